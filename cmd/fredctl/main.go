@@ -0,0 +1,285 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/archive"
+	"git.tu-berlin.de/mcc-fred/fred/pkg/grpcutil"
+	"git.tu-berlin.de/mcc-fred/fred/proto/client"
+	"github.com/go-errors/errors"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+)
+
+type config struct {
+	address    string
+	cert       string
+	key        string
+	caCert     string
+	skipVerify bool
+	keygroup   string
+	file       string
+	replace    bool
+	target     string
+	node       string
+}
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] export|import|decommission\n\n", os.Args[0])
+	fmt.Fprintln(flag.CommandLine.Output(), "  export        writes the keygroup to an archive file")
+	fmt.Fprintln(flag.CommandLine.Output(), "  import        restores a keygroup from an archive file")
+	fmt.Fprintln(flag.CommandLine.Output(), "  decommission  moves the replicas of a node to other nodes and removes it from the cluster")
+	fmt.Fprintln(flag.CommandLine.Output())
+	flag.PrintDefaults()
+}
+
+func parseArgs() (c config) {
+	flag.StringVar(&(c.address), "address", "", "address of the client interface of the fred node (or proxy) to connect to")
+	flag.StringVar(&(c.cert), "cert", "", "certificate of the user")
+	flag.StringVar(&(c.key), "key", "", "key of the user")
+	flag.StringVar(&(c.caCert), "ca-cert", "", "certificates of the ca, comma-separated")
+	flag.BoolVar(&(c.skipVerify), "skip-verify", false, "skip tls verification of the fred node")
+	flag.StringVar(&(c.keygroup), "keygroup", "", "keygroup to export")
+	flag.StringVar(&(c.file), "file", "", "archive file to write to (export) or read from (import)")
+	flag.BoolVar(&(c.replace), "replace", false, "replace the items of the keygroup if it already exists (import)")
+	flag.StringVar(&(c.target), "target", "", "import the archive into a keygroup with this name instead of the one of the archive (import)")
+	flag.StringVar(&(c.node), "node", "", "id of the node to decommission (decommission)")
+	flag.Usage = usage
+	flag.Parse()
+	return
+}
+
+func main() {
+	c := parseArgs()
+
+	log.Logger = log.Output(
+		zerolog.ConsoleWriter{
+			Out:     os.Stderr,
+			NoColor: false,
+		},
+	)
+
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+
+	if flag.NArg() != 1 || c.address == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	creds, _, err := grpcutil.GetCreds(c.cert, c.key, strings.Split(c.caCert, ","), false, c.skipVerify)
+
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot load credentials")
+	}
+
+	conn, err := grpc.Dial(c.address, grpc.WithTransportCredentials(creds))
+
+	if err != nil {
+		log.Fatal().Err(err).Msgf("cannot connect to %s", c.address)
+	}
+
+	defer conn.Close()
+
+	cl := client.NewClientClient(conn)
+
+	switch flag.Arg(0) {
+	case "export":
+		if c.keygroup == "" || c.file == "" {
+			log.Fatal().Msg("export needs a keygroup and a file")
+		}
+
+		err = export(cl, c.keygroup, c.file)
+	case "import":
+		if c.file == "" {
+			log.Fatal().Msg("import needs a file")
+		}
+
+		err = restore(cl, c.file, c.replace, c.target)
+	case "decommission":
+		if c.node == "" {
+			log.Fatal().Msg("decommission needs a node")
+		}
+
+		err = decommission(cl, c.node)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		log.Fatal().Msg(err.Error())
+	}
+}
+
+// export writes a keygroup to an archive file. The file is only created once the whole keygroup has been exported, so
+// a failed export never leaves an incomplete archive behind.
+func export(cl client.ClientClient, kg string, file string) error {
+	tmp := file + ".part"
+
+	f, err := os.Create(tmp)
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	defer os.Remove(tmp)
+	defer f.Close()
+
+	w, err := archive.NewWriter(f)
+
+	if err != nil {
+		return err
+	}
+
+	stream, err := cl.ExportKeygroup(context.Background(), &client.ExportKeygroupRequest{Keygroup: kg})
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	for {
+		chunk, err := stream.Recv()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return errors.New(err)
+		}
+
+		if err := w.Write(chunk); err != nil {
+			return err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	if err := f.Sync(); err != nil {
+		return errors.New(err)
+	}
+
+	if err := os.Rename(tmp, file); err != nil {
+		return errors.New(err)
+	}
+
+	log.Info().Msgf("exported keygroup %s to %s", kg, file)
+
+	return nil
+}
+
+// restore imports a keygroup from an archive file. The whole archive is verified before anything is sent, so that a
+// corrupted archive is never imported.
+func restore(cl client.ClientClient, file string, replace bool, target string) error {
+	f, err := os.Open(file)
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	defer f.Close()
+
+	header, items, err := archive.Verify(f)
+
+	if err != nil {
+		return err
+	}
+
+	log.Info().Msgf("archive %s of keygroup %s from node %s with %d items is valid", file, header.Keygroup, header.Source, items)
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return errors.New(err)
+	}
+
+	r, err := archive.NewReader(f)
+
+	if err != nil {
+		return err
+	}
+
+	stream, err := cl.ImportKeygroup(context.Background())
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	first := true
+
+	for {
+		chunk, err := r.Next()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		req := &client.ImportKeygroupRequest{Chunk: chunk}
+
+		if first {
+			req.Replace = replace
+			req.Keygroup = target
+			first = false
+		}
+
+		if err := stream.Send(req); err != nil {
+			return errors.New(err)
+		}
+	}
+
+	res, err := stream.CloseAndRecv()
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	log.Info().Msgf("imported %d versions, deleted %d items, added replicas %v", res.Versions, res.Deleted, res.Replicas)
+
+	if len(res.Skipped) > 0 {
+		log.Warn().Msgf("could not add replicas %v, add them with AddReplica once they are part of the cluster", res.Skipped)
+	}
+
+	return nil
+}
+
+// decommission decommissions a node and logs its progress.
+func decommission(cl client.ClientClient, node string) error {
+	stream, err := cl.DecommissionNode(context.Background(), &client.DecommissionNodeRequest{NodeId: node})
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	for {
+		p, err := stream.Recv()
+
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return errors.New(err)
+		}
+
+		switch p.Step {
+		case client.DecommissionStep_Started:
+			log.Info().Msgf("node %s is a replica of %d keygroups", node, p.Total)
+		case client.DecommissionStep_ReplicaAdded:
+			log.Info().Msgf("[%d/%d] added replica %s to keygroup %s", p.Done, p.Total, p.Replica, p.Keygroup)
+		case client.DecommissionStep_ReplicaRemoved:
+			log.Info().Msgf("[%d/%d] removed node %s from keygroup %s", p.Done, p.Total, node, p.Keygroup)
+		case client.DecommissionStep_Deregistered:
+			log.Info().Msgf("node %s is decommissioned and can be stopped", node)
+		}
+	}
+}
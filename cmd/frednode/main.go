@@ -8,6 +8,7 @@ import (
 	"runtime/pprof"
 	"strings"
 	"syscall"
+	"time"
 
 	"git.tu-berlin.de/mcc-fred/fred/pkg/dynamo"
 	"git.tu-berlin.de/mcc-fred/fred/pkg/storageclient"
@@ -19,14 +20,19 @@ import (
 
 	"git.tu-berlin.de/mcc-fred/fred/pkg/api"
 	"git.tu-berlin.de/mcc-fred/fred/pkg/badgerdb"
+	"git.tu-berlin.de/mcc-fred/fred/pkg/compression"
+	"git.tu-berlin.de/mcc-fred/fred/pkg/encryption"
 	"git.tu-berlin.de/mcc-fred/fred/pkg/etcdnase"
 	"git.tu-berlin.de/mcc-fred/fred/pkg/fred"
 	"git.tu-berlin.de/mcc-fred/fred/pkg/peering"
+	"git.tu-berlin.de/mcc-fred/fred/pkg/raftnase"
 )
 
 type fredConfig struct {
 	General struct {
 		nodeID string `env:"NODEID"`
+		Labels string `env:"LABELS"`
+		Admins string `env:"ADMINS"`
 	}
 	Location struct {
 		Lat float64 `env:"LAT"`
@@ -53,13 +59,21 @@ type fredConfig struct {
 		CA               string `env:"PEERING_CA"`
 		AsyncReplication bool   `env:"PEERING_ASYNC_REPLICATION"`
 		SkipVerify       bool   `env:"PEERING_SKIP_VERIFY"`
+		AntiEntropy      int    `env:"PEERING_ANTI_ENTROPY_INTERVAL"`
+		TombstoneGC      int    `env:"PEERING_TOMBSTONE_GC_INTERVAL"`
+		Reconcile        int    `env:"PEERING_RECONCILE_INTERVAL"`
+		OutboxPath       string `env:"PEERING_OUTBOX_PATH"`
+		OutboxSize       int    `env:"PEERING_OUTBOX_SIZE"`
 	}
 	Log struct {
 		Level   string `env:"LOG_LEVEL"`
 		Handler string `env:"LOG_HANDLER"`
 	}
 	NaSe struct {
+		Mode       string `env:"NASE_MODE"`
 		Host       string `env:"NASE_HOST"`
+		Peers      string `env:"NASE_PEERS"`
+		Path       string `env:"NASE_PATH"`
 		Cert       string `env:"NASE_CERT"`
 		Key        string `env:"NASE_KEY"`
 		CA         string `env:"NASE_CA"`
@@ -84,6 +98,16 @@ type fredConfig struct {
 	Bdb struct {
 		Path string `env:"BADGERDB_PATH"`
 	}
+	Encryption struct {
+		KeyFile     string `env:"ENCRYPTION_KEY_FILE"`
+		OldKeyFiles string `env:"ENCRYPTION_OLD_KEY_FILES"`
+		Rotation    int    `env:"ENCRYPTION_ROTATION_INTERVAL"`
+	}
+	ChangeLog struct {
+		Path    string `env:"CHANGELOG_PATH"`
+		Entries int    `env:"CHANGELOG_RETENTION_ENTRIES"`
+		Window  int    `env:"CHANGELOG_RETENTION_WINDOW"`
+	}
 	Trigger struct {
 		Cert       string `env:"TRIGGER_CERT"`
 		Key        string `env:"TRIGGER_KEY"`
@@ -100,6 +124,8 @@ func parseArgs() (fc fredConfig) {
 
 	// General configuration
 	flag.StringVar(&(fc.General.nodeID), "nodeID", "", "Unique ID of this node. Will be calculated from lat/long if omitted. (Env: NODEID)")
+	flag.StringVar(&(fc.General.Labels), "labels", "", "Comma-separated list of key=value labels of this node that replication policies of keygroups can require. (Env: LABELS)")
+	flag.StringVar(&(fc.General.Admins), "admins", "", "Comma-separated list of users that may administer the cluster, e.g., decommission nodes. (Env: ADMINS)")
 
 	// Location configuration
 	flag.Float64Var(&(fc.Location.Lat), "lat", 0, "Latitude of the node. (Env: LAT)")   // Domain: [-90,90]
@@ -125,6 +151,11 @@ func parseArgs() (fc fredConfig) {
 	flag.StringVar(&(fc.Peering.CA), "peer-ca", "", "Certificate authority root certificate file for peering connections. (Env: PEERING_CA)")
 	flag.BoolVar(&(fc.Peering.AsyncReplication), "peer-async-replication", false, "Enable asynchronous replication. Experimental. (Env: PEERING_ASYNC_REPLICATION)")
 	flag.BoolVar(&(fc.Peering.SkipVerify), "peer-skip-verify", false, "Skip verification of client certificates. (Env: PEERING_SKIP_VERIFY)")
+	flag.StringVar(&(fc.Peering.OutboxPath), "peer-outbox-path", "", "Path to the BadgerDB database where asynchronous replication messages are queued until they are delivered. If empty, messages are only sent once. (Env: PEERING_OUTBOX_PATH)")
+	flag.IntVar(&(fc.Peering.OutboxSize), "peer-outbox-size", 100000, "Maximum number of queued asynchronous replication messages per peer. (Env: PEERING_OUTBOX_SIZE)")
+	flag.IntVar(&(fc.Peering.AntiEntropy), "peer-anti-entropy-interval", 0, "Interval in seconds in which keygroups are compared with other replicas to repair missed updates, 0 to disable. (Env: PEERING_ANTI_ENTROPY_INTERVAL)")
+	flag.IntVar(&(fc.Peering.TombstoneGC), "peer-tombstone-gc-interval", 0, "Interval in seconds in which tombstones of deleted items that all replicas have seen are removed, 0 to disable. (Env: PEERING_TOMBSTONE_GC_INTERVAL)")
+	flag.IntVar(&(fc.Peering.Reconcile), "peer-reconcile-interval", 60, "Interval in seconds in which the elected node adds replicas to keygroups that have fewer replicas alive than their replication policy asks for, 0 to not take part in the election. (Env: PEERING_RECONCILE_INTERVAL)")
 
 	// storage configuration
 	flag.StringVar(&(fc.Storage.Adaptor), "adaptor", "", "Storage adaptor, can be \"remote\", \"badgerdb\", \"memory\", \"dynamo\". (Env: STORAGE_ADAPTOR)")
@@ -143,16 +174,27 @@ func parseArgs() (fc fredConfig) {
 
 	flag.StringVar(&(fc.Bdb.Path), "badgerdb-path", "", "Path to the BadgerDB database. (Env: BADGERDB_PATH)")
 
+	flag.StringVar(&(fc.Encryption.KeyFile), "encryption-key-file", "", "File with the master key that wraps the data keys used to encrypt stored values. Leave empty to store values unencrypted. (Env: ENCRYPTION_KEY_FILE)")
+	flag.StringVar(&(fc.Encryption.OldKeyFiles), "encryption-old-key-files", "", "Comma-separated list of files with earlier master keys, data keys wrapped with them are wrapped with the current master key. (Env: ENCRYPTION_OLD_KEY_FILES)")
+	flag.IntVar(&(fc.Encryption.Rotation), "encryption-rotation-interval", 0, "Interval in seconds after which the data key of a keygroup is replaced and its items are re-encrypted, 0 to disable. (Env: ENCRYPTION_ROTATION_INTERVAL)")
+
+	flag.StringVar(&(fc.ChangeLog.Path), "changelog-path", "", "Path to the BadgerDB database where all changes applied on this node are logged for ReadChanges. Leave empty to disable the change log. (Env: CHANGELOG_PATH)")
+	flag.IntVar(&(fc.ChangeLog.Entries), "changelog-retention-entries", 0, "Maximum number of logged changes per keygroup, older changes are removed, 0 for no limit. (Env: CHANGELOG_RETENTION_ENTRIES)")
+	flag.IntVar(&(fc.ChangeLog.Window), "changelog-retention-window", 0, "Time in seconds after which logged changes are removed, 0 for no limit. (Env: CHANGELOG_RETENTION_WINDOW)")
+
 	// logging configuration
 	flag.StringVar(&(fc.Log.Level), "log-level", "debug", "Log level, can be \"debug\", \"info\" ,\"warn\", \"error\", \"fatal\", \"panic\". (Env: LOG_LEVEL)")
 	flag.StringVar(&(fc.Log.Handler), "handler", "dev", "Mode of log handler, can be \"dev\", \"prod\". (Env: LOG_HANDLER)")
 
 	// Nameservice configuration
 	// TODO this should be a list of nodes. One node is enough, but if we want reliability we should accept multiple etcd nodes
-	flag.StringVar(&(fc.NaSe.Host), "nase-host", "", "Host where the etcd server runs. (Env: NASE_HOST)")
-	flag.StringVar(&(fc.NaSe.Cert), "nase-cert", "", "Certificate file to authenticate against etcd. (Env: NASE_CERT)")
-	flag.StringVar(&(fc.NaSe.Key), "nase-key", "", "Key file to authenticate against etcd. (Env: NASE_KEY)")
-	flag.StringVar(&(fc.NaSe.CA), "nase-ca", "", "CA certificate file to authenticate against etcd. (Env: NASE_CA)")
+	flag.StringVar(&(fc.NaSe.Mode), "nase-mode", "etcd", "Where the NaSe keeps its state, can be \"etcd\" for an external etcd or \"embedded\" for a raft group of FReD nodes. (Env: NASE_MODE)")
+	flag.StringVar(&(fc.NaSe.Host), "nase-host", "", "Host where the etcd server runs or, in embedded mode, address of this node in the NaSe if it is one of the peers. (Env: NASE_HOST)")
+	flag.StringVar(&(fc.NaSe.Peers), "nase-peers", "", "Comma-separated list of the addresses of all nodes in the embedded NaSe, in the same order on every node. (Env: NASE_PEERS)")
+	flag.StringVar(&(fc.NaSe.Path), "nase-path", "", "Path to the BadgerDB database where this node keeps its copy of the embedded NaSe. (Env: NASE_PATH)")
+	flag.StringVar(&(fc.NaSe.Cert), "nase-cert", "", "Certificate file to authenticate against etcd or the other nodes of the embedded NaSe. (Env: NASE_CERT)")
+	flag.StringVar(&(fc.NaSe.Key), "nase-key", "", "Key file to authenticate against etcd or the other nodes of the embedded NaSe. (Env: NASE_KEY)")
+	flag.StringVar(&(fc.NaSe.CA), "nase-ca", "", "CA certificate file to authenticate against etcd or the other nodes of the embedded NaSe. (Env: NASE_CA)")
 	flag.BoolVar(&(fc.NaSe.SkipVerify), "nase-skip-verify", false, "Skip verification of etcd certificates. (Env: NASE_SKIP_VERIFY)")
 	flag.BoolVar(&(fc.NaSe.Cached), "nase-cached", false, "Flag to indicate, whether to use a cache for NaSe. (Env: NASE_CACHED)")
 
@@ -178,7 +220,7 @@ func parseArgs() (fc fredConfig) {
 		log.Fatal().Msgf("Given latitude %f is not within latitude range from -90 to 90.", fc.Location.Lat)
 	}
 
-	if fc.Location.Lng > 180 || fc.Location.Lat < -180 {
+	if fc.Location.Lng > 180 || fc.Location.Lng < -180 {
 		flag.Usage()
 		log.Fatal().Msgf("Given longitutde %f is not within latitude range from -180 to 180.", fc.Location.Lng)
 	}
@@ -305,6 +347,26 @@ func main() {
 		log.Fatal().Msg("unknown storage backend")
 	}
 
+	if fc.Encryption.KeyFile != "" {
+		var old []string
+
+		if fc.Encryption.OldKeyFiles != "" {
+			old = strings.Split(fc.Encryption.OldKeyFiles, ",")
+		}
+
+		kms, err := encryption.NewFileKMS(fc.Encryption.KeyFile, old...)
+
+		if err != nil {
+			log.Fatal().Msgf("could not load encryption master key: %s", err.(*errors.Error).ErrorStack())
+		}
+
+		store, err = encryption.New(store, kms, time.Duration(fc.Encryption.Rotation)*time.Second)
+
+		if err != nil {
+			log.Fatal().Msgf("could not set up encryption: %s", err.(*errors.Error).ErrorStack())
+		}
+	}
+
 	if fc.Server.AdvertiseHost == "" {
 		fc.Server.AdvertiseHost = fc.Server.Host
 	}
@@ -316,16 +378,78 @@ func main() {
 	log.Debug().Msg("Starting Interconnection Client...")
 	c := peering.NewClient(fc.Peering.Cert, fc.Peering.Key, fc.Peering.CA, fc.Peering.SkipVerify)
 
+	var outbox fred.Outbox
+
+	if fc.Peering.AsyncReplication && fc.Peering.OutboxPath != "" {
+		log.Debug().Msgf("Opening replication outbox at %s...", fc.Peering.OutboxPath)
+		outbox = badgerdb.NewOutbox(fc.Peering.OutboxPath)
+	}
+
+	var changelog fred.ChangeLog
+
+	if fc.ChangeLog.Path != "" {
+		log.Debug().Msgf("Opening change log at %s...", fc.ChangeLog.Path)
+		changelog = badgerdb.NewChangeLog(fc.ChangeLog.Path)
+	}
+
 	log.Debug().Msg("Starting NaSe Client...")
 
 	var n fred.NameService
-	n, err = etcdnase.NewNameService(fc.General.nodeID, []string{fc.NaSe.Host}, fc.NaSe.Cert, fc.NaSe.Key, fc.NaSe.CA, fc.NaSe.SkipVerify, fc.NaSe.Cached)
+	var embedded etcdnase.KV
+
+	switch fc.NaSe.Mode {
+	case "etcd":
+		n, err = etcdnase.NewNameService(fc.General.nodeID, []string{fc.NaSe.Host}, fc.NaSe.Cert, fc.NaSe.Key, fc.NaSe.CA, fc.NaSe.SkipVerify, fc.NaSe.Cached)
+	case "embedded":
+		// nodes with an address are members of the embedded NaSe, all others only use it
+		if fc.NaSe.Host != "" {
+			log.Debug().Msgf("Starting embedded NaSe at %s...", fc.NaSe.Host)
+			embedded, err = raftnase.New(fc.NaSe.Host, strings.Split(fc.NaSe.Peers, ","), fc.NaSe.Path, fc.NaSe.Cert, fc.NaSe.Key, fc.NaSe.CA, fc.NaSe.SkipVerify)
+		} else {
+			embedded, err = raftnase.NewClient(strings.Split(fc.NaSe.Peers, ","), fc.NaSe.Cert, fc.NaSe.Key, fc.NaSe.CA, fc.NaSe.SkipVerify)
+		}
+
+		if err == nil {
+			// the embedded NaSe keeps its state in memory anyway, so there is nothing to cache
+			n, err = etcdnase.NewNameServiceFromKV(fc.General.nodeID, embedded, false)
+		}
+	default:
+		log.Fatal().Msgf("unknown NaSe mode: %s", fc.NaSe.Mode)
+	}
 
 	if err != nil {
 		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
 		panic(err)
 	}
 
+	// values are compressed with the codec of their keygroup before they are encrypted and stored or sent to peers
+	store = compression.New(store, n)
+	c.SetCompression(n)
+
+	// replicas can be placed by the labels and the location of this node
+	info := fred.NodeInfo{
+		Labels:  make(map[string]string),
+		Geohash: geohash.Encode(fc.Location.Lat, fc.Location.Lng),
+		Location: &fred.Location{
+			Lat: fc.Location.Lat,
+			Lng: fc.Location.Lng,
+		},
+	}
+
+	for _, l := range strings.Split(fc.General.Labels, ",") {
+		if l == "" {
+			continue
+		}
+
+		kv := strings.SplitN(l, "=", 2)
+
+		if len(kv) != 2 {
+			log.Fatal().Msgf("label %s is not of the form key=value", l)
+		}
+
+		info.Labels[kv[0]] = kv[1]
+	}
+
 	f := fred.New(&fred.Config{
 		Store:                   store,
 		Client:                  c,
@@ -333,11 +457,21 @@ func main() {
 		PeeringHost:             fc.Peering.AdvertiseHost,
 		PeeringHostProxy:        fc.Peering.Proxy,
 		PeeringAsyncReplication: fc.Peering.AsyncReplication,
+		AntiEntropyInterval:     fc.Peering.AntiEntropy,
+		TombstoneGCInterval:     fc.Peering.TombstoneGC,
+		ReconcileInterval:       fc.Peering.Reconcile,
+		Outbox:                  outbox,
+		OutboxSize:              fc.Peering.OutboxSize,
+		ChangeLog:               changelog,
+		ChangeLogEntries:        fc.ChangeLog.Entries,
+		ChangeLogWindow:         fc.ChangeLog.Window,
 		ExternalHost:            fc.Server.AdvertiseHost,
 		ExternalHostProxy:       fc.Server.Proxy,
+		NodeInfo:                info,
 		TriggerCert:             fc.Trigger.Cert,
 		TriggerKey:              fc.Trigger.Key,
 		TriggerCA:               strings.Split(fc.Trigger.CA, ","),
+		Admins:                  strings.Split(fc.General.Admins, ","),
 	})
 
 	log.Debug().Msg("Starting Interconnection Server...")
@@ -359,6 +493,18 @@ func main() {
 	log.Err(es.Close()).Msg("closing api server")
 	log.Err(store.Close()).Msg("closing database")
 
+	if outbox != nil {
+		log.Err(outbox.Close()).Msg("closing replication outbox")
+	}
+
+	if changelog != nil {
+		log.Err(changelog.Close()).Msg("closing change log")
+	}
+
+	if embedded != nil {
+		log.Err(embedded.Close()).Msg("closing embedded NaSe")
+	}
+
 	if prof.cpu != nil {
 		pprof.StopCPUProfile()
 		err = prof.cpu.Close()
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/etcdnase"
+	"git.tu-berlin.de/mcc-fred/fred/pkg/raftnase"
+)
+
+// batchSize is the number of keys that are written to the embedded NaSe at once.
+const batchSize = 100
+
+// nasemigrate copies the state of a NaSe from etcd into an embedded NaSe. The embedded NaSe has to be running already,
+// e.g. with frednode -nase-mode=embedded, and no FReD node should change the etcd NaSe while the state is copied.
+func main() {
+	etcdHost := flag.String("etcd-host", "", "Comma-separated list of the etcd servers to copy the state from.")
+	etcdCert := flag.String("etcd-cert", "", "Certificate file to authenticate against etcd.")
+	etcdKey := flag.String("etcd-key", "", "Key file to authenticate against etcd.")
+	etcdCA := flag.String("etcd-ca", "", "CA certificate file to authenticate against etcd.")
+	etcdSkipVerify := flag.Bool("etcd-skip-verify", false, "Skip verification of etcd certificates.")
+
+	peers := flag.String("nase-peers", "", "Comma-separated list of the addresses of the nodes of the embedded NaSe to copy the state to.")
+	cert := flag.String("nase-cert", "", "Certificate file to authenticate against the embedded NaSe.")
+	key := flag.String("nase-key", "", "Key file to authenticate against the embedded NaSe.")
+	ca := flag.String("nase-ca", "", "CA certificate file to authenticate against the embedded NaSe.")
+	skipVerify := flag.Bool("nase-skip-verify", false, "Skip verification of the certificates of the embedded NaSe.")
+
+	flag.Parse()
+
+	log.Logger = log.Output(
+		zerolog.ConsoleWriter{
+			Out:     os.Stderr,
+			NoColor: false,
+		},
+	)
+
+	src, err := etcdnase.NewEtcdKV(strings.Split(*etcdHost, ","), *etcdCert, *etcdKey, *etcdCA, *etcdSkipVerify)
+
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not connect to etcd")
+	}
+
+	defer func() {
+		log.Err(src.Close()).Msg("closing etcd client")
+	}()
+
+	dst, err := raftnase.NewClient(strings.Split(*peers, ","), *cert, *key, *ca, *skipVerify)
+
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not connect to embedded NaSe")
+	}
+
+	defer func() {
+		log.Err(dst.Close()).Msg("closing embedded NaSe client")
+	}()
+
+	ctx, cncl := context.WithTimeout(context.Background(), time.Minute)
+	defer cncl()
+
+	kv, err := src.Get(ctx, "", true)
+
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not read state from etcd")
+	}
+
+	log.Info().Msgf("copying %d keys from etcd to the embedded NaSe at %s", len(kv), *peers)
+
+	batch := make(map[string]string, batchSize)
+	copied := 0
+
+	for k, v := range kv {
+		batch[k] = v
+
+		if len(batch) < batchSize && copied+len(batch) < len(kv) {
+			continue
+		}
+
+		if err := dst.PutAll(ctx, batch); err != nil {
+			log.Fatal().Err(err).Msgf("could not write state to embedded NaSe after %d keys", copied)
+		}
+
+		copied += len(batch)
+		batch = make(map[string]string, batchSize)
+
+		log.Debug().Msgf("copied %d of %d keys", copied, len(kv))
+	}
+
+	log.Info().Msgf("copied %d keys", copied)
+}
@@ -39,7 +39,7 @@ func (s *Server) PutItemTrigger(_ context.Context, request *trigger.PutItemTrigg
 		Op:  "put",
 		Kg:  request.Keygroup,
 		ID:  request.Id,
-		Val: request.Val,
+		Val: string(request.Val),
 	})
 
 	return &trigger.Empty{}, nil
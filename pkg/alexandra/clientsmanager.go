@@ -109,7 +109,7 @@ func (m *ClientsMgr) readFromAnywhere(request *middleware.ReadRequest) ([]string
 		versions := make([]vclock.VClock, len(res.Data))
 
 		for i := range res.Data {
-			vals[i] = res.Data[i].Val
+			vals[i] = string(res.Data[i].Val)
 			versions[i] = res.Data[i].Version.Version
 			log.Debug().Msgf("Reading from client %s returned data: %+v %+v", set.preferred.nodeID, res.Data[i].Val, res.Data[i].Version.Version)
 		}
@@ -163,7 +163,7 @@ func (m *ClientsMgr) readFromAnywhere(request *middleware.ReadRequest) ([]string
 				versions: make([]vclock.VClock, len(res.Data)),
 			}
 			for i := range res.Data {
-				r.vals[i] = res.Data[i].Val
+				r.vals[i] = string(res.Data[i].Val)
 				r.versions[i] = res.Data[i].Version.Version
 				log.Debug().Msgf("Reading from client %s returned data: %+v %+v", c.nodeID, res.Data[i].Val, res.Data[i].Version.Version)
 			}
@@ -208,7 +208,7 @@ func (m *ClientsMgr) readFromAnywhere(request *middleware.ReadRequest) ([]string
 	versions := make([]vclock.VClock, len(result.Data))
 
 	for i := range result.Data {
-		vals[i] = result.Data[i].Val
+		vals[i] = string(result.Data[i].Val)
 		versions[i] = result.Data[i].Version.Version
 	}
 
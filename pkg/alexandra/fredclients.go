@@ -119,7 +119,7 @@ func (c *Client) updateVersions(ctx context.Context, keygroup string, id string,
 	res, err := c.Client.Update(ctx, &api.UpdateRequest{
 		Keygroup: keygroup,
 		Id:       id,
-		Data:     data,
+		Data:     []byte(data),
 		Versions: v,
 	})
 
@@ -167,7 +167,7 @@ func (c *Client) append(ctx context.Context, keygroup string, data string) (*api
 	res, err := c.Client.Append(ctx, &api.AppendRequest{
 		Keygroup: keygroup,
 		Id:       id,
-		Data:     data,
+		Data:     []byte(data),
 	})
 	if err == nil {
 		elapsed := time.Since(start)
@@ -151,7 +151,7 @@ func (m *Middleware) Read(_ context.Context, req *middleware.ReadRequest) (*midd
 
 	for i := range vals {
 		items[i] = &middleware.Item{
-			Val:     vals[i],
+			Val:     []byte(vals[i]),
 			Version: versions[i].GetMap(),
 		}
 	}
@@ -203,7 +203,7 @@ func (m *Middleware) Update(ctx context.Context, req *middleware.UpdateRequest)
 		known = []vclock.VClock{{}}
 	}
 
-	v, err := c.updateVersions(ctx, req.Keygroup, req.Id, req.Data, known)
+	v, err := c.updateVersions(ctx, req.Keygroup, req.Id, string(req.Data), known)
 
 	if err != nil {
 		log.Error().Err(err)
@@ -285,7 +285,7 @@ func (m *Middleware) Append(ctx context.Context, req *middleware.AppendRequest)
 	if err != nil {
 		return nil, err
 	}
-	res, err := c.append(ctx, req.Keygroup, req.Data)
+	res, err := c.append(ctx, req.Keygroup, string(req.Data))
 
 	if err != nil {
 		return nil, err
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"io"
 	"net"
 	"strconv"
 	"time"
@@ -15,9 +16,11 @@ import (
 	"github.com/go-errors/errors"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 )
 
 // Server handles GRPC Requests and calls the according functions of the exthandler
@@ -207,7 +210,7 @@ func (s *Server) CreateKeygroup(ctx context.Context, request *client.CreateKeygr
 		return nil, err
 	}
 
-	err = s.e.HandleCreateKeygroup(user, fred.Keygroup{Name: fred.KeygroupName(request.Keygroup), Mutable: request.Mutable, Expiry: int(request.Expiry)})
+	err = s.e.HandleCreateKeygroup(user, fred.Keygroup{Name: fred.KeygroupName(request.Keygroup), Mutable: request.Mutable, Expiry: int(request.Expiry), Indexes: request.Indexes, ConflictPolicy: fred.ConflictPolicy(request.ConflictPolicy), Type: fred.KeygroupType(request.Type), History: fred.HistoryRetention{Versions: int(request.HistoryVersions), Window: int(request.HistoryWindow)}, Quota: quota(request.Quota), Compression: fred.Compression(request.Compression), ReplicationPolicy: replicationPolicy(request.ReplicationPolicy)})
 
 	if err != nil {
 		return nil, err
@@ -216,6 +219,165 @@ func (s *Server) CreateKeygroup(ctx context.Context, request *client.CreateKeygr
 	return &client.Empty{}, nil
 }
 
+// QueryIndex calls this method on the exthandler
+func (s *Server) QueryIndex(ctx context.Context, request *client.QueryIndexRequest) (*client.QueryIndexResponse, error) {
+	log.Info().Msgf("API Server has rcvd QueryIndex. In: %+v", request)
+
+	user, err := s.CheckCert(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.e.HandleQueryIndex(user, fred.Keygroup{Name: fred.KeygroupName(request.Keygroup)}, request.Index, request.Value)
+
+	if err != nil {
+		log.Debug().Msgf("API Server is returning error: %+v", err)
+		return nil, err
+	}
+
+	data := make([]*client.Item, len(res))
+
+	for i := 0; i < len(res); i++ {
+		data[i] = &client.Item{
+			Id:  res[i].ID,
+			Val: []byte(res[i].Val),
+			Version: &client.Version{
+				Version: res[i].Version.GetMap(),
+			},
+		}
+	}
+
+	return &client.QueryIndexResponse{
+		Data: data,
+	}, nil
+}
+
+// Increment calls this method on the exthandler
+func (s *Server) Increment(ctx context.Context, request *client.IncrementRequest) (*client.IncrementResponse, error) {
+	log.Info().Msgf("API Server has rcvd Increment. In: %+v", request)
+
+	user, err := s.CheckCert(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	i, v, err := s.e.HandleIncrement(user, fred.Item{Keygroup: fred.KeygroupName(request.Keygroup), ID: request.Id}, request.Delta)
+
+	if err != nil {
+		log.Debug().Msgf("API Server is returning error: %+v", err)
+		return nil, errorStatus(err)
+	}
+
+	return &client.IncrementResponse{
+		Value: v,
+		Version: &client.Version{
+			Version: i.Version.GetMap(),
+		},
+	}, nil
+}
+
+// SetAdd calls this method on the exthandler
+func (s *Server) SetAdd(ctx context.Context, request *client.SetRequest) (*client.SetResponse, error) {
+	log.Info().Msgf("API Server has rcvd SetAdd. In: %+v", request)
+
+	user, err := s.CheckCert(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	i, e, err := s.e.HandleSetAdd(user, fred.Item{Keygroup: fred.KeygroupName(request.Keygroup), ID: request.Id}, request.Elements)
+
+	if err != nil {
+		log.Debug().Msgf("API Server is returning error: %+v", err)
+		return nil, errorStatus(err)
+	}
+
+	return &client.SetResponse{
+		Elements: e,
+		Version: &client.Version{
+			Version: i.Version.GetMap(),
+		},
+	}, nil
+}
+
+// SetRemove calls this method on the exthandler
+func (s *Server) SetRemove(ctx context.Context, request *client.SetRequest) (*client.SetResponse, error) {
+	log.Info().Msgf("API Server has rcvd SetRemove. In: %+v", request)
+
+	user, err := s.CheckCert(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	i, e, err := s.e.HandleSetRemove(user, fred.Item{Keygroup: fred.KeygroupName(request.Keygroup), ID: request.Id}, request.Elements)
+
+	if err != nil {
+		log.Debug().Msgf("API Server is returning error: %+v", err)
+		return nil, errorStatus(err)
+	}
+
+	return &client.SetResponse{
+		Elements: e,
+		Version: &client.Version{
+			Version: i.Version.GetMap(),
+		},
+	}, nil
+}
+
+// MapPut calls this method on the exthandler
+func (s *Server) MapPut(ctx context.Context, request *client.MapPutRequest) (*client.MapResponse, error) {
+	log.Info().Msgf("API Server has rcvd MapPut. In: %+v", request)
+
+	user, err := s.CheckCert(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	i, e, err := s.e.HandleMapUpdate(user, fred.Item{Keygroup: fred.KeygroupName(request.Keygroup), ID: request.Id}, request.Entries, nil)
+
+	if err != nil {
+		log.Debug().Msgf("API Server is returning error: %+v", err)
+		return nil, errorStatus(err)
+	}
+
+	return &client.MapResponse{
+		Entries: e,
+		Version: &client.Version{
+			Version: i.Version.GetMap(),
+		},
+	}, nil
+}
+
+// MapRemove calls this method on the exthandler
+func (s *Server) MapRemove(ctx context.Context, request *client.MapRemoveRequest) (*client.MapResponse, error) {
+	log.Info().Msgf("API Server has rcvd MapRemove. In: %+v", request)
+
+	user, err := s.CheckCert(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	i, e, err := s.e.HandleMapUpdate(user, fred.Item{Keygroup: fred.KeygroupName(request.Keygroup), ID: request.Id}, nil, request.Keys)
+
+	if err != nil {
+		log.Debug().Msgf("API Server is returning error: %+v", err)
+		return nil, errorStatus(err)
+	}
+
+	return &client.MapResponse{
+		Entries: e,
+		Version: &client.Version{
+			Version: i.Version.GetMap(),
+		},
+	}, nil
+}
+
 // DeleteKeygroup calls this method on the exthandler
 func (s *Server) DeleteKeygroup(ctx context.Context, request *client.DeleteKeygroupRequest) (*client.Empty, error) {
 
@@ -264,7 +426,7 @@ func (s *Server) Read(ctx context.Context, request *client.ReadRequest) (*client
 	for i := 0; i < len(res); i++ {
 		data[i] = &client.Item{
 			Id:  res[i].ID,
-			Val: res[i].Val,
+			Val: []byte(res[i].Val),
 			Version: &client.Version{
 				Version: res[i].Version.GetMap(),
 			},
@@ -277,6 +439,79 @@ func (s *Server) Read(ctx context.Context, request *client.ReadRequest) (*client
 
 }
 
+// ReadHistory calls this method on the exthandler
+func (s *Server) ReadHistory(ctx context.Context, request *client.ReadHistoryRequest) (*client.ReadHistoryResponse, error) {
+	log.Info().Msgf("API Server has rcvd ReadHistory. In: %+v", request)
+
+	user, err := s.CheckCert(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.e.HandleReadHistory(user, fred.Item{Keygroup: fred.KeygroupName(request.Keygroup), ID: request.Id})
+
+	if err != nil {
+		log.Debug().Msgf("API Server is returning error: %+v", err)
+		return nil, err
+	}
+
+	revisions := make([]*client.Revision, len(res))
+
+	for i := 0; i < len(res); i++ {
+		revisions[i] = &client.Revision{
+			Val: []byte(res[i].Val),
+			Version: &client.Version{
+				Version: res[i].Version.GetMap(),
+			},
+			Tombstoned: res[i].Tombstoned,
+			Replaced:   res[i].Replaced,
+		}
+	}
+
+	return &client.ReadHistoryResponse{
+		Revisions: revisions,
+	}, nil
+}
+
+// ReadAt calls this method on the exthandler
+func (s *Server) ReadAt(ctx context.Context, request *client.ReadAtRequest) (*client.ReadResponse, error) {
+	log.Info().Msgf("API Server has rcvd ReadAt. In: %+v", request)
+
+	user, err := s.CheckCert(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Version == nil {
+		return nil, errors.Errorf("no version given to read item %s at", request.Id)
+	}
+
+	res, err := s.e.HandleReadAt(user, fred.Item{Keygroup: fred.KeygroupName(request.Keygroup), ID: request.Id}, request.Version.Version)
+
+	if err != nil {
+		log.Debug().Msgf("API Server is returning error: %+v", err)
+		return nil, err
+	}
+
+	data := make([]*client.Item, len(res))
+
+	for i := 0; i < len(res); i++ {
+		data[i] = &client.Item{
+			Id:  res[i].ID,
+			Val: []byte(res[i].Val),
+			Version: &client.Version{
+				Version: res[i].Version.GetMap(),
+			},
+		}
+	}
+
+	return &client.ReadResponse{
+		Data: data,
+	}, nil
+}
+
 // Scan calls this method on the exthandler
 func (s *Server) Scan(ctx context.Context, request *client.ScanRequest) (*client.ScanResponse, error) {
 	log.Info().Msgf("API Server has rcvd Scan. In: %+v", request)
@@ -287,7 +522,12 @@ func (s *Server) Scan(ctx context.Context, request *client.ScanRequest) (*client
 		return nil, err
 	}
 
-	res, err := s.e.HandleScan(user, fred.Item{Keygroup: fred.KeygroupName(request.Keygroup), ID: request.Id}, request.Count)
+	res, token, err := s.e.HandleScan(user, fred.Item{Keygroup: fred.KeygroupName(request.Keygroup), ID: request.Id}, request.Count, fred.ScanOptions{
+		End:     request.End,
+		Prefix:  request.Prefix,
+		Reverse: request.Reverse,
+		Token:   request.ContinuationToken,
+	})
 
 	if err != nil {
 		log.Debug().Msgf("API Server is returning error: %+v", err)
@@ -300,7 +540,7 @@ func (s *Server) Scan(ctx context.Context, request *client.ScanRequest) (*client
 	for i := 0; i < len(res); i++ {
 		data[i] = &client.Item{
 			Id:  res[i].ID,
-			Val: res[i].Val,
+			Val: []byte(res[i].Val),
 			Version: &client.Version{
 				Version: res[i].Version.GetMap(),
 			},
@@ -308,7 +548,8 @@ func (s *Server) Scan(ctx context.Context, request *client.ScanRequest) (*client
 	}
 
 	return &client.ScanResponse{
-		Data: data,
+		Data:              data,
+		ContinuationToken: token,
 	}, nil
 
 }
@@ -358,10 +599,10 @@ func (s *Server) Append(ctx context.Context, request *client.AppendRequest) (*cl
 		return nil, err
 	}
 
-	res, err := s.e.HandleAppend(user, fred.Item{Keygroup: fred.KeygroupName(request.Keygroup), ID: strconv.FormatUint(request.Id, 10), Val: request.Data})
+	res, err := s.e.HandleAppend(user, fred.Item{Keygroup: fred.KeygroupName(request.Keygroup), ID: strconv.FormatUint(request.Id, 10), Val: string(request.Data), TTL: int(request.Ttl)})
 
 	if err != nil {
-		return nil, err
+		return nil, errorStatus(err)
 	}
 
 	return &client.AppendResponse{
@@ -385,10 +626,10 @@ func (s *Server) Update(ctx context.Context, request *client.UpdateRequest) (*cl
 		versions = append(versions, v.Version)
 	}
 
-	i, err := s.e.HandleUpdate(user, fred.Item{Keygroup: fred.KeygroupName(request.Keygroup), ID: request.Id, Val: request.Data}, versions)
+	i, err := s.e.HandleUpdate(user, fred.Item{Keygroup: fred.KeygroupName(request.Keygroup), ID: request.Id, Val: string(request.Data), TTL: int(request.Ttl)}, versions, precondition(request.Precondition))
 
 	if err != nil {
-		return nil, err
+		return nil, errorStatus(err)
 	}
 
 	return &client.UpdateResponse{
@@ -396,6 +637,94 @@ func (s *Server) Update(ctx context.Context, request *client.UpdateRequest) (*cl
 	}, nil
 }
 
+// precondition converts a precondition from a request, which may be nil.
+func precondition(p *client.Precondition) *fred.Precondition {
+	if p == nil {
+		return nil
+	}
+
+	c := &fred.Precondition{
+		IfAbsent:    p.IfAbsent,
+		IfValueHash: p.IfValueHash,
+	}
+
+	if p.IfVersion != nil {
+		c.IfVersion = vclock.VClock{}
+		for id, t := range p.IfVersion.Version {
+			c.IfVersion[id] = t
+		}
+	}
+
+	return c
+}
+
+// errorStatus gives errors from failed preconditions the FailedPrecondition code and errors from exceeded quotas the
+// ResourceExhausted code so that clients can tell them apart from other errors.
+func errorStatus(err error) error {
+	var perr *fred.PreconditionError
+	if errors.As(err, &perr) {
+		return status.Error(codes.FailedPrecondition, perr.Error())
+	}
+
+	var qerr *fred.QuotaError
+	if errors.As(err, &qerr) {
+		return status.Error(codes.ResourceExhausted, qerr.Error())
+	}
+
+	var terr *fred.TruncatedError
+	if errors.As(err, &terr) {
+		return status.Error(codes.OutOfRange, terr.Error())
+	}
+
+	return err
+}
+
+// quota converts a quota from a request, which may be nil.
+func quota(q *client.Quota) fred.Quota {
+	if q == nil {
+		return fred.Quota{}
+	}
+
+	return fred.Quota{
+		MaxBytes:        q.MaxBytes,
+		MaxItems:        q.MaxItems,
+		MaxValueSize:    q.MaxValueSize,
+		WritesPerSecond: q.WritesPerSecond,
+	}
+}
+
+// quotaResponse converts a quota for a response.
+func quotaResponse(q fred.Quota) *client.Quota {
+	return &client.Quota{
+		MaxBytes:        q.MaxBytes,
+		MaxItems:        q.MaxItems,
+		MaxValueSize:    q.MaxValueSize,
+		WritesPerSecond: q.WritesPerSecond,
+	}
+}
+
+// replicationPolicy converts a replication policy from a request, which may be nil.
+func replicationPolicy(p *client.ReplicationPolicy) fred.ReplicationPolicy {
+	if p == nil {
+		return fred.ReplicationPolicy{}
+	}
+
+	return fred.ReplicationPolicy{
+		MinReplicas:   int(p.MinReplicas),
+		Labels:        p.Labels,
+		GeohashPrefix: p.GeohashPrefix,
+	}
+}
+
+// replicationPolicyResponse converts a replication policy for a response.
+func replicationPolicyResponse(p fred.ReplicationPolicy) *client.ReplicationPolicy {
+	return &client.ReplicationPolicy{
+		MinReplicas:   int64(p.MinReplicas),
+		Labels:        p.Labels,
+		GeohashPrefix: p.GeohashPrefix,
+	}
+}
+
 // Delete calls this method on the exthandler
 func (s *Server) Delete(ctx context.Context, request *client.DeleteRequest) (*client.DeleteResponse, error) {
 	log.Info().Msgf("API Server has rcvd Delete. In: %+v", request)
@@ -411,10 +740,10 @@ func (s *Server) Delete(ctx context.Context, request *client.DeleteRequest) (*cl
 		versions = append(versions, v.Version)
 	}
 
-	i, err := s.e.HandleDelete(user, fred.Item{Keygroup: fred.KeygroupName(request.Keygroup), ID: request.Id}, versions)
+	i, err := s.e.HandleDelete(user, fred.Item{Keygroup: fred.KeygroupName(request.Keygroup), ID: request.Id}, versions, precondition(request.Precondition))
 
 	if err != nil {
-		return nil, err
+		return nil, errorStatus(err)
 	}
 
 	return &client.DeleteResponse{
@@ -422,6 +751,45 @@ func (s *Server) Delete(ctx context.Context, request *client.DeleteRequest) (*cl
 	}, nil
 }
 
+// Batch calls this method on the exthandler
+func (s *Server) Batch(ctx context.Context, request *client.BatchRequest) (*client.BatchResponse, error) {
+	log.Info().Msgf("API Server has rcvd Batch. In: %+v", request)
+
+	user, err := s.CheckCert(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]fred.Item, len(request.Operations))
+
+	for i, o := range request.Operations {
+		items[i] = fred.Item{
+			Keygroup:   fred.KeygroupName(request.Keygroup),
+			ID:         o.Id,
+			Val:        string(o.Val),
+			Tombstoned: o.Delete,
+			TTL:        int(o.Ttl),
+		}
+	}
+
+	res, err := s.e.HandleBatch(user, fred.Keygroup{Name: fred.KeygroupName(request.Keygroup)}, items)
+
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+
+	versions := make([]*client.Version, len(res))
+
+	for i, item := range res {
+		versions[i] = &client.Version{Version: item.Version.GetMap()}
+	}
+
+	return &client.BatchResponse{
+		Versions: versions,
+	}, nil
+}
+
 // AddReplica calls this method on the exthandler
 func (s *Server) AddReplica(ctx context.Context, request *client.AddReplicaRequest) (*client.Empty, error) {
 	log.Info().Msgf("API Server has rcvd AddReplica. In: %+v", request)
@@ -459,6 +827,18 @@ func (s *Server) GetKeygroupInfo(ctx context.Context, request *client.GetKeygrou
 		return nil, err
 	}
 
+	q, u, err := s.e.HandleGetKeygroupUsage(user, fred.Keygroup{Name: fred.KeygroupName(request.Keygroup)})
+
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.e.HandleGetKeygroupReplicationPolicy(user, fred.Keygroup{Name: fred.KeygroupName(request.Keygroup)})
+
+	if err != nil {
+		return nil, err
+	}
+
 	// Copy only the interesting values into a new array
 	replicas := make([]*client.KeygroupReplica, len(n))
 
@@ -473,6 +853,12 @@ func (s *Server) GetKeygroupInfo(ctx context.Context, request *client.GetKeygrou
 	return &client.GetKeygroupInfoResponse{
 		Mutable: m,
 		Replica: replicas,
+		Quota:   quotaResponse(q),
+		Usage: &client.Usage{
+			Bytes: u.Bytes,
+			Items: u.Items,
+		},
+		ReplicationPolicy: replicationPolicyResponse(p),
 	}, nil
 
 }
@@ -649,3 +1035,493 @@ func (s *Server) RemoveUser(ctx context.Context, request *client.RemoveUserReque
 
 	return &client.Empty{}, nil
 }
+
+// Watch calls this method on the exthandler and streams all changes back to the client
+func (s *Server) Watch(request *client.WatchRequest, stream client.Client_WatchServer) error {
+	log.Info().Msgf("API Server has rcvd Watch. In: %+v", request)
+
+	user, err := s.CheckCert(stream.Context())
+
+	if err != nil {
+		return err
+	}
+
+	var from vclock.VClock
+
+	if request.FromVersion != nil {
+		from = request.FromVersion.Version
+
+		// an empty version is still a version, it just means that all items should be sent
+		if from == nil {
+			from = vclock.VClock{}
+		}
+	}
+
+	return s.e.HandleWatch(user, fred.Item{Keygroup: fred.KeygroupName(request.Keygroup), ID: request.IdPrefix}, from, stream.Context().Done(), func(i fred.Item) error {
+		return stream.Send(&client.WatchEvent{
+			Id:  i.ID,
+			Val: []byte(i.Val),
+			Version: &client.Version{
+				Version: i.Version.GetMap(),
+			},
+			Tombstoned: i.Tombstoned,
+		})
+	})
+}
+
+// ReadChanges calls this method on the exthandler
+func (s *Server) ReadChanges(request *client.ReadChangesRequest, stream client.Client_ReadChangesServer) error {
+	log.Info().Msgf("API Server has rcvd ReadChanges. In: %+v", request)
+
+	user, err := s.CheckCert(stream.Context())
+
+	if err != nil {
+		return err
+	}
+
+	err = s.e.HandleReadChanges(user, fred.Keygroup{Name: fred.KeygroupName(request.Keygroup)}, request.FromSeq, request.Consumer, stream.Context().Done(), func(c fred.Change) error {
+		return stream.Send(&client.Change{
+			Seq: c.Seq,
+			Id:  c.ID,
+			Val: []byte(c.Val),
+			Version: &client.Version{
+				Version: c.Version.GetMap(),
+			},
+			Tombstoned: c.Tombstoned,
+			Append:     c.Append,
+			Origin:     string(c.Origin),
+			Time:       c.Time.UnixNano(),
+		})
+	})
+
+	if err != nil {
+		return errorStatus(err)
+	}
+
+	return nil
+}
+
+// SetChangeCursor calls this method on the exthandler
+func (s *Server) SetChangeCursor(ctx context.Context, request *client.SetChangeCursorRequest) (*client.Empty, error) {
+	log.Info().Msgf("API Server has rcvd SetChangeCursor. In: %+v", request)
+
+	user, err := s.CheckCert(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.e.HandleSetChangeCursor(user, fred.Keygroup{Name: fred.KeygroupName(request.Keygroup)}, request.Consumer, request.Seq)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &client.Empty{}, nil
+}
+
+// CloneKeygroup calls this method on the exthandler
+func (s *Server) CloneKeygroup(ctx context.Context, request *client.CloneKeygroupRequest) (*client.Empty, error) {
+	log.Info().Msgf("API Server has rcvd CloneKeygroup. In: %+v", request)
+
+	user, err := s.CheckCert(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	replicas := make(map[fred.NodeID]int, len(request.Replicas))
+
+	for id, e := range request.Replicas {
+		replicas[fred.NodeID(id)] = int(e)
+	}
+
+	err = s.e.HandleCloneKeygroup(user, fred.Keygroup{Name: fred.KeygroupName(request.Keygroup)}, fred.Keygroup{Name: fred.KeygroupName(request.Target)}, replicas)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &client.Empty{}, nil
+}
+
+// RenameKeygroup calls this method on the exthandler
+func (s *Server) RenameKeygroup(ctx context.Context, request *client.RenameKeygroupRequest) (*client.Empty, error) {
+	log.Info().Msgf("API Server has rcvd RenameKeygroup. In: %+v", request)
+
+	user, err := s.CheckCert(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.e.HandleRenameKeygroup(user, fred.Keygroup{Name: fred.KeygroupName(request.Keygroup)}, fred.Keygroup{Name: fred.KeygroupName(request.Target)})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &client.Empty{}, nil
+}
+
+// SetKeygroupQuota calls this method on the exthandler
+func (s *Server) SetKeygroupQuota(ctx context.Context, request *client.SetKeygroupQuotaRequest) (*client.Empty, error) {
+	log.Info().Msgf("API Server has rcvd SetKeygroupQuota. In: %+v", request)
+
+	user, err := s.CheckCert(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.e.HandleSetKeygroupQuota(user, fred.Keygroup{Name: fred.KeygroupName(request.Keygroup), Quota: quota(request.Quota)})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &client.Empty{}, nil
+}
+
+// SetKeygroupReplicationPolicy calls this method on the exthandler
+func (s *Server) SetKeygroupReplicationPolicy(ctx context.Context, request *client.SetKeygroupReplicationPolicyRequest) (*client.Empty, error) {
+	log.Info().Msgf("API Server has rcvd SetKeygroupReplicationPolicy. In: %+v", request)
+
+	user, err := s.CheckCert(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.e.HandleSetKeygroupReplicationPolicy(user, fred.Keygroup{Name: fred.KeygroupName(request.Keygroup), ReplicationPolicy: replicationPolicy(request.ReplicationPolicy)})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &client.Empty{}, nil
+}
+
+// GetNearestReplicas calls this method on the exthandler
+func (s *Server) GetNearestReplicas(ctx context.Context, request *client.GetNearestReplicasRequest) (*client.GetNearestReplicasResponse, error) {
+	log.Info().Msgf("API Server has rcvd GetNearestReplicas. In: %+v", request)
+
+	user, err := s.CheckCert(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.e.HandleGetNearestReplicas(user, fred.Keygroup{Name: fred.KeygroupName(request.Keygroup)}, fred.Location{Lat: request.Lat, Lng: request.Lng}, int(request.N))
+
+	if err != nil {
+		log.Debug().Msgf("API Server is returning error: %+v", err)
+		return nil, err
+	}
+
+	replicas := make([]*client.NearestReplica, len(res))
+
+	for i, r := range res {
+		replicas[i] = &client.NearestReplica{
+			NodeId:   string(r.ID),
+			Host:     r.Host,
+			Expiry:   int64(r.Expiry),
+			Distance: r.Distance,
+		}
+	}
+
+	return &client.GetNearestReplicasResponse{Replicas: replicas}, nil
+}
+
+// ProposeReplicas calls this method on the exthandler
+func (s *Server) ProposeReplicas(ctx context.Context, request *client.ProposeReplicasRequest) (*client.ProposeReplicasResponse, error) {
+	log.Info().Msgf("API Server has rcvd ProposeReplicas. In: %+v", request)
+
+	user, err := s.CheckCert(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	locations := make([]fred.Location, len(request.Locations))
+
+	for i, l := range request.Locations {
+		locations[i] = fred.Location{Lat: l.Lat, Lng: l.Lng}
+	}
+
+	res, mean, err := s.e.HandleProposeReplicas(user, fred.Keygroup{Name: fred.KeygroupName(request.Keygroup)}, locations, int(request.N))
+
+	if err != nil {
+		log.Debug().Msgf("API Server is returning error: %+v", err)
+		return nil, err
+	}
+
+	replicas := make([]*client.Replica, len(res))
+
+	for i, r := range res {
+		replicas[i] = &client.Replica{
+			NodeId: string(r.ID),
+			Host:   r.Host,
+		}
+	}
+
+	return &client.ProposeReplicasResponse{Replicas: replicas, MeanDistance: mean}, nil
+}
+
+// DecommissionNode calls this method on the exthandler and streams its progress back to the client
+func (s *Server) DecommissionNode(request *client.DecommissionNodeRequest, stream client.Client_DecommissionNodeServer) error {
+	log.Info().Msgf("API Server has rcvd DecommissionNode. In: %+v", request)
+
+	user, err := s.CheckCert(stream.Context())
+
+	if err != nil {
+		return err
+	}
+
+	return s.e.HandleDecommissionNode(user, fred.Node{ID: fred.NodeID(request.NodeId)}, func(p fred.DecommissionProgress) error {
+		var step client.DecommissionStep
+
+		switch p.Step {
+		case fred.DecommissionStarted:
+			step = client.DecommissionStep_Started
+		case fred.DecommissionReplicaAdded:
+			step = client.DecommissionStep_ReplicaAdded
+		case fred.DecommissionReplicaRemoved:
+			step = client.DecommissionStep_ReplicaRemoved
+		case fred.DecommissionDeregistered:
+			step = client.DecommissionStep_Deregistered
+		}
+
+		return stream.Send(&client.DecommissionProgress{
+			Step:     step,
+			Keygroup: string(p.Keygroup),
+			Replica:  string(p.Replica),
+			Done:     int64(p.Done),
+			Total:    int64(p.Total),
+		})
+	})
+}
+
+// ExportKeygroup calls this method on the exthandler
+func (s *Server) ExportKeygroup(request *client.ExportKeygroupRequest, stream client.Client_ExportKeygroupServer) error {
+	log.Info().Msgf("API Server has rcvd ExportKeygroup. In: %+v", request)
+
+	user, err := s.CheckCert(stream.Context())
+
+	if err != nil {
+		return err
+	}
+
+	return s.e.HandleExportKeygroup(user, fred.Keygroup{Name: fred.KeygroupName(request.Keygroup)}, func(a fred.KeygroupArchive) error {
+		return stream.Send(&client.ArchiveChunk{
+			Chunk: &client.ArchiveChunk_Header{
+				Header: headerFromArchive(a),
+			},
+		})
+	}, func(items []fred.Item) error {
+		data := make([]*client.ArchiveItem, len(items))
+
+		for i := range items {
+			data[i] = &client.ArchiveItem{
+				Id:  items[i].ID,
+				Val: []byte(items[i].Val),
+				Version: &client.Version{
+					Version: items[i].Version.GetMap(),
+				},
+				Tombstoned: items[i].Tombstoned,
+			}
+		}
+
+		return stream.Send(&client.ArchiveChunk{
+			Chunk: &client.ArchiveChunk_Items{
+				Items: &client.ArchiveItems{
+					Items: data,
+				},
+			},
+		})
+	})
+}
+
+// ImportKeygroup calls this method on the exthandler
+func (s *Server) ImportKeygroup(stream client.Client_ImportKeygroupServer) error {
+	log.Info().Msg("API Server has rcvd ImportKeygroup.")
+
+	user, err := s.CheckCert(stream.Context())
+
+	if err != nil {
+		return err
+	}
+
+	first, err := stream.Recv()
+
+	if err != nil {
+		return err
+	}
+
+	header := first.Chunk.GetHeader()
+
+	if header == nil {
+		return errors.Errorf("the first request of an import has to have the header of the archive")
+	}
+
+	a := archiveFromHeader(header)
+
+	if first.Keygroup != "" {
+		a.Name = fred.KeygroupName(first.Keygroup)
+	}
+
+	res, err := s.e.HandleImportKeygroup(user, a, first.Replace, func() ([]fred.Item, error) {
+		for {
+			request, err := stream.Recv()
+
+			if err == io.EOF {
+				return nil, nil
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			page := request.Chunk.GetItems()
+
+			if page == nil {
+				return nil, errors.Errorf("expected items of the archive")
+			}
+
+			// an empty page would end the import
+			if len(page.Items) == 0 {
+				continue
+			}
+
+			items := make([]fred.Item, len(page.Items))
+
+			for i, item := range page.Items {
+				if item.Version == nil {
+					return nil, errors.Errorf("item %s of the archive has no version", item.Id)
+				}
+
+				items[i] = fred.Item{
+					ID:         item.Id,
+					Val:        string(item.Val),
+					Version:    item.Version.Version,
+					Tombstoned: item.Tombstoned,
+				}
+			}
+
+			return items, nil
+		}
+	})
+
+	if err != nil {
+		log.Debug().Msgf("API Server is returning error: %+v", err)
+		return errorStatus(err)
+	}
+
+	response := &client.ImportKeygroupResponse{
+		Versions: uint64(res.Versions),
+		Deleted:  uint64(res.Deleted),
+		Replicas: make([]string, len(res.Replicas)),
+		Skipped:  make([]string, len(res.Skipped)),
+	}
+
+	for i, id := range res.Replicas {
+		response.Replicas[i] = string(id)
+	}
+
+	for i, id := range res.Skipped {
+		response.Skipped[i] = string(id)
+	}
+
+	return stream.SendAndClose(response)
+}
+
+// headerFromArchive converts the configuration of an exported keygroup to the header of an archive.
+func headerFromArchive(a fred.KeygroupArchive) *client.ArchiveHeader {
+	header := &client.ArchiveHeader{
+		Keygroup:          string(a.Name),
+		Mutable:           a.Mutable,
+		Source:            string(a.Source),
+		Expiries:          make(map[string]int64, len(a.Expiries)),
+		Indexes:           a.Indexes,
+		ConflictPolicy:    string(a.ConflictPolicy),
+		Type:              string(a.Type),
+		HistoryVersions:   int64(a.History.Versions),
+		HistoryWindow:     int64(a.History.Window),
+		Quota:             quotaResponse(a.Quota),
+		Compression:       string(a.Compression),
+		ReplicationPolicy: replicationPolicyResponse(a.ReplicationPolicy),
+		Triggers:          make([]*client.Trigger, len(a.Triggers)),
+		Users:             make([]*client.ArchiveUser, 0, len(a.Permissions)),
+	}
+
+	for id, e := range a.Expiries {
+		header.Expiries[string(id)] = int64(e)
+	}
+
+	for i, t := range a.Triggers {
+		header.Triggers[i] = &client.Trigger{
+			Id:   t.ID,
+			Host: t.Host,
+		}
+	}
+
+	for u, methods := range a.Permissions {
+		user := &client.ArchiveUser{
+			User:    u,
+			Methods: make([]string, len(methods)),
+		}
+
+		for i, m := range methods {
+			user.Methods[i] = string(m)
+		}
+
+		header.Users = append(header.Users, user)
+	}
+
+	return header
+}
+
+// archiveFromHeader converts the header of an archive to the configuration of a keygroup that is imported.
+func archiveFromHeader(header *client.ArchiveHeader) fred.KeygroupArchive {
+	a := fred.KeygroupArchive{
+		Keygroup: fred.Keygroup{
+			Name:           fred.KeygroupName(header.Keygroup),
+			Mutable:        header.Mutable,
+			Indexes:        header.Indexes,
+			ConflictPolicy: fred.ConflictPolicy(header.ConflictPolicy),
+			Type:           fred.KeygroupType(header.Type),
+			History: fred.HistoryRetention{
+				Versions: int(header.HistoryVersions),
+				Window:   int(header.HistoryWindow),
+			},
+			Quota:             quota(header.Quota),
+			Compression:       fred.Compression(header.Compression),
+			ReplicationPolicy: replicationPolicy(header.ReplicationPolicy),
+		},
+		Source:      fred.NodeID(header.Source),
+		Expiries:    make(map[fred.NodeID]int, len(header.Expiries)),
+		Triggers:    make([]fred.Trigger, len(header.Triggers)),
+		Permissions: make(map[string][]fred.Method, len(header.Users)),
+	}
+
+	for id, e := range header.Expiries {
+		a.Expiries[fred.NodeID(id)] = int(e)
+	}
+
+	a.Expiry = a.Expiries[a.Source]
+
+	for i, t := range header.Triggers {
+		a.Triggers[i] = fred.Trigger{
+			ID:   t.Id,
+			Host: t.Host,
+		}
+	}
+
+	for _, u := range header.Users {
+		for _, m := range u.Methods {
+			a.Permissions[u.User] = append(a.Permissions[u.User], fred.Method(m))
+		}
+	}
+
+	return a
+}
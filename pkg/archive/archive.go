@@ -0,0 +1,280 @@
+// Package archive reads and writes keygroup archives, files with the configuration and all items of a keygroup as they
+// are exported by a FReD node.
+//
+// An archive starts with the magic bytes "FREDKGAR" and the version of the format as a big-endian uint32. The chunks of
+// the export follow, each as its length as a big-endian uint32 and the protobuf encoding of a client.ArchiveChunk. The
+// first chunk is the header with the configuration of the keygroup, all further chunks have items. The archive ends
+// with a length of 0, the number of items in the archive as a big-endian uint64, and the SHA-256 checksum of
+// everything before the checksum.
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"io"
+
+	"git.tu-berlin.de/mcc-fred/fred/proto/client"
+	"github.com/go-errors/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// Version is the version of the archive format that is written. Archives with a newer version cannot be read.
+const Version uint32 = 1
+
+// maxChunkSize is the largest chunk that is read, so that a corrupted length does not make us allocate all memory.
+const maxChunkSize = 256 << 20
+
+var magic = []byte("FREDKGAR")
+
+// ErrChecksum is returned when the checksum of an archive does not match its contents.
+var ErrChecksum = errors.Errorf("archive checksum does not match its contents")
+
+// Writer writes a keygroup archive.
+type Writer struct {
+	w      *bufio.Writer
+	h      hash.Hash
+	out    io.Writer
+	items  uint64
+	header bool
+}
+
+// NewWriter creates a new Writer that writes an archive to w. The archive is only complete once the Writer is closed.
+func NewWriter(w io.Writer) (*Writer, error) {
+	a := &Writer{
+		w: bufio.NewWriter(w),
+		h: sha256.New(),
+	}
+
+	a.out = io.MultiWriter(a.w, a.h)
+
+	if _, err := a.out.Write(magic); err != nil {
+		return nil, errors.New(err)
+	}
+
+	if err := binary.Write(a.out, binary.BigEndian, Version); err != nil {
+		return nil, errors.New(err)
+	}
+
+	return a, nil
+}
+
+// Write adds a chunk to the archive. The first chunk has to be the header, all further chunks have to have items.
+func (a *Writer) Write(c *client.ArchiveChunk) error {
+	if c.GetHeader() != nil {
+		if a.header {
+			return errors.Errorf("archive already has a header")
+		}
+
+		a.header = true
+	} else {
+		if !a.header {
+			return errors.Errorf("archive has to start with a header")
+		}
+
+		if c.GetItems() == nil {
+			return errors.Errorf("archive chunk has neither a header nor items")
+		}
+
+		a.items += uint64(len(c.GetItems().Items))
+	}
+
+	data, err := proto.Marshal(c)
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	if len(data) == 0 || len(data) > maxChunkSize {
+		return errors.Errorf("archive chunk has an invalid size of %d bytes", len(data))
+	}
+
+	if err := binary.Write(a.out, binary.BigEndian, uint32(len(data))); err != nil {
+		return errors.New(err)
+	}
+
+	if _, err := a.out.Write(data); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+// Close ends the archive with the number of items and the checksum and flushes it. It does not close the underlying
+// writer.
+func (a *Writer) Close() error {
+	if !a.header {
+		return errors.Errorf("archive has to start with a header")
+	}
+
+	if err := binary.Write(a.out, binary.BigEndian, uint32(0)); err != nil {
+		return errors.New(err)
+	}
+
+	if err := binary.Write(a.out, binary.BigEndian, a.items); err != nil {
+		return errors.New(err)
+	}
+
+	if _, err := a.w.Write(a.h.Sum(nil)); err != nil {
+		return errors.New(err)
+	}
+
+	if err := a.w.Flush(); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+// Reader reads a keygroup archive.
+type Reader struct {
+	r      io.Reader
+	h      hash.Hash
+	in     io.Reader
+	items  uint64
+	header bool
+	done   bool
+}
+
+// NewReader creates a new Reader that reads an archive from r. It fails if r is not an archive or if the archive has a
+// version that we cannot read.
+func NewReader(r io.Reader) (*Reader, error) {
+	a := &Reader{
+		r: bufio.NewReader(r),
+		h: sha256.New(),
+	}
+
+	a.in = io.TeeReader(a.r, a.h)
+
+	m := make([]byte, len(magic))
+
+	if _, err := io.ReadFull(a.in, m); err != nil || !bytes.Equal(m, magic) {
+		return nil, errors.Errorf("not a keygroup archive")
+	}
+
+	var v uint32
+
+	if err := binary.Read(a.in, binary.BigEndian, &v); err != nil {
+		return nil, errors.Errorf("not a keygroup archive")
+	}
+
+	if v == 0 || v > Version {
+		return nil, errors.Errorf("cannot read keygroup archive of version %d, only up to version %d", v, Version)
+	}
+
+	return a, nil
+}
+
+// Next returns the next chunk of the archive, starting with the header. At the end of the archive, it checks the
+// number of items and the checksum and returns io.EOF if they match or ErrChecksum if they don't. Chunks are returned
+// before the checksum is checked, so use Verify to check an archive before you use its contents.
+func (a *Reader) Next() (*client.ArchiveChunk, error) {
+	if a.done {
+		return nil, io.EOF
+	}
+
+	var l uint32
+
+	if err := binary.Read(a.in, binary.BigEndian, &l); err != nil {
+		return nil, errors.Errorf("archive is truncated: %v", err)
+	}
+
+	if l == 0 {
+		return nil, a.end()
+	}
+
+	if l > maxChunkSize {
+		return nil, errors.Errorf("archive chunk has an invalid size of %d bytes", l)
+	}
+
+	data := make([]byte, l)
+
+	if _, err := io.ReadFull(a.in, data); err != nil {
+		return nil, errors.Errorf("archive is truncated: %v", err)
+	}
+
+	c := &client.ArchiveChunk{}
+
+	if err := proto.Unmarshal(data, c); err != nil {
+		return nil, errors.New(err)
+	}
+
+	if c.GetHeader() != nil {
+		if a.header {
+			return nil, errors.Errorf("archive has more than one header")
+		}
+
+		a.header = true
+
+		return c, nil
+	}
+
+	if !a.header {
+		return nil, errors.Errorf("archive does not start with a header")
+	}
+
+	if c.GetItems() == nil {
+		return nil, errors.Errorf("archive chunk has neither a header nor items")
+	}
+
+	a.items += uint64(len(c.GetItems().Items))
+
+	return c, nil
+}
+
+// end reads the end of the archive and checks it.
+func (a *Reader) end() error {
+	a.done = true
+
+	if !a.header {
+		return errors.Errorf("archive does not start with a header")
+	}
+
+	var items uint64
+
+	if err := binary.Read(a.in, binary.BigEndian, &items); err != nil {
+		return errors.Errorf("archive is truncated: %v", err)
+	}
+
+	expected := a.h.Sum(nil)
+	checksum := make([]byte, len(expected))
+
+	if _, err := io.ReadFull(a.r, checksum); err != nil {
+		return errors.Errorf("archive is truncated: %v", err)
+	}
+
+	if items != a.items || !bytes.Equal(checksum, expected) {
+		return ErrChecksum
+	}
+
+	return io.EOF
+}
+
+// Verify reads a whole archive and checks it. It returns the header and the number of items of the archive.
+func Verify(r io.Reader) (*client.ArchiveHeader, uint64, error) {
+	a, err := NewReader(r)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var header *client.ArchiveHeader
+
+	for {
+		c, err := a.Next()
+
+		if err == io.EOF {
+			return header, a.items, nil
+		}
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if c.GetHeader() != nil {
+			header = c.GetHeader()
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package archive
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"git.tu-berlin.de/mcc-fred/fred/proto/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func write(t *testing.T) []byte {
+	var buf bytes.Buffer
+
+	w, err := NewWriter(&buf)
+	assert.NoError(t, err)
+
+	assert.Error(t, w.Write(&client.ArchiveChunk{Chunk: &client.ArchiveChunk_Items{Items: &client.ArchiveItems{}}}))
+
+	assert.NoError(t, w.Write(&client.ArchiveChunk{Chunk: &client.ArchiveChunk_Header{Header: &client.ArchiveHeader{
+		Keygroup: "kg",
+		Mutable:  true,
+		Source:   "A",
+		Expiries: map[string]int64{"A": 0, "B": 60},
+	}}}))
+
+	for _, id := range []string{"a", "b"} {
+		assert.NoError(t, w.Write(&client.ArchiveChunk{Chunk: &client.ArchiveChunk_Items{Items: &client.ArchiveItems{
+			Items: []*client.ArchiveItem{
+				{Id: id, Val: []byte{0, 1}, Version: &client.Version{Version: map[string]uint64{"A": 1}}},
+				{Id: id, Tombstoned: true, Version: &client.Version{Version: map[string]uint64{"B": 1}}},
+			},
+		}}}))
+	}
+
+	assert.NoError(t, w.Close())
+
+	return buf.Bytes()
+}
+
+func TestArchive(t *testing.T) {
+	data := write(t)
+
+	r, err := NewReader(bytes.NewReader(data))
+	assert.NoError(t, err)
+
+	c, err := r.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "kg", c.GetHeader().Keygroup)
+	assert.Equal(t, int64(60), c.GetHeader().Expiries["B"])
+
+	for _, id := range []string{"a", "b"} {
+		c, err = r.Next()
+		assert.NoError(t, err)
+		assert.Len(t, c.GetItems().Items, 2)
+		assert.Equal(t, id, c.GetItems().Items[0].Id)
+		assert.Equal(t, []byte{0, 1}, c.GetItems().Items[0].Val)
+		assert.True(t, c.GetItems().Items[1].Tombstoned)
+	}
+
+	_, err = r.Next()
+	assert.Equal(t, io.EOF, err)
+
+	header, items, err := Verify(bytes.NewReader(data))
+	assert.NoError(t, err)
+	assert.Equal(t, "kg", header.Keygroup)
+	assert.Equal(t, uint64(4), items)
+}
+
+func TestCorruptArchive(t *testing.T) {
+	data := write(t)
+
+	// flip a bit in the value of an item
+	corrupt := bytes.Clone(data)
+	start := len(magic) + 4
+	corrupt[start+bytes.Index(corrupt[start:], []byte{0, 1})+1] ^= 1
+
+	_, _, err := Verify(bytes.NewReader(corrupt))
+	assert.ErrorIs(t, err, ErrChecksum)
+
+	_, _, err = Verify(bytes.NewReader(data[:len(data)-10]))
+	assert.Error(t, err)
+
+	_, _, err = Verify(bytes.NewReader([]byte("something else")))
+	assert.Error(t, err)
+
+	// archives of a newer version cannot be read
+	newer := bytes.Clone(data)
+	newer[len(magic)+3] = byte(Version + 1)
+
+	_, err = NewReader(bytes.NewReader(newer))
+	assert.Error(t, err)
+}
@@ -15,6 +15,9 @@ const sep = "|"
 const gcInterval = 5 * time.Minute
 const gcDiscardRatio = 0.7
 
+// tombstoneMeta is the bit in the user metadata of an entry that marks a version as a tombstone.
+const tombstoneMeta byte = 1 << 0
+
 // Storage is a struct that saves all necessary information to access the database, in this case just a pointer to the BadgerDB database.
 type Storage struct {
 	db  *badger.DB
@@ -48,6 +51,27 @@ func makeLogConfigKeyName(kgname string) []byte {
 	return []byte(sep + "fred" + sep + "rolling" + sep + kgname)
 }
 
+// makeEntry creates the BadgerDB entry for a version of an item. Tombstones are marked in the user metadata of the
+// entry so that they can be told apart from empty values.
+func makeEntry(key []byte, val string, tombstoned bool, expiry int) *badger.Entry {
+	e := badger.NewEntry(key, []byte(val))
+
+	if tombstoned {
+		e = e.WithMeta(tombstoneMeta)
+	}
+
+	if expiry > 0 {
+		e.ExpiresAt = uint64(time.Now().Unix()) + uint64(expiry)
+	}
+
+	return e
+}
+
+// isTombstone returns whether a version of an item is a tombstone.
+func isTombstone(item *badger.Item) bool {
+	return item.UserMeta()&tombstoneMeta != 0
+}
+
 // getTriggerConfigKey returns the keygroup and id of a key.
 func getTriggerConfigKey(key string) (kg, tid string) {
 	s := strings.Split(key, sep)
@@ -98,6 +122,10 @@ func New(dbPath string) (s *Storage) {
 		seq: make(map[string]*badger.Sequence),
 	}
 
+	if err := s.migrate(); err != nil {
+		panic(err)
+	}
+
 	go garbageCollection(db)
 
 	return
@@ -115,6 +143,10 @@ func NewMemory() (s *Storage) {
 		seq: make(map[string]*badger.Sequence),
 	}
 
+	if err := s.migrate(); err != nil {
+		panic(err)
+	}
+
 	go garbageCollection(db)
 
 	return
@@ -126,8 +158,9 @@ func (s *Storage) Close() error {
 }
 
 // Read returns an item with the specified id from the specified keygroup.
-func (s *Storage) Read(kg string, id string) ([]string, []vclock.VClock, bool, error) {
+func (s *Storage) Read(kg string, id string) ([]string, []bool, []vclock.VClock, bool, error) {
 	values := make([]string, 0)
+	tombstones := make([]bool, 0)
 	vvectors := make([]vclock.VClock, 0)
 
 	err := s.db.View(func(txn *badger.Txn) error {
@@ -150,6 +183,7 @@ func (s *Storage) Read(kg string, id string) ([]string, []vclock.VClock, bool, e
 			}
 
 			values = append(values, string(v))
+			tombstones = append(tombstones, isTombstone(item))
 			vvectors = append(vvectors, vvector)
 		}
 
@@ -159,24 +193,25 @@ func (s *Storage) Read(kg string, id string) ([]string, []vclock.VClock, bool, e
 	if err != nil {
 		// if the error is a "KeyNotFound", there is no need to add a stacktrace
 		if errors.Is(err, badger.ErrKeyNotFound) {
-			return nil, nil, false, nil
+			return nil, nil, nil, false, nil
 		}
 		// if we have a different error, debug with full stacktrace
-		return nil, nil, false, errors.New(err)
+		return nil, nil, nil, false, errors.New(err)
 	}
 
 	if len(values) == 0 {
-		return nil, nil, false, nil
+		return nil, nil, nil, false, nil
 	}
 
-	return values, vvectors, true, nil
+	return values, tombstones, vvectors, true, nil
 
 }
 
 // ReadSome returns count number of items in the specified keygroup starting at id.
-func (s *Storage) ReadSome(kg, id string, count uint64) ([]string, []string, []vclock.VClock, error) {
+func (s *Storage) ReadSome(kg, id string, count uint64) ([]string, []string, []bool, []vclock.VClock, error) {
 	keys := make([]string, 0)
 	items := make([]string, 0)
+	tombstones := make([]bool, 0)
 	vvectors := make([]vclock.VClock, 0)
 
 	err := s.db.View(func(txn *badger.Txn) error {
@@ -202,6 +237,7 @@ func (s *Storage) ReadSome(kg, id string, count uint64) ([]string, []string, []v
 
 			keys = append(keys, key)
 			items = append(items, string(v))
+			tombstones = append(tombstones, isTombstone(item))
 			vvectors = append(vvectors, vvector)
 
 			i++
@@ -211,16 +247,81 @@ func (s *Storage) ReadSome(kg, id string, count uint64) ([]string, []string, []v
 	})
 
 	if err != nil {
-		return nil, nil, nil, errors.New(err)
+		return nil, nil, nil, nil, errors.New(err)
 	}
 
-	return keys, items, vvectors, nil
+	return keys, items, tombstones, vvectors, nil
+}
+
+// ReadPage returns all versions of the next count items in the specified keygroup that come after id.
+// Note that items are ordered by their internal key, which is not necessarily the lexicographic order of their ids.
+func (s *Storage) ReadPage(kg string, after string, count uint64) ([]string, []string, []bool, []vclock.VClock, error) {
+	keys := make([]string, 0)
+	items := make([]string, 0)
+	tombstones := make([]bool, 0)
+	vvectors := make([]vclock.VClock, 0)
+
+	err := s.db.View(func(txn *badger.Txn) error {
+		prefix := makeKeygroupKeyName(kg)
+		start := prefix
+
+		if after != "" {
+			start = makeKeyNamePrefix(kg, after)
+		}
+
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		var i uint64
+		curr := ""
+		for it.Seek(start); it.ValidForPrefix(prefix); it.Next() {
+			item := it.Item()
+			_, key, vvector := getKey(string(item.Key()))
+
+			// all versions of the item we start after come first, skip those
+			if after != "" && key == after {
+				continue
+			}
+
+			// only stop once all versions of the last item are in this page
+			if key != curr {
+				if i == count {
+					break
+				}
+				curr = key
+				i++
+			}
+
+			v, err := item.ValueCopy(nil)
+
+			if err != nil {
+				return err
+			}
+
+			keys = append(keys, key)
+			items = append(items, string(v))
+			tombstones = append(tombstones, isTombstone(item))
+			vvectors = append(vvectors, vvector)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, nil, nil, errors.New(err)
+	}
+
+	return keys, items, tombstones, vvectors, nil
 }
 
 // ReadAll returns all items in the specified keygroup.
-func (s *Storage) ReadAll(kg string) ([]string, []string, []vclock.VClock, error) {
+func (s *Storage) ReadAll(kg string) ([]string, []string, []bool, []vclock.VClock, error) {
 	keys := make([]string, 0)
 	items := make([]string, 0)
+	tombstones := make([]bool, 0)
 	vvectors := make([]vclock.VClock, 0)
 
 	err := s.db.View(func(txn *badger.Txn) error {
@@ -244,6 +345,7 @@ func (s *Storage) ReadAll(kg string) ([]string, []string, []vclock.VClock, error
 
 			keys = append(keys, key)
 			items = append(items, string(v))
+			tombstones = append(tombstones, isTombstone(item))
 			vvectors = append(vvectors, vvector)
 
 		}
@@ -252,10 +354,10 @@ func (s *Storage) ReadAll(kg string) ([]string, []string, []vclock.VClock, error
 	})
 
 	if err != nil {
-		return nil, nil, nil, errors.New(err)
+		return nil, nil, nil, nil, errors.New(err)
 	}
 
-	return keys, items, vvectors, nil
+	return keys, items, tombstones, vvectors, nil
 }
 
 // IDs returns the keys of all items in the specified keygroup.
@@ -293,27 +395,42 @@ func (s *Storage) IDs(kg string) ([]string, error) {
 }
 
 // Update updates the item with the specified id in the specified keygroup.
-func (s *Storage) Update(kg, id, val string, expiry int, vvector vclock.VClock) error {
+func (s *Storage) Update(kg, id, val string, tombstoned bool, expiry int, vvector vclock.VClock) error {
 	err := s.db.Update(func(txn *badger.Txn) error {
-		key := makeKeyName(kg, id, vvector)
+		return txn.SetEntry(makeEntry(makeKeyName(kg, id, vvector), val, tombstoned, expiry))
+	})
 
-		if expiry > 0 {
-			err := txn.SetEntry(&badger.Entry{
-				Key:       key,
-				Value:     []byte(val),
-				ExpiresAt: uint64(time.Now().Unix()) + uint64(expiry),
-			})
-			if err != nil {
+	if err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+// UpdateBatch stores new versions of several items and removes the versions they replace in a single transaction.
+// Replaced versions are kept in the history of their items if the keygroup keeps one.
+func (s *Storage) UpdateBatch(kg string, ids []string, vals []string, tombstones []bool, expiries []int, vvectors []vclock.VClock, replaced [][]vclock.VClock) error {
+	err := s.db.Update(func(txn *badger.Txn) error {
+		for i := range ids {
+			for _, v := range replaced[i] {
+				if len(v) == 0 {
+					continue
+				}
+
+				if err := archive(txn, kg, ids[i], v); err != nil {
+					return err
+				}
+
+				if err := txn.Delete(makeKeyName(kg, ids[i], v)); err != nil {
+					return err
+				}
+			}
+
+			if err := txn.SetEntry(makeEntry(makeKeyName(kg, ids[i], vvectors[i]), vals[i], tombstones[i], expiries[i])); err != nil {
 				return err
 			}
-			return nil
 		}
 
-		err := txn.Set(key, []byte(val))
-
-		if err != nil {
-			return err
-		}
 		return nil
 	})
 
@@ -325,9 +442,14 @@ func (s *Storage) Update(kg, id, val string, expiry int, vvector vclock.VClock)
 }
 
 // Delete deletes the item with the specified id from the specified keygroup. Will delete a specific version or all versions if vvector is nil.
+// A specific version is kept in the history of the item if the keygroup keeps one.
 func (s *Storage) Delete(kg string, id string, vvector vclock.VClock) error {
 	if vvector != nil {
 		err := s.db.Update(func(txn *badger.Txn) error {
+			if err := archive(txn, kg, id, vvector); err != nil {
+				return err
+			}
+
 			err := txn.Delete(makeKeyName(kg, id, vvector))
 			if err != nil {
 				return err
@@ -592,6 +714,10 @@ func (s *Storage) DeleteKeygroup(kg string) error {
 		return errors.New(err)
 	}
 
+	if err := s.deleteHistory(kg); err != nil {
+		return err
+	}
+
 	if seq, ok := s.seq[kg]; ok {
 		err = seq.Release()
 
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/dgraph-io/badger/v3"
 	"github.com/go-errors/errors"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
@@ -99,13 +100,13 @@ func TestReadSome(t *testing.T) {
 		ids[i] = "id" + strconv.Itoa(i)
 		vals[i] = "val" + strconv.Itoa(i)
 
-		err = db.Update(kg, ids[i], vals[i], 0, vclock.VClock{})
+		err = db.Update(kg, ids[i], vals[i], false, 0, vclock.VClock{})
 
 		assert.NoError(t, err)
 
 	}
 
-	keys, values, _, err := db.ReadSome(kg, "id"+strconv.Itoa(scanStart), uint64(scanRange))
+	keys, values, _, _, err := db.ReadSome(kg, "id"+strconv.Itoa(scanStart), uint64(scanRange))
 
 	assert.NoError(t, err)
 
@@ -120,21 +121,133 @@ func TestReadSome(t *testing.T) {
 	}
 }
 
+func TestReadPage(t *testing.T) {
+	kg := "test-kg-page"
+	updates := 10
+	pageSize := 3
+
+	err := db.CreateKeygroup(kg)
+
+	assert.NoError(t, err)
+
+	ids := make([]string, updates)
+
+	for i := 0; i < updates; i++ {
+		ids[i] = "id" + strconv.Itoa(i)
+
+		err = db.Update(kg, ids[i], "val"+strconv.Itoa(i), false, 0, vclock.VClock{"a": 1})
+
+		assert.NoError(t, err)
+	}
+
+	// add a concurrent version to one item, both versions need to end up in the same page
+	err = db.Update(kg, ids[2], "val2b", false, 0, vclock.VClock{"b": 1})
+
+	assert.NoError(t, err)
+
+	seen := make(map[string]int)
+	after := ""
+	pages := 0
+
+	for {
+		keys, values, _, versions, err := db.ReadPage(kg, after, uint64(pageSize))
+
+		assert.NoError(t, err)
+		assert.Len(t, values, len(keys))
+		assert.Len(t, versions, len(keys))
+
+		if len(keys) == 0 {
+			break
+		}
+
+		for _, k := range keys {
+			seen[k]++
+		}
+
+		after = keys[len(keys)-1]
+		pages++
+	}
+
+	assert.Equal(t, 4, pages)
+	assert.Len(t, seen, updates)
+	assert.Equal(t, 2, seen[ids[2]])
+
+	for _, id := range ids {
+		assert.Contains(t, seen, id)
+	}
+}
+
+func TestReadRange(t *testing.T) {
+	kg := "test-kg-range"
+
+	err := db.CreateKeygroup(kg)
+
+	assert.NoError(t, err)
+
+	// ids that are prefixes of other ids are stored after them in BadgerDB
+	for _, id := range []string{"a", "ab", "ab1", "ab2", "abc", "b", "b1"} {
+		err = db.Update(kg, id, "val"+id, false, 0, vclock.VClock{"X": 1})
+		assert.NoError(t, err)
+	}
+
+	// a second version of ab
+	err = db.Update(kg, "ab", "valab2", false, 0, vclock.VClock{"Y": 1})
+	assert.NoError(t, err)
+
+	ids := func(start, end, prefix string, reverse bool, count uint64) []string {
+		keys, values, _, vvectors, err := db.ReadRange(kg, start, end, prefix, reverse, count)
+		assert.NoError(t, err)
+		assert.Len(t, values, len(keys))
+		assert.Len(t, vvectors, len(keys))
+
+		result := make([]string, 0)
+		for i, k := range keys {
+			if i == 0 || keys[i-1] != k {
+				result = append(result, k)
+			}
+		}
+
+		return result
+	}
+
+	assert.Equal(t, []string{"a", "ab", "ab1", "ab2", "abc", "b", "b1"}, ids("", "", "", false, 100))
+	assert.Equal(t, []string{"b1", "b", "abc", "ab2", "ab1", "ab", "a"}, ids("", "", "", true, 100))
+
+	assert.Equal(t, []string{"a", "ab"}, ids("", "", "", false, 2))
+	assert.Equal(t, []string{"b1", "b"}, ids("", "", "", true, 2))
+
+	assert.Equal(t, []string{"ab1", "ab2", "abc"}, ids("ab1", "b", "", false, 100))
+	assert.Equal(t, []string{"abc", "ab2", "ab1"}, ids("ab1", "b", "", true, 100))
+	assert.Equal(t, []string{"ab", "ab1"}, ids("aa", "ab2", "", false, 100))
+	assert.Equal(t, []string{"ab1", "ab", "a"}, ids("", "ab2", "", true, 100))
+
+	assert.Equal(t, []string{"ab", "ab1", "ab2", "abc"}, ids("", "", "ab", false, 100))
+	assert.Equal(t, []string{"abc", "ab2", "ab1", "ab"}, ids("", "", "ab", true, 100))
+	assert.Equal(t, []string{"ab2", "abc"}, ids("ab2", "", "ab", false, 100))
+	assert.Equal(t, []string{"ab1", "ab"}, ids("", "ab2", "ab", true, 100))
+	assert.Equal(t, []string{}, ids("", "", "c", false, 100))
+
+	keys, values, _, _, err := db.ReadRange(kg, "ab", "ab1", "", false, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ab", "ab"}, keys)
+	assert.ElementsMatch(t, []string{"valab", "valab2"}, values)
+}
+
 func TestReadAll(t *testing.T) {
 	kg := "test-read-all"
 	err := db.CreateKeygroup(kg)
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg, "id-1", "data-1", 0, vclock.VClock{})
+	err = db.Update(kg, "id-1", "data-1", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg, "id-2", "data-2", 0, vclock.VClock{})
+	err = db.Update(kg, "id-2", "data-2", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg, "id-3", "data-3", 0, vclock.VClock{})
+	err = db.Update(kg, "id-3", "data-3", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
@@ -144,19 +257,19 @@ func TestReadAll(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg2, "id-4", "data-4", 0, vclock.VClock{})
+	err = db.Update(kg2, "id-4", "data-4", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg2, "id-5", "data-5", 0, vclock.VClock{})
+	err = db.Update(kg2, "id-5", "data-5", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg2, "id-6", "data-6", 0, vclock.VClock{})
+	err = db.Update(kg2, "id-6", "data-6", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	keys, values, _, err := db.ReadAll(kg)
+	keys, values, _, _, err := db.ReadAll(kg)
 
 	assert.NoError(t, err)
 
@@ -176,15 +289,15 @@ func TestIDs(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg, "id-1", "data-1", 0, vclock.VClock{})
+	err = db.Update(kg, "id-1", "data-1", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg, "id-2", "data-2", 0, vclock.VClock{})
+	err = db.Update(kg, "id-2", "data-2", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg, "id-3", "data-3", 0, vclock.VClock{})
+	err = db.Update(kg, "id-3", "data-3", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
@@ -194,15 +307,15 @@ func TestIDs(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg2, "id-1", "data-1", 0, vclock.VClock{})
+	err = db.Update(kg2, "id-1", "data-1", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg2, "id-2", "data-2", 0, vclock.VClock{})
+	err = db.Update(kg2, "id-2", "data-2", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg2, "id-3", "data-3", 0, vclock.VClock{})
+	err = db.Update(kg2, "id-3", "data-3", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
@@ -226,7 +339,7 @@ func TestItemExists(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg, id, value, 0, vclock.VClock{})
+	err = db.Update(kg, id, value, false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 	ex := db.Exists(kg, id)
@@ -247,11 +360,11 @@ func TestItemGet(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg, id, value, 0, vclock.VClock{})
+	err = db.Update(kg, id, value, false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	retr, _, _, err := db.Read(kg, id)
+	retr, _, _, _, err := db.Read(kg, id)
 	assert.NoError(t, err)
 
 	assert.Len(t, retr, 1)
@@ -267,11 +380,11 @@ func TestItemDelete(t *testing.T) {
 	err := db.CreateKeygroup(kg)
 
 	assert.NoError(t, err)
-	err = db.Update(kg, id, value, 0, vclock.VClock{})
+	err = db.Update(kg, id, value, false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	retr, _, _, err := db.Read(kg, id)
+	retr, _, _, _, err := db.Read(kg, id)
 
 	assert.NoError(t, err)
 
@@ -282,7 +395,7 @@ func TestItemDelete(t *testing.T) {
 
 	assert.NoError(t, err, "read a deleted item")
 
-	_, _, found, err := db.Read(kg, id)
+	_, _, _, found, err := db.Read(kg, id)
 
 	assert.NoError(t, err)
 	assert.False(t, found)
@@ -302,7 +415,7 @@ func TestItemAfterDeleteKeygroup(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg, id, value, 0, vclock.VClock{})
+	err = db.Update(kg, id, value, false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
@@ -310,7 +423,7 @@ func TestItemAfterDeleteKeygroup(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	_, _, found, err := db.Read(kg, id)
+	_, _, _, found, err := db.Read(kg, id)
 
 	assert.NoError(t, err)
 	assert.False(t, found)
@@ -325,11 +438,11 @@ func TestExpiry(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg, id, value, 10, vclock.VClock{})
+	err = db.Update(kg, id, value, false, 10, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	retr, _, _, err := db.Read(kg, id)
+	retr, _, _, _, err := db.Read(kg, id)
 
 	assert.NoError(t, err)
 
@@ -341,7 +454,7 @@ func TestExpiry(t *testing.T) {
 
 	time.Sleep(11 * time.Second)
 
-	_, _, found, err := db.Read(kg, id)
+	_, _, _, found, err := db.Read(kg, id)
 
 	assert.NoError(t, err)
 	assert.False(t, found)
@@ -528,11 +641,11 @@ func TestPutItemVersion(t *testing.T) {
 	v1.Set("X", 10)
 	v1.Set("Y", 3)
 	v1.Set("Z", 7)
-	err = db.Update(kg, id, value, 0, v1)
+	err = db.Update(kg, id, value, false, 0, v1)
 
 	assert.NoError(t, err)
 
-	retr, clock, found, err := db.Read(kg, id)
+	retr, _, clock, found, err := db.Read(kg, id)
 
 	assert.NoError(t, err)
 
@@ -557,7 +670,7 @@ func TestDeleteVersion(t *testing.T) {
 	v1.Set("X", 10)
 	v1.Set("Y", 3)
 	v1.Set("Z", 7)
-	err = db.Update(kg, id, value, 0, v1)
+	err = db.Update(kg, id, value, false, 0, v1)
 
 	assert.NoError(t, err)
 
@@ -565,7 +678,7 @@ func TestDeleteVersion(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	_, _, found, err := db.Read(kg, id)
+	_, _, _, found, err := db.Read(kg, id)
 
 	assert.NoError(t, err)
 	assert.False(t, found)
@@ -576,6 +689,235 @@ func TestDeleteVersion(t *testing.T) {
 
 }
 
+func TestUpdateBatch(t *testing.T) {
+	kg := "test-kg-update-batch"
+
+	err := db.CreateKeygroup(kg)
+
+	assert.NoError(t, err)
+
+	v1 := vclock.VClock{}
+	v1.Set("X", 1)
+
+	err = db.Update(kg, "id1", "old", false, 0, v1)
+
+	assert.NoError(t, err)
+
+	v2 := v1.Copy()
+	v2.Tick("X")
+
+	err = db.UpdateBatch(kg, []string{"id1", "id2"}, []string{"new", "value2"}, []bool{false, false}, []int{0, 0}, []vclock.VClock{v2, v1}, [][]vclock.VClock{{v1}, nil})
+
+	assert.NoError(t, err)
+
+	retr, _, clock, found, err := db.Read(kg, "id1")
+
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []string{"new"}, retr)
+	assert.True(t, clock[0].Compare(v2, vclock.Equal))
+
+	retr, _, _, found, err = db.Read(kg, "id2")
+
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []string{"value2"}, retr)
+}
+
+func TestEmptyValue(t *testing.T) {
+	kg := "test-kg-empty"
+
+	err := db.CreateKeygroup(kg)
+
+	assert.NoError(t, err)
+
+	err = db.Update(kg, "empty", "", false, 0, vclock.VClock{"X": 1})
+
+	assert.NoError(t, err)
+
+	err = db.Update(kg, "deleted", "", true, 0, vclock.VClock{"X": 1})
+
+	assert.NoError(t, err)
+
+	err = db.Update(kg, "binary", "\x00\xff\x00", false, 0, vclock.VClock{"X": 1})
+
+	assert.NoError(t, err)
+
+	retr, tombstones, _, found, err := db.Read(kg, "empty")
+
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []string{""}, retr)
+	assert.Equal(t, []bool{false}, tombstones)
+
+	_, tombstones, _, found, err = db.Read(kg, "deleted")
+
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []bool{true}, tombstones)
+
+	retr, _, _, _, err = db.Read(kg, "binary")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"\x00\xff\x00"}, retr)
+
+	keys, _, tombstones, _, err := db.ReadAll(kg)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"binary", "deleted", "empty"}, keys)
+	assert.Equal(t, []bool{false, true, false}, tombstones)
+}
+
+func TestMigrate(t *testing.T) {
+	s := NewMemory()
+	defer s.Close()
+
+	kg := "test-kg-migrate"
+
+	err := s.CreateKeygroup(kg)
+
+	assert.NoError(t, err)
+
+	// write a version the way format 1 did, where an empty value is a tombstone
+	err = s.db.Update(func(txn *badger.Txn) error {
+		if err := txn.Set(makeKeyName(kg, "deleted", vclock.VClock{"X": 1}), []byte{}); err != nil {
+			return err
+		}
+
+		return txn.Delete(makeFormatKeyName())
+	})
+
+	assert.NoError(t, err)
+
+	err = s.Update(kg, "value", "val", false, 0, vclock.VClock{"X": 1})
+
+	assert.NoError(t, err)
+
+	_, tombstones, _, _, err := s.Read(kg, "deleted")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{false}, tombstones)
+
+	err = s.migrate()
+
+	assert.NoError(t, err)
+
+	_, tombstones, _, _, err = s.Read(kg, "deleted")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true}, tombstones)
+
+	_, tombstones, _, _, err = s.Read(kg, "value")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{false}, tombstones)
+
+	// migrating again does nothing
+	err = s.migrate()
+
+	assert.NoError(t, err)
+}
+
+func TestHistory(t *testing.T) {
+	kg := "test-kg-history"
+
+	err := db.CreateKeygroup(kg)
+
+	assert.NoError(t, err)
+
+	err = db.SetKeygroupHistory(kg, 2, 0)
+
+	assert.NoError(t, err)
+
+	for i := 1; i <= 4; i++ {
+		err = db.Update(kg, "id", "val"+strconv.Itoa(i), false, 0, vclock.VClock{"X": uint64(i)})
+
+		assert.NoError(t, err)
+
+		if i > 1 {
+			err = db.Delete(kg, "id", vclock.VClock{"X": uint64(i - 1)})
+
+			assert.NoError(t, err)
+		}
+	}
+
+	// only the last two replaced versions are kept
+	vals, tombstones, versions, replaced, err := db.ReadHistory(kg, "id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"val2", "val3"}, vals)
+	assert.Equal(t, []bool{false, false}, tombstones)
+	assert.Equal(t, []vclock.VClock{{"X": 2}, {"X": 3}}, versions)
+	assert.Len(t, replaced, 2)
+
+	retr, _, _, found, err := db.Read(kg, "id")
+
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []string{"val4"}, retr)
+
+	err = db.UpdateBatch(kg, []string{"id"}, []string{""}, []bool{true}, []int{0}, []vclock.VClock{{"X": 5}}, [][]vclock.VClock{{{"X": 4}}})
+
+	assert.NoError(t, err)
+
+	vals, _, _, _, err = db.ReadHistory(kg, "id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"val3", "val4"}, vals)
+
+	// replaced versions don't outlive their expiry
+	err = db.Update(kg, "expiring", "val1", false, 1, vclock.VClock{"X": 1})
+
+	assert.NoError(t, err)
+
+	err = db.Update(kg, "expiring", "val2", false, 1, vclock.VClock{"X": 2})
+
+	assert.NoError(t, err)
+
+	err = db.Delete(kg, "expiring", vclock.VClock{"X": 1})
+
+	assert.NoError(t, err)
+
+	vals, _, _, _, err = db.ReadHistory(kg, "expiring")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"val1"}, vals)
+
+	time.Sleep(2 * time.Second)
+
+	vals, _, _, _, err = db.ReadHistory(kg, "expiring")
+
+	assert.NoError(t, err)
+	assert.Empty(t, vals)
+
+	// keygroups without a history don't keep replaced versions
+	err = db.CreateKeygroup("test-kg-nohistory")
+
+	assert.NoError(t, err)
+
+	err = db.Update("test-kg-nohistory", "id", "val1", false, 0, vclock.VClock{"X": 1})
+
+	assert.NoError(t, err)
+
+	err = db.Delete("test-kg-nohistory", "id", vclock.VClock{"X": 1})
+
+	assert.NoError(t, err)
+
+	vals, _, _, _, err = db.ReadHistory("test-kg-nohistory", "id")
+
+	assert.NoError(t, err)
+	assert.Empty(t, vals)
+
+	err = db.DeleteKeygroup(kg)
+
+	assert.NoError(t, err)
+
+	vals, _, _, _, err = db.ReadHistory(kg, "id")
+
+	assert.NoError(t, err)
+	assert.Empty(t, vals)
+}
+
 func TestClose(t *testing.T) {
 	kg := "test-kg-item"
 	id := "name"
@@ -585,11 +927,11 @@ func TestClose(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg, id, value, 0, vclock.VClock{})
+	err = db.Update(kg, id, value, false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	retr, _, _, err := db.Read(kg, id)
+	retr, _, _, _, err := db.Read(kg, id)
 	assert.NoError(t, err)
 
 	assert.Len(t, retr, 1)
@@ -599,7 +941,7 @@ func TestClose(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	_, _, _, err = db.Read(kg, id)
+	_, _, _, _, err = db.Read(kg, id)
 	assert.Error(t, err)
 
 }
@@ -0,0 +1,326 @@
+package badgerdb
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/go-errors/errors"
+)
+
+// ChangeLog is a persistent log of the changes to each keygroup, backed by its own BadgerDB database.
+type ChangeLog struct {
+	db *badger.DB
+	// last is loaded lazily from the database for every keygroup
+	last map[string]uint64
+	lock sync.Mutex
+}
+
+// makeChangePrefix creates the internal BadgerDB key prefix for all changes of a keygroup.
+func makeChangePrefix(kg string) []byte {
+	return []byte("change" + sep + kg + sep)
+}
+
+// makeChangeKey creates the internal BadgerDB key for a change of a keygroup. The sequence number is stored in big
+// endian so that changes are iterated in order.
+func makeChangeKey(kg string, seq uint64) []byte {
+	return binary.BigEndian.AppendUint64(makeChangePrefix(kg), seq)
+}
+
+// makeChangeLastKey creates the internal BadgerDB key that holds the last sequence number of a keygroup. It is kept
+// when the changes are truncated so that sequence numbers are never reused.
+func makeChangeLastKey(kg string) []byte {
+	return []byte("last" + sep + kg)
+}
+
+// makeCursorKey creates the internal BadgerDB key for the cursor of a consumer of the changes of a keygroup.
+func makeCursorKey(kg string, consumer string) []byte {
+	return []byte("cursor" + sep + kg + sep + consumer)
+}
+
+// NewChangeLog creates a new change log that is persisted in a BadgerDB database at the given path.
+func NewChangeLog(dbPath string) (l *ChangeLog) {
+	db, err := badger.Open(badger.DefaultOptions(dbPath).WithLoggingLevel(badger.ERROR))
+	if err != nil {
+		panic(err)
+	}
+
+	l = &ChangeLog{
+		db:   db,
+		last: make(map[string]uint64),
+	}
+
+	go garbageCollection(db)
+
+	return
+}
+
+// NewMemoryChangeLog creates a new change log in memory. Changes in this log do not survive a restart.
+func NewMemoryChangeLog() (l *ChangeLog) {
+	db, err := badger.Open(badger.DefaultOptions("").WithInMemory(true).WithLoggingLevel(badger.ERROR))
+	if err != nil {
+		panic(err)
+	}
+
+	l = &ChangeLog{
+		db:   db,
+		last: make(map[string]uint64),
+	}
+
+	go garbageCollection(db)
+
+	return
+}
+
+// Close closes the underlying BadgerDB.
+func (l *ChangeLog) Close() error {
+	return l.db.Close()
+}
+
+// readUint64 reads a big endian number from the database, it is 0 if the key does not exist.
+func readUint64(txn *badger.Txn, key []byte) (uint64, error) {
+	item, err := txn.Get(key)
+
+	if err == badger.ErrKeyNotFound {
+		return 0, nil
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	v, err := item.ValueCopy(nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if len(v) != 8 {
+		return 0, errors.Errorf("invalid value for %s", key)
+	}
+
+	return binary.BigEndian.Uint64(v), nil
+}
+
+// load reads the last sequence number of a keygroup from the database if it is not known yet. The lock has to be held
+// when calling this.
+func (l *ChangeLog) load(kg string) error {
+	if _, ok := l.last[kg]; ok {
+		return nil
+	}
+
+	var last uint64
+
+	err := l.db.View(func(txn *badger.Txn) error {
+		var err error
+		last, err = readUint64(txn, makeChangeLastKey(kg))
+		return err
+	})
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	l.last[kg] = last
+
+	return nil
+}
+
+// Append adds a change to the end of the log of a keygroup and returns its sequence number.
+func (l *ChangeLog) Append(kg string, change []byte) (uint64, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if err := l.load(kg); err != nil {
+		return 0, err
+	}
+
+	seq := l.last[kg] + 1
+
+	err := l.db.Update(func(txn *badger.Txn) error {
+		if err := txn.Set(makeChangeLastKey(kg), binary.BigEndian.AppendUint64(nil, seq)); err != nil {
+			return err
+		}
+
+		return txn.Set(makeChangeKey(kg, seq), change)
+	})
+
+	if err != nil {
+		return 0, errors.New(err)
+	}
+
+	l.last[kg] = seq
+
+	return seq, nil
+}
+
+// Read returns up to count changes of a keygroup, starting at the given sequence number.
+func (l *ChangeLog) Read(kg string, from uint64, count int) ([]uint64, [][]byte, error) {
+	seqs := make([]uint64, 0)
+	changes := make([][]byte, 0)
+
+	err := l.db.View(func(txn *badger.Txn) error {
+		prefix := makeChangePrefix(kg)
+
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Seek(makeChangeKey(kg, from)); it.ValidForPrefix(prefix) && len(changes) < count; it.Next() {
+			item := it.Item()
+
+			v, err := item.ValueCopy(nil)
+
+			if err != nil {
+				return err
+			}
+
+			seqs = append(seqs, binary.BigEndian.Uint64(item.Key()[len(prefix):]))
+			changes = append(changes, v)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, errors.New(err)
+	}
+
+	return seqs, changes, nil
+}
+
+// Bounds returns the sequence numbers of the first and the last change of a keygroup that are in the log. If there
+// are none, first is one more than the last sequence number that was ever assigned.
+func (l *ChangeLog) Bounds(kg string) (uint64, uint64, error) {
+	l.lock.Lock()
+
+	if err := l.load(kg); err != nil {
+		l.lock.Unlock()
+		return 0, 0, err
+	}
+
+	last := l.last[kg]
+	l.lock.Unlock()
+
+	first := last + 1
+
+	err := l.db.View(func(txn *badger.Txn) error {
+		prefix := makeChangePrefix(kg)
+
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+		opts.PrefetchValues = false
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		it.Seek(prefix)
+
+		if it.ValidForPrefix(prefix) {
+			first = binary.BigEndian.Uint64(it.Item().Key()[len(prefix):])
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, 0, errors.New(err)
+	}
+
+	return first, last, nil
+}
+
+// Truncate removes all changes of a keygroup before the given sequence number.
+func (l *ChangeLog) Truncate(kg string, before uint64) error {
+	err := l.db.Update(func(txn *badger.Txn) error {
+		prefix := makeChangePrefix(kg)
+
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+		opts.PrefetchValues = false
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			key := it.Item().KeyCopy(nil)
+
+			if binary.BigEndian.Uint64(key[len(prefix):]) >= before {
+				break
+			}
+
+			if err := txn.Delete(key); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+// SetCursor stores the sequence number that a consumer of the changes of a keygroup has processed.
+func (l *ChangeLog) SetCursor(kg string, consumer string, seq uint64) error {
+	err := l.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(makeCursorKey(kg, consumer), binary.BigEndian.AppendUint64(nil, seq))
+	})
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+// GetCursor returns the sequence number that a consumer of the changes of a keygroup has processed, 0 if it has not
+// stored one.
+func (l *ChangeLog) GetCursor(kg string, consumer string) (uint64, error) {
+	var seq uint64
+
+	err := l.db.View(func(txn *badger.Txn) error {
+		var err error
+		seq, err = readUint64(txn, makeCursorKey(kg, consumer))
+		return err
+	})
+
+	if err != nil {
+		return 0, errors.New(err)
+	}
+
+	return seq, nil
+}
+
+// Keygroups returns all keygroups that changes have ever been logged for.
+func (l *ChangeLog) Keygroups() ([]string, error) {
+	kgs := make([]string, 0)
+
+	err := l.db.View(func(txn *badger.Txn) error {
+		prefix := makeChangeLastKey("")
+
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+		opts.PrefetchValues = false
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			kgs = append(kgs, string(it.Item().Key()[len(prefix):]))
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	return kgs, nil
+}
@@ -0,0 +1,76 @@
+package badgerdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangeLog(t *testing.T) {
+	path := t.TempDir()
+
+	l := NewChangeLog(path)
+
+	first, last, err := l.Bounds("kgA")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), first)
+	assert.Equal(t, uint64(0), last)
+
+	for i := 1; i <= 10; i++ {
+		seq, err := l.Append("kgA", []byte(fmt.Sprintf("change-%d", i)))
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(i), seq)
+	}
+
+	seq, err := l.Append("kgB", []byte("change-b"))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), seq)
+
+	seqs, changes, err := l.Read("kgA", 3, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{3, 4, 5, 6}, seqs)
+
+	for i, c := range changes {
+		assert.Equal(t, fmt.Sprintf("change-%d", seqs[i]), string(c))
+	}
+
+	assert.NoError(t, l.Truncate("kgA", 5))
+
+	first, last, err = l.Bounds("kgA")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), first)
+	assert.Equal(t, uint64(10), last)
+
+	assert.NoError(t, l.SetCursor("kgA", "consumer", 7))
+
+	// sequence numbers and cursors have to survive a restart, even if all changes were removed
+	assert.NoError(t, l.Truncate("kgA", 11))
+	assert.NoError(t, l.Close())
+
+	l = NewChangeLog(path)
+	defer func() {
+		assert.NoError(t, l.Close())
+	}()
+
+	kgs, err := l.Keygroups()
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"kgA", "kgB"}, kgs)
+
+	first, last, err = l.Bounds("kgA")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(11), first)
+	assert.Equal(t, uint64(10), last)
+
+	seq, err = l.Append("kgA", []byte("change-11"))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(11), seq)
+
+	c, err := l.GetCursor("kgA", "consumer")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(7), c)
+
+	c, err = l.GetCursor("kgA", "other")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), c)
+}
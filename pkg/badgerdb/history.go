@@ -0,0 +1,267 @@
+package badgerdb
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/vector"
+	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/go-errors/errors"
+)
+
+// retention is the configuration of the history of a keygroup: how many replaced versions are kept for each item and
+// for how many seconds, 0 means no limit.
+type retention struct {
+	versions int
+	window   int
+}
+
+// enabled returns whether replaced versions are kept at all.
+func (r retention) enabled() bool {
+	return r.versions > 0 || r.window > 0
+}
+
+func makeHistoryConfigKeyName(kgname string) []byte {
+	return []byte(sep + "fred" + sep + "retention" + sep + kgname)
+}
+
+// makeHistoryKeygroupKeyName creates the prefix of the replaced versions of all items of a keygroup.
+func makeHistoryKeygroupKeyName(kgname string) []byte {
+	return []byte(sep + "fred" + sep + "history" + sep + kgname + sep)
+}
+
+// makeHistoryKeyNamePrefix creates the prefix of the replaced versions of an item.
+func makeHistoryKeyNamePrefix(kgname string, id string) []byte {
+	return append(makeHistoryKeygroupKeyName(kgname), []byte(id+sep)...)
+}
+
+// makeHistoryKeyName creates the key of a replaced version of an item. The time at which the version was replaced comes
+// first, so the replaced versions of an item are ordered from the oldest to the newest.
+func makeHistoryKeyName(kgname string, id string, replaced int64, vvector vclock.VClock) []byte {
+	key := makeHistoryKeyNamePrefix(kgname, id)
+	key = binary.BigEndian.AppendUint64(key, uint64(replaced))
+	return append(key, vector.Bytes(vvector)...)
+}
+
+// getHistoryKey returns the time (in unix nanoseconds) at which a version was replaced and the version from the key of
+// a replaced version, given the prefix of the replaced versions of the item.
+func getHistoryKey(key []byte, prefix []byte) (int64, vclock.VClock, error) {
+	if len(key) < len(prefix)+8 {
+		return 0, nil, errors.Errorf("malformed history key %s", key)
+	}
+
+	replaced := int64(binary.BigEndian.Uint64(key[len(prefix) : len(prefix)+8]))
+
+	vvector, err := vector.FromBytes(key[len(prefix)+8:])
+
+	if err != nil {
+		return 0, nil, errors.New(err)
+	}
+
+	return replaced, vvector, nil
+}
+
+// getRetention returns the history configuration of a keygroup, keygroups without one keep no history.
+func getRetention(txn *badger.Txn, kg string) (retention, error) {
+	var r retention
+
+	item, err := txn.Get(makeHistoryConfigKeyName(kg))
+
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return r, nil
+	}
+
+	if err != nil {
+		return r, err
+	}
+
+	v, err := item.ValueCopy(nil)
+
+	if err != nil {
+		return r, err
+	}
+
+	if _, err := fmt.Sscanf(string(v), "%d"+sep+"%d", &r.versions, &r.window); err != nil {
+		return r, errors.Errorf("malformed history configuration %s for keygroup %s", v, kg)
+	}
+
+	return r, nil
+}
+
+// archive keeps a version of an item that is about to be removed in the history of the item if the keygroup keeps a
+// history. The replaced version expires when the version itself would have, or when it leaves the history window if
+// that is earlier. If the item has more replaced versions than the keygroup keeps, the oldest ones are removed.
+func archive(txn *badger.Txn, kg string, id string, vvector vclock.VClock) error {
+	r, err := getRetention(txn, kg)
+
+	if err != nil {
+		return err
+	}
+
+	if !r.enabled() {
+		return nil
+	}
+
+	item, err := txn.Get(makeKeyName(kg, id, vvector))
+
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	val, err := item.ValueCopy(nil)
+
+	if err != nil {
+		return err
+	}
+
+	if r.versions > 0 {
+		prefix := makeHistoryKeyNamePrefix(kg, id)
+
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+		opts.PrefetchValues = false
+
+		it := txn.NewIterator(opts)
+
+		var keys [][]byte
+
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			keys = append(keys, it.Item().KeyCopy(nil))
+		}
+
+		it.Close()
+
+		// make room for the version we are about to add
+		for j := 0; j < len(keys)+1-r.versions; j++ {
+			if err := txn.Delete(keys[j]); err != nil {
+				return err
+			}
+		}
+	}
+
+	now := time.Now()
+
+	e := badger.NewEntry(makeHistoryKeyName(kg, id, now.UnixNano(), vvector), val).WithMeta(item.UserMeta())
+	e.ExpiresAt = item.ExpiresAt()
+
+	if r.window > 0 {
+		w := uint64(now.Unix()) + uint64(r.window)
+
+		if e.ExpiresAt == 0 || w < e.ExpiresAt {
+			e.ExpiresAt = w
+		}
+	}
+
+	return txn.SetEntry(e)
+}
+
+// SetKeygroupHistory sets how many replaced versions of each item of a keygroup are kept and for how long.
+func (s *Storage) SetKeygroupHistory(kg string, versions int, window int) error {
+	err := s.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(makeHistoryConfigKeyName(kg), []byte(fmt.Sprintf("%d"+sep+"%d", versions, window)))
+	})
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+// ReadHistory returns the replaced versions of an item that are kept, from the oldest to the newest.
+func (s *Storage) ReadHistory(kg string, id string) ([]string, []bool, []vclock.VClock, []int64, error) {
+	values := make([]string, 0)
+	tombstones := make([]bool, 0)
+	vvectors := make([]vclock.VClock, 0)
+	replaced := make([]int64, 0)
+
+	err := s.db.View(func(txn *badger.Txn) error {
+		prefix := makeHistoryKeyNamePrefix(kg, id)
+
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			item := it.Item()
+
+			r, vvector, err := getHistoryKey(item.Key(), prefix)
+
+			if err != nil {
+				return err
+			}
+
+			v, err := item.ValueCopy(nil)
+
+			if err != nil {
+				return err
+			}
+
+			values = append(values, string(v))
+			tombstones = append(tombstones, isTombstone(item))
+			vvectors = append(vvectors, vvector)
+			replaced = append(replaced, r/int64(time.Second))
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, nil, nil, errors.New(err)
+	}
+
+	return values, tombstones, vvectors, replaced, nil
+}
+
+// deleteHistory removes the history configuration and all replaced versions of a keygroup.
+func (s *Storage) deleteHistory(kg string) error {
+	var keys []string
+
+	err := s.db.View(func(txn *badger.Txn) error {
+		prefix := makeHistoryKeygroupKeyName(kg)
+
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+		opts.PrefetchValues = false
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			keys = append(keys, string(it.Item().Key()))
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	wb := s.db.NewWriteBatch()
+	defer wb.Cancel()
+
+	if err := wb.Delete(makeHistoryConfigKeyName(kg)); err != nil {
+		return errors.New(err)
+	}
+
+	for _, k := range keys {
+		if err := wb.Delete([]byte(k)); err != nil {
+			return errors.New(err)
+		}
+	}
+
+	if err := wb.Flush(); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
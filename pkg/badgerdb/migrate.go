@@ -0,0 +1,128 @@
+package badgerdb
+
+import (
+	"github.com/dgraph-io/badger/v3"
+	"github.com/go-errors/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// format is the version of the layout of the data in the database.
+// Format 1 stored tombstones as empty values, format 2 marks them in the user metadata of an entry.
+const format = "2"
+
+func makeFormatKeyName() []byte {
+	return []byte(sep + "fred" + sep + "format")
+}
+
+// migrate brings the data in the database up to the current format. Databases without a format are from format 1, in
+// which every empty value is a tombstone, so those values are rewritten with a tombstone marker.
+func (s *Storage) migrate() error {
+	var current string
+
+	err := s.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(makeFormatKeyName())
+
+		if err != nil {
+			return err
+		}
+
+		v, err := item.ValueCopy(nil)
+
+		if err != nil {
+			return err
+		}
+
+		current = string(v)
+
+		return nil
+	})
+
+	if err != nil && !errors.Is(err, badger.ErrKeyNotFound) {
+		return errors.New(err)
+	}
+
+	if current == format {
+		return nil
+	}
+
+	if current != "" {
+		return errors.Errorf("unknown database format %s", current)
+	}
+
+	var kgs []string
+
+	err = s.db.View(func(txn *badger.Txn) error {
+		prefix := makeKeygroupConfigKeyName("")
+
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			kgs = append(kgs, string(it.Item().Key()[len(prefix):]))
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	var tombstones []*badger.Entry
+
+	err = s.db.View(func(txn *badger.Txn) error {
+		for _, kg := range kgs {
+			prefix := makeKeygroupKeyName(kg)
+
+			opts := badger.DefaultIteratorOptions
+			opts.Prefix = prefix
+
+			it := txn.NewIterator(opts)
+
+			for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+				item := it.Item()
+
+				if item.ValueSize() > 0 || isTombstone(item) {
+					continue
+				}
+
+				e := badger.NewEntry(item.KeyCopy(nil), []byte{}).WithMeta(tombstoneMeta)
+				e.ExpiresAt = item.ExpiresAt()
+
+				tombstones = append(tombstones, e)
+			}
+
+			it.Close()
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	wb := s.db.NewWriteBatch()
+	defer wb.Cancel()
+
+	for _, e := range tombstones {
+		if err := wb.SetEntry(e); err != nil {
+			return errors.New(err)
+		}
+	}
+
+	if err := wb.Set(makeFormatKeyName(), []byte(format)); err != nil {
+		return errors.New(err)
+	}
+
+	if err := wb.Flush(); err != nil {
+		return errors.New(err)
+	}
+
+	log.Info().Msgf("BadgerDB: migrated database to format %s, marked %d tombstones", format, len(tombstones))
+
+	return nil
+}
@@ -0,0 +1,265 @@
+package badgerdb
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/go-errors/errors"
+)
+
+// Outbox is a persistent queue of replication messages per peer, backed by its own BadgerDB database.
+type Outbox struct {
+	db *badger.DB
+	// next and length are loaded lazily from the database for every peer
+	next   map[string]uint64
+	length map[string]uint64
+	lock   sync.Mutex
+}
+
+// makeOutboxPrefix creates the internal BadgerDB key prefix for all messages queued for a peer.
+func makeOutboxPrefix(peer string) []byte {
+	return []byte("msg" + sep + peer + sep)
+}
+
+// makeOutboxKey creates the internal BadgerDB key for a message queued for a peer. The sequence number is stored in
+// big endian so that messages are iterated in order.
+func makeOutboxKey(peer string, seq uint64) []byte {
+	return binary.BigEndian.AppendUint64(makeOutboxPrefix(peer), seq)
+}
+
+// makeOutboxPeerKey creates the internal BadgerDB key that marks that there are messages for a peer.
+func makeOutboxPeerKey(peer string) []byte {
+	return []byte("peer" + sep + peer)
+}
+
+// NewOutbox creates a new outbox that is persisted in a BadgerDB database at the given path.
+func NewOutbox(dbPath string) (o *Outbox) {
+	db, err := badger.Open(badger.DefaultOptions(dbPath).WithLoggingLevel(badger.ERROR))
+	if err != nil {
+		panic(err)
+	}
+
+	o = &Outbox{
+		db:     db,
+		next:   make(map[string]uint64),
+		length: make(map[string]uint64),
+	}
+
+	go garbageCollection(db)
+
+	return
+}
+
+// NewMemoryOutbox creates a new outbox in memory. Messages in this outbox do not survive a restart.
+func NewMemoryOutbox() (o *Outbox) {
+	db, err := badger.Open(badger.DefaultOptions("").WithInMemory(true).WithLoggingLevel(badger.ERROR))
+	if err != nil {
+		panic(err)
+	}
+
+	o = &Outbox{
+		db:     db,
+		next:   make(map[string]uint64),
+		length: make(map[string]uint64),
+	}
+
+	go garbageCollection(db)
+
+	return
+}
+
+// Close closes the underlying BadgerDB.
+func (o *Outbox) Close() error {
+	return o.db.Close()
+}
+
+// load reads the next sequence number and the number of queued messages for a peer from the database if they are not
+// known yet. The lock has to be held when calling this.
+func (o *Outbox) load(peer string) error {
+	if _, ok := o.next[peer]; ok {
+		return nil
+	}
+
+	var next, length uint64
+
+	err := o.db.View(func(txn *badger.Txn) error {
+		prefix := makeOutboxPrefix(peer)
+
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+		opts.PrefetchValues = false
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			next = binary.BigEndian.Uint64(it.Item().Key()[len(prefix):]) + 1
+			length++
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	o.next[peer] = next
+	o.length[peer] = length
+
+	return nil
+}
+
+// Enqueue adds a message to the end of the queue for a peer.
+func (o *Outbox) Enqueue(peer string, msg []byte) error {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	if err := o.load(peer); err != nil {
+		return err
+	}
+
+	seq := o.next[peer]
+
+	err := o.db.Update(func(txn *badger.Txn) error {
+		if err := txn.Set(makeOutboxPeerKey(peer), nil); err != nil {
+			return err
+		}
+
+		return txn.Set(makeOutboxKey(peer, seq), msg)
+	})
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	o.next[peer] = seq + 1
+	o.length[peer]++
+
+	return nil
+}
+
+// Peek returns up to count messages from the start of the queue for a peer without removing them.
+func (o *Outbox) Peek(peer string, count int) ([]uint64, [][]byte, error) {
+	seqs := make([]uint64, 0)
+	msgs := make([][]byte, 0)
+
+	err := o.db.View(func(txn *badger.Txn) error {
+		prefix := makeOutboxPrefix(peer)
+
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Seek(prefix); it.ValidForPrefix(prefix) && len(msgs) < count; it.Next() {
+			item := it.Item()
+
+			v, err := item.ValueCopy(nil)
+
+			if err != nil {
+				return err
+			}
+
+			seqs = append(seqs, binary.BigEndian.Uint64(item.Key()[len(prefix):]))
+			msgs = append(msgs, v)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, errors.New(err)
+	}
+
+	return seqs, msgs, nil
+}
+
+// Ack removes all messages up to and including the given sequence number from the queue for a peer.
+func (o *Outbox) Ack(peer string, seq uint64) error {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	if err := o.load(peer); err != nil {
+		return err
+	}
+
+	var removed uint64
+
+	err := o.db.Update(func(txn *badger.Txn) error {
+		prefix := makeOutboxPrefix(peer)
+
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+		opts.PrefetchValues = false
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			key := it.Item().KeyCopy(nil)
+
+			if binary.BigEndian.Uint64(key[len(prefix):]) > seq {
+				break
+			}
+
+			if err := txn.Delete(key); err != nil {
+				return err
+			}
+
+			removed++
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	o.length[peer] -= removed
+
+	return nil
+}
+
+// Len returns the number of messages queued for a peer.
+func (o *Outbox) Len(peer string) (uint64, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	if err := o.load(peer); err != nil {
+		return 0, err
+	}
+
+	return o.length[peer], nil
+}
+
+// Peers returns all peers that messages have ever been queued for.
+func (o *Outbox) Peers() ([]string, error) {
+	peers := make([]string, 0)
+
+	err := o.db.View(func(txn *badger.Txn) error {
+		prefix := makeOutboxPeerKey("")
+
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+		opts.PrefetchValues = false
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			peers = append(peers, string(it.Item().Key()[len(prefix):]))
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	return peers, nil
+}
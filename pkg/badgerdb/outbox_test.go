@@ -0,0 +1,72 @@
+package badgerdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutbox(t *testing.T) {
+	path := t.TempDir()
+
+	o := NewOutbox(path)
+
+	for i := 0; i < 10; i++ {
+		err := o.Enqueue("nodeA", []byte(fmt.Sprintf("msg-%d", i)))
+		assert.NoError(t, err)
+	}
+
+	err := o.Enqueue("nodeB", []byte("msg-b"))
+	assert.NoError(t, err)
+
+	l, err := o.Len("nodeA")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(10), l)
+
+	seqs, msgs, err := o.Peek("nodeA", 4)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 4)
+
+	for i, m := range msgs {
+		assert.Equal(t, fmt.Sprintf("msg-%d", i), string(m))
+	}
+
+	err = o.Ack("nodeA", seqs[len(seqs)-1])
+	assert.NoError(t, err)
+
+	l, err = o.Len("nodeA")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(6), l)
+
+	// messages that were not acknowledged have to survive a restart, in order
+	assert.NoError(t, o.Close())
+
+	o = NewOutbox(path)
+	defer func() {
+		assert.NoError(t, o.Close())
+	}()
+
+	peers, err := o.Peers()
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"nodeA", "nodeB"}, peers)
+
+	l, err = o.Len("nodeA")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(6), l)
+
+	err = o.Enqueue("nodeA", []byte("msg-10"))
+	assert.NoError(t, err)
+
+	_, msgs, err = o.Peek("nodeA", 100)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 7)
+
+	for i, m := range msgs {
+		assert.Equal(t, fmt.Sprintf("msg-%d", i+4), string(m))
+	}
+
+	_, msgs, err = o.Peek("nodeB", 100)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("msg-b")}, msgs)
+}
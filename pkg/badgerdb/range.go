@@ -0,0 +1,266 @@
+package badgerdb
+
+import (
+	"bytes"
+	"strings"
+
+	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/go-errors/errors"
+)
+
+// rangeReader collects the items of a range read in lexicographic order of their ids.
+// BadgerDB sorts an id after all longer ids that start with it as the separator comes after all characters that are
+// allowed in ids, e.g., "ab1" comes before "ab". To restore the lexicographic order, an id that is a prefix of other
+// ids is added before them when going forward and held back until they have all been added when going backward.
+type rangeReader struct {
+	kg         string
+	start      string
+	end        string
+	prefix     string
+	reverse    bool
+	count      uint64
+	ids        uint64
+	keys       []string
+	items      []string
+	tombstones []bool
+	vvectors   []vclock.VClock
+}
+
+// readVersions returns the values, tombstone markers, and versions of an id in a keygroup.
+func readVersions(txn *badger.Txn, kg string, id string) ([]string, []bool, []vclock.VClock, error) {
+	values := make([]string, 0)
+	tombstones := make([]bool, 0)
+	vvectors := make([]vclock.VClock, 0)
+
+	prefix := makeKeyNamePrefix(kg, id)
+
+	opts := badger.DefaultIteratorOptions
+	opts.Prefix = prefix
+
+	it := txn.NewIterator(opts)
+	defer it.Close()
+
+	for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+		item := it.Item()
+		_, _, vvector := getKey(string(item.Key()))
+
+		v, err := item.ValueCopy(nil)
+
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		values = append(values, string(v))
+		tombstones = append(tombstones, isTombstone(item))
+		vvectors = append(vvectors, vvector)
+	}
+
+	return values, tombstones, vvectors, nil
+}
+
+// add adds all versions of an id to the result if the id is in the range. It returns false once no more ids can be
+// added, either because the result is full or because the id is past the end of the range.
+func (r *rangeReader) add(txn *badger.Txn, id string) (bool, error) {
+	if !strings.HasPrefix(id, r.prefix) {
+		return true, nil
+	}
+
+	if id < r.start {
+		return !r.reverse, nil
+	}
+
+	if r.end != "" && id >= r.end {
+		return r.reverse, nil
+	}
+
+	values, tombstones, vvectors, err := readVersions(txn, r.kg, id)
+
+	if err != nil {
+		return false, err
+	}
+
+	// this id only exists as a prefix of other ids
+	if len(values) == 0 {
+		return true, nil
+	}
+
+	for i := range values {
+		r.keys = append(r.keys, id)
+		r.items = append(r.items, values[i])
+		r.tombstones = append(r.tombstones, tombstones[i])
+		r.vvectors = append(r.vvectors, vvectors[i])
+	}
+
+	r.ids++
+
+	return r.ids < r.count, nil
+}
+
+// forward reads the range in ascending order.
+func (r *rangeReader) forward(txn *badger.Txn) error {
+	prefix := []byte(r.kg + sep + r.prefix)
+	seek := prefix
+
+	if r.start > r.prefix {
+		seek = []byte(r.kg + sep + r.start)
+	}
+
+	opts := badger.DefaultIteratorOptions
+	opts.Prefix = prefix
+	opts.PrefetchValues = false
+
+	it := txn.NewIterator(opts)
+	defer it.Close()
+
+	// ancestors are ids that the current id starts with and that have already been added
+	var ancestors []string
+	last := ""
+
+	for it.Seek(seek); it.ValidForPrefix(prefix); it.Next() {
+		_, id, _ := getKey(string(it.Item().Key()))
+
+		if id == last {
+			continue
+		}
+
+		last = id
+
+		for len(ancestors) > 0 && !strings.HasPrefix(id, ancestors[len(ancestors)-1]) {
+			ancestors = ancestors[:len(ancestors)-1]
+		}
+
+		if len(ancestors) > 0 && ancestors[len(ancestors)-1] == id {
+			ancestors = ancestors[:len(ancestors)-1]
+			continue
+		}
+
+		k := len(r.prefix)
+
+		if len(ancestors) > 0 {
+			k = len(ancestors[len(ancestors)-1]) + 1
+		}
+
+		if k == 0 {
+			k = 1
+		}
+
+		for ; k < len(id); k++ {
+			ancestors = append(ancestors, id[:k])
+
+			more, err := r.add(txn, id[:k])
+
+			if err != nil || !more {
+				return err
+			}
+		}
+
+		more, err := r.add(txn, id)
+
+		if err != nil || !more {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// backward reads the range in descending order.
+func (r *rangeReader) backward(txn *badger.Txn) error {
+	prefix := []byte(r.kg + sep + r.prefix)
+	seek := append(append([]byte{}, prefix...), 0xFF)
+
+	// pending are ids that still have to be added once all ids that start with them have been added
+	var pending []string
+
+	if r.end != "" {
+		if e := []byte(r.kg + sep + r.end); bytes.Compare(e, seek) < 0 {
+			seek = e
+		}
+
+		// ids that the end of the range starts with come before it lexicographically but after it in BadgerDB
+		for k := 1; k < len(r.end); k++ {
+			pending = append(pending, r.end[:k])
+		}
+	}
+
+	opts := badger.DefaultIteratorOptions
+	opts.Prefix = prefix
+	opts.PrefetchValues = false
+	opts.Reverse = true
+
+	it := txn.NewIterator(opts)
+	defer it.Close()
+
+	last := ""
+
+	for it.Seek(seek); it.ValidForPrefix(prefix); it.Next() {
+		_, id, _ := getKey(string(it.Item().Key()))
+
+		if id == last {
+			continue
+		}
+
+		last = id
+
+		for len(pending) > 0 && !strings.HasPrefix(id, pending[len(pending)-1]) {
+			more, err := r.add(txn, pending[len(pending)-1])
+
+			if err != nil || !more {
+				return err
+			}
+
+			pending = pending[:len(pending)-1]
+		}
+
+		pending = append(pending, id)
+	}
+
+	for len(pending) > 0 {
+		more, err := r.add(txn, pending[len(pending)-1])
+
+		if err != nil || !more {
+			return err
+		}
+
+		pending = pending[:len(pending)-1]
+	}
+
+	return nil
+}
+
+// ReadRange returns all versions of up to count items in the specified keygroup with ids between start (inclusive) and
+// end (exclusive) that start with prefix. Items are ordered lexicographically by their ids, or in reverse. An empty
+// start or end means that the range is not bounded on that side.
+func (s *Storage) ReadRange(kg string, start string, end string, prefix string, reverse bool, count uint64) ([]string, []string, []bool, []vclock.VClock, error) {
+	r := &rangeReader{
+		kg:         kg,
+		start:      start,
+		end:        end,
+		prefix:     prefix,
+		reverse:    reverse,
+		count:      count,
+		keys:       make([]string, 0),
+		items:      make([]string, 0),
+		tombstones: make([]bool, 0),
+		vvectors:   make([]vclock.VClock, 0),
+	}
+
+	if count == 0 {
+		return r.keys, r.items, r.tombstones, r.vvectors, nil
+	}
+
+	err := s.db.View(func(txn *badger.Txn) error {
+		if reverse {
+			return r.backward(txn)
+		}
+
+		return r.forward(txn)
+	})
+
+	if err != nil {
+		return nil, nil, nil, nil, errors.New(err)
+	}
+
+	return r.keys, r.items, r.tombstones, r.vvectors, nil
+}
@@ -0,0 +1,166 @@
+// Package compression compresses the values of items with the codec of their keygroup, both before they are written to
+// a store and before they are sent to other nodes.
+package compression
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"strings"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/fred"
+	"github.com/go-errors/errors"
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// magic is how values in a store start that carry a codec header. It is followed by one byte for the version of the
+// header, one byte for the codec, the checksum of the value (uint64), and the possibly compressed value. Values without
+// the header were written before compression was enabled. As they can start with the magic as well, a value only has a
+// header if the checksum matches.
+const magic = "FCMP"
+
+// version is the version of the header.
+const version = 1
+
+const headerSize = len(magic) + 1 + 1 + 8
+
+// maxSize is the largest size of a decompressed value that is accepted.
+const maxSize = 64 << 20
+
+// codecs maps the codecs to the byte that stands for them in the header. The bytes must never change, as they are part
+// of stored values.
+var codecs = map[fred.Compression]byte{
+	fred.NoCompression: 0,
+	fred.Zstd:          1,
+	fred.Snappy:        2,
+}
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(maxSize))
+)
+
+// Settings tells which codec the values of a keygroup are compressed with, it is usually the NameService.
+type Settings interface {
+	GetKeygroupCompression(kg fred.KeygroupName) (fred.Compression, error)
+}
+
+// Supported returns the codecs that this node can compress and decompress values with.
+func Supported() []fred.Compression {
+	return []fred.Compression{fred.Zstd, fred.Snappy}
+}
+
+// Compress compresses a value with a codec.
+func Compress(c fred.Compression, val []byte) ([]byte, error) {
+	switch c {
+	case "", fred.NoCompression:
+		return val, nil
+	case fred.Zstd:
+		return zstdEncoder.EncodeAll(val, nil), nil
+	case fred.Snappy:
+		return snappy.Encode(nil, val), nil
+	}
+
+	return nil, errors.Errorf("unknown compression %s", c)
+}
+
+// Decompress decompresses a value that was compressed with a codec.
+func Decompress(c fred.Compression, val []byte) ([]byte, error) {
+	switch c {
+	case "", fred.NoCompression:
+		return val, nil
+	case fred.Zstd:
+		d, err := zstdDecoder.DecodeAll(val, nil)
+
+		if err != nil {
+			return nil, errors.Errorf("cannot decompress value with zstd: %v", err)
+		}
+
+		return d, nil
+	case fred.Snappy:
+		if n, err := snappy.DecodedLen(val); err != nil || n > maxSize {
+			return nil, errors.Errorf("cannot decompress value with snappy: invalid length")
+		}
+
+		d, err := snappy.Decode(nil, val)
+
+		if err != nil {
+			return nil, errors.Errorf("cannot decompress value with snappy: %v", err)
+		}
+
+		return d, nil
+	}
+
+	return nil, errors.Errorf("unknown compression %s", c)
+}
+
+// checksum returns the checksum of a value for the header.
+func checksum(val string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(val))
+	return h.Sum64()
+}
+
+// header returns the header for a value that is encoded with a codec.
+func header(b byte, val string) string {
+	h := make([]byte, headerSize)
+	copy(h, magic)
+	h[len(magic)] = version
+	h[len(magic)+1] = b
+	binary.BigEndian.PutUint64(h[len(magic)+2:], checksum(val))
+
+	return string(h)
+}
+
+// Encode compresses a value with a codec and adds the codec header. If compressing does not make the value smaller, it
+// is kept as it is. Values that are not compressed only get a header if they could be mistaken for one that has a
+// header, so that uncompressed keygroups look the same as before.
+func Encode(c fred.Compression, val string) (string, error) {
+	if c != "" && c != fred.NoCompression {
+		b, ok := codecs[c]
+
+		if !ok {
+			return "", errors.Errorf("unknown compression %s", c)
+		}
+
+		compressed, err := Compress(c, []byte(val))
+
+		if err != nil {
+			return "", err
+		}
+
+		if len(compressed)+headerSize < len(val) {
+			return header(b, val) + string(compressed), nil
+		}
+	}
+
+	if strings.HasPrefix(val, magic) {
+		return header(codecs[fred.NoCompression], val) + val, nil
+	}
+
+	return val, nil
+}
+
+// Decode removes the codec header from a value and decompresses it. Values without a header, including values whose
+// header does not check out, are returned as they are.
+func Decode(val string) string {
+	if len(val) < headerSize || !strings.HasPrefix(val, magic) || val[len(magic)] != version {
+		return val
+	}
+
+	for c, b := range codecs {
+		if val[len(magic)+1] != b {
+			continue
+		}
+
+		d, err := Decompress(c, []byte(val[headerSize:]))
+
+		if err != nil || checksum(string(d)) != binary.BigEndian.Uint64([]byte(val[len(magic)+2:headerSize])) {
+			return val
+		}
+
+		return string(d)
+	}
+
+	return val
+}
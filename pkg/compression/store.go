@@ -0,0 +1,209 @@
+package compression
+
+import (
+	"git.tu-berlin.de/mcc-fred/fred/pkg/fred"
+	"git.tu-berlin.de/mcc-fred/vclock"
+)
+
+// Store is a fred.Store that compresses values with the codec of their keygroup before they are written to another
+// store and decompresses them when they are read. Every value carries the codec that it was compressed with, so
+// values that were written before compression was enabled or with another codec can still be read.
+type Store struct {
+	s fred.Store
+	n Settings
+}
+
+// New creates a Store that compresses the values of items in the given store with the codecs from the settings.
+func New(s fred.Store, n Settings) *Store {
+	return &Store{
+		s: s,
+		n: n,
+	}
+}
+
+// encode compresses a value with the codec of its keygroup.
+func (c *Store) encode(kg string, val string) (string, error) {
+	codec, err := c.n.GetKeygroupCompression(fred.KeygroupName(kg))
+
+	if err != nil {
+		return "", err
+	}
+
+	return Encode(codec, val)
+}
+
+// decodeAll decompresses several values in place.
+func decodeAll(vals []string) {
+	for i := range vals {
+		vals[i] = Decode(vals[i])
+	}
+}
+
+// Update compresses the value and stores it in the underlying store.
+func (c *Store) Update(kg string, id string, val string, tombstoned bool, expiry int, vvector vclock.VClock) error {
+	val, err := c.encode(kg, val)
+
+	if err != nil {
+		return err
+	}
+
+	return c.s.Update(kg, id, val, tombstoned, expiry, vvector)
+}
+
+// Delete deletes a version of an item from the underlying store.
+func (c *Store) Delete(kg string, id string, vvector vclock.VClock) error {
+	return c.s.Delete(kg, id, vvector)
+}
+
+// UpdateBatch compresses the values and stores them in the underlying store.
+func (c *Store) UpdateBatch(kg string, ids []string, vals []string, tombstones []bool, expiries []int, vvectors []vclock.VClock, replaced [][]vclock.VClock) error {
+	encoded := make([]string, len(vals))
+
+	for i := range vals {
+		var err error
+		if encoded[i], err = c.encode(kg, vals[i]); err != nil {
+			return err
+		}
+	}
+
+	return c.s.UpdateBatch(kg, ids, encoded, tombstones, expiries, vvectors, replaced)
+}
+
+// Append compresses the value and appends it to the underlying store.
+func (c *Store) Append(kg string, id string, val string, expiry int) error {
+	val, err := c.encode(kg, val)
+
+	if err != nil {
+		return err
+	}
+
+	return c.s.Append(kg, id, val, expiry)
+}
+
+// Read reads the versions of an item from the underlying store and decompresses them.
+func (c *Store) Read(kg string, id string) ([]string, []bool, []vclock.VClock, bool, error) {
+	vals, tombstones, versions, found, err := c.s.Read(kg, id)
+
+	if err != nil {
+		return nil, nil, nil, false, err
+	}
+
+	decodeAll(vals)
+
+	return vals, tombstones, versions, found, nil
+}
+
+// ReadSome reads items from the underlying store and decompresses them.
+func (c *Store) ReadSome(kg string, id string, count uint64) ([]string, []string, []bool, []vclock.VClock, error) {
+	ids, vals, tombstones, versions, err := c.s.ReadSome(kg, id, count)
+
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	decodeAll(vals)
+
+	return ids, vals, tombstones, versions, nil
+}
+
+// ReadPage reads items from the underlying store and decompresses them.
+func (c *Store) ReadPage(kg string, after string, count uint64) ([]string, []string, []bool, []vclock.VClock, error) {
+	ids, vals, tombstones, versions, err := c.s.ReadPage(kg, after, count)
+
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	decodeAll(vals)
+
+	return ids, vals, tombstones, versions, nil
+}
+
+// ReadRange reads items from the underlying store and decompresses them.
+func (c *Store) ReadRange(kg string, start string, end string, prefix string, reverse bool, count uint64) ([]string, []string, []bool, []vclock.VClock, error) {
+	ids, vals, tombstones, versions, err := c.s.ReadRange(kg, start, end, prefix, reverse, count)
+
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	decodeAll(vals)
+
+	return ids, vals, tombstones, versions, nil
+}
+
+// ReadAll reads all items of a keygroup from the underlying store and decompresses them.
+func (c *Store) ReadAll(kg string) ([]string, []string, []bool, []vclock.VClock, error) {
+	ids, vals, tombstones, versions, err := c.s.ReadAll(kg)
+
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	decodeAll(vals)
+
+	return ids, vals, tombstones, versions, nil
+}
+
+// ReadHistory reads the replaced versions of an item from the underlying store and decompresses them.
+func (c *Store) ReadHistory(kg string, id string) ([]string, []bool, []vclock.VClock, []int64, error) {
+	vals, tombstones, versions, replaced, err := c.s.ReadHistory(kg, id)
+
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	decodeAll(vals)
+
+	return vals, tombstones, versions, replaced, nil
+}
+
+// IDs returns the ids of the items of a keygroup in the underlying store.
+func (c *Store) IDs(kg string) ([]string, error) {
+	return c.s.IDs(kg)
+}
+
+// Exists checks whether an item exists in the underlying store.
+func (c *Store) Exists(kg string, id string) bool {
+	return c.s.Exists(kg, id)
+}
+
+// CreateKeygroup creates a keygroup in the underlying store.
+func (c *Store) CreateKeygroup(kg string) error {
+	return c.s.CreateKeygroup(kg)
+}
+
+// SetKeygroupHistory sets the history retention of a keygroup in the underlying store.
+func (c *Store) SetKeygroupHistory(kg string, versions int, window int) error {
+	return c.s.SetKeygroupHistory(kg, versions, window)
+}
+
+// DeleteKeygroup deletes a keygroup in the underlying store.
+func (c *Store) DeleteKeygroup(kg string) error {
+	return c.s.DeleteKeygroup(kg)
+}
+
+// ExistsKeygroup checks whether a keygroup exists in the underlying store.
+func (c *Store) ExistsKeygroup(kg string) bool {
+	return c.s.ExistsKeygroup(kg)
+}
+
+// AddKeygroupTrigger adds a trigger to a keygroup in the underlying store.
+func (c *Store) AddKeygroupTrigger(kg string, id string, host string) error {
+	return c.s.AddKeygroupTrigger(kg, id, host)
+}
+
+// DeleteKeygroupTrigger removes a trigger from a keygroup in the underlying store.
+func (c *Store) DeleteKeygroupTrigger(kg string, id string) error {
+	return c.s.DeleteKeygroupTrigger(kg, id)
+}
+
+// GetKeygroupTrigger returns the triggers of a keygroup in the underlying store.
+func (c *Store) GetKeygroupTrigger(kg string) (map[string]string, error) {
+	return c.s.GetKeygroupTrigger(kg)
+}
+
+// Close closes the underlying store.
+func (c *Store) Close() error {
+	return c.s.Close()
+}
@@ -0,0 +1,135 @@
+package compression
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/badgerdb"
+	"git.tu-berlin.de/mcc-fred/fred/pkg/fred"
+	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/stretchr/testify/assert"
+)
+
+// settings are the codecs of some keygroups.
+type settings map[fred.KeygroupName]fred.Compression
+
+func (s settings) GetKeygroupCompression(kg fred.KeygroupName) (fred.Compression, error) {
+	return s[kg], nil
+}
+
+func TestEncode(t *testing.T) {
+	long := strings.Repeat("compressible ", 100)
+
+	for _, c := range []fred.Compression{"", fred.NoCompression, fred.Zstd, fred.Snappy} {
+		for _, val := range []string{"", "short", long, magic, magic + "\x01garbage"} {
+			e, err := Encode(c, val)
+			assert.NoError(t, err)
+
+			assert.Equal(t, val, Decode(e), "codec %s", c)
+		}
+	}
+
+	// compressed values are smaller, uncompressed ones don't change
+	e, err := Encode(fred.Zstd, long)
+	assert.NoError(t, err)
+	assert.Less(t, len(e), len(long))
+
+	e, err = Encode(fred.NoCompression, long)
+	assert.NoError(t, err)
+	assert.Equal(t, long, e)
+
+	// values that don't get smaller are not compressed
+	e, err = Encode(fred.Snappy, "short")
+	assert.NoError(t, err)
+	assert.Equal(t, "short", e)
+
+	_, err = Encode("gzip", long)
+	assert.Error(t, err)
+
+	// values without a valid header are returned as they are
+	assert.Equal(t, magic+"\x09", Decode(magic+"\x09"))
+
+	e, err = Encode(fred.Zstd, long)
+	assert.NoError(t, err)
+
+	corrupt := e[:len(magic)+2] + "\x00" + e[len(magic)+3:]
+	assert.Equal(t, corrupt, Decode(corrupt))
+}
+
+func TestStore(t *testing.T) {
+	long := strings.Repeat("compressible ", 100)
+
+	inner := badgerdb.NewMemory()
+	n := settings{"zstdkg": fred.Zstd, "snappykg": fred.Snappy}
+
+	s := New(inner, n)
+	t.Cleanup(func() { _ = s.Close() })
+
+	for _, kg := range []string{"zstdkg", "snappykg", "plainkg"} {
+		assert.NoError(t, s.CreateKeygroup(kg))
+
+		// an item that was written before compression was enabled
+		assert.NoError(t, inner.Update(kg, "old", long, false, 0, vclock.VClock{"X": 1}))
+
+		assert.NoError(t, s.Update(kg, "a", long, false, 0, vclock.VClock{"X": 1}))
+		assert.NoError(t, s.UpdateBatch(kg, []string{"b"}, []string{"b" + long}, []bool{false}, []int{0}, []vclock.VClock{{"X": 1}}, [][]vclock.VClock{nil}))
+
+		vals, _, _, found, err := s.Read(kg, "a")
+		assert.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, []string{long}, vals)
+
+		ids, vals, _, _, err := s.ReadPage(kg, "", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "old"}, ids)
+		assert.Equal(t, []string{long, "b" + long, long}, vals)
+
+		raw, _, _, _, err := inner.Read(kg, "a")
+		assert.NoError(t, err)
+
+		if kg == "plainkg" {
+			assert.Equal(t, long, raw[0])
+		} else {
+			assert.Less(t, len(raw[0]), len(long))
+		}
+	}
+
+	// changing the codec of a keygroup keeps earlier values readable
+	n["zstdkg"] = fred.Snappy
+	assert.NoError(t, s.Update("zstdkg", "c", long, false, 0, vclock.VClock{"X": 1}))
+
+	_, vals, _, _, err := s.ReadAll("zstdkg")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{long, "b" + long, long, long}, vals)
+}
+
+func TestLegacyValues(t *testing.T) {
+	long := strings.Repeat("compressible ", 100)
+
+	inner := badgerdb.NewMemory()
+	s := New(inner, settings{"legacykg": fred.Zstd})
+	t.Cleanup(func() { _ = s.Close() })
+
+	assert.NoError(t, s.CreateKeygroup("legacykg"))
+
+	// values that were written before compression was enabled and start with the magic, even with a valid version and
+	// codec
+	legacy := []string{
+		magic,
+		magic + "\x00" + long,
+		magic + "\x01\x00" + strings.Repeat("\x00", 8) + long,
+		magic + "\x01\x01" + strings.Repeat("\x00", 8) + long,
+	}
+
+	for i, val := range legacy {
+		assert.NoError(t, inner.Update("legacykg", fmt.Sprintf("legacy%d", i), val, false, 0, vclock.VClock{"X": 1}))
+	}
+
+	assert.NoError(t, s.Update("legacykg", "new", long, false, 0, vclock.VClock{"X": 1}))
+
+	ids, vals, _, _, err := s.ReadPage("legacykg", "", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"legacy0", "legacy1", "legacy2", "legacy3", "new"}, ids)
+	assert.Equal(t, append(legacy, long), vals)
+}
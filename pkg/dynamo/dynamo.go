@@ -31,6 +31,8 @@ const (
 	triggerName  = "Trigger"
 	expiryKey    = "Expiry"
 	NULLValue    = "%NULL%"
+	// batchAttempts is how often UpdateBatch tries to write a batch whose items are changed concurrently.
+	batchAttempts = 5
 )
 
 // Storage is a struct that saves all necessary information to access the database, in this case the session for
@@ -52,10 +54,14 @@ const (
 // Two types of items are stored here:
 //   - Keygroup configuration is stored with the NULL "Key" and the keygroup name: this has  the "Trigger" attribute that
 //     stores a map of trigger nodes for that keygroup
-//   - Keys are stored with a "Keygroup" and unique "Key", where the Value is a list of version vectors and values - the
+//   - Keys are stored with a "Keygroup" and unique "Key", where the Value is a map of version vectors to values - the
 //     additional "Expiry" attribute can be set to let the keys expire, and it is updated with each update to the data item
 //     (note that this means that in DynamoDB, all versions of an item expire at the same time, not necessarily in the
 //     order in which they appeared)
+//
+// Values are stored as binary (B) attributes and tombstones as NULL attributes. Tables written by older versions store
+// values as string (S) attributes, where an empty string is a tombstone. Those are still read correctly and are
+// replaced as items are updated, so there is no need to migrate a table explicitly.
 type Storage struct {
 	dynamotable string
 	svc         *dynamodb.Client
@@ -78,6 +84,31 @@ func vectorFromString(s string) (vclock.VClock, error) {
 	return b, nil
 }
 
+// encodeValue encodes a version of an item: values are stored as binary attributes and tombstones as NULL attributes.
+func encodeValue(val string, tombstoned bool) dynamoDBTypes.AttributeValue {
+	if tombstoned {
+		return &dynamoDBTypes.AttributeValueMemberNULL{Value: true}
+	}
+
+	return &dynamoDBTypes.AttributeValueMemberB{Value: []byte(val)}
+}
+
+// decodeValue decodes a version of an item and returns its value and whether it is a tombstone. Versions that were
+// written before values were stored as binary attributes are string attributes, in which an empty string is a
+// tombstone.
+func decodeValue(data dynamoDBTypes.AttributeValue) (string, bool, bool) {
+	switch v := data.(type) {
+	case *dynamoDBTypes.AttributeValueMemberB:
+		return string(v.Value), false, true
+	case *dynamoDBTypes.AttributeValueMemberNULL:
+		return "", true, true
+	case *dynamoDBTypes.AttributeValueMemberS:
+		return v.Value, v.Value == "", true
+	}
+
+	return "", false, false
+}
+
 func vectorToString(v vclock.VClock) string {
 	return url.QueryEscape(vector.SortedVCString(v))
 }
@@ -213,7 +244,7 @@ func (s *Storage) Close() error {
 }
 
 // Read returns an item with the specified id from the specified keygroup.
-func (s *Storage) Read(kg string, id string) ([]string, []vclock.VClock, bool, error) {
+func (s *Storage) Read(kg string, id string) ([]string, []bool, []vclock.VClock, bool, error) {
 	// To read, we need to get the item with the "Keygroup" kg and "Key" id and convert the returned "Value" to a list
 	// of strings and vclocks.
 	proj := expression.NamesList(expression.Name(keyName), expression.Name(valName), expression.Name(expiryKey))
@@ -222,7 +253,7 @@ func (s *Storage) Read(kg string, id string) ([]string, []vclock.VClock, bool, e
 
 	if err != nil {
 		log.Error().Msg(errors.New(err).ErrorStack())
-		return nil, nil, false, errors.New(err)
+		return nil, nil, nil, false, errors.New(err)
 	}
 
 	result, err := s.svc.GetItem(context.TODO(), &dynamodb.GetItemInput{
@@ -241,19 +272,19 @@ func (s *Storage) Read(kg string, id string) ([]string, []vclock.VClock, bool, e
 
 	if err != nil {
 		log.Error().Msg(errors.New(err).ErrorStack())
-		return nil, nil, false, errors.New(err)
+		return nil, nil, nil, false, errors.New(err)
 	}
 
 	// check if the item was found at all
 	if result.Item == nil {
-		return nil, nil, false, nil
+		return nil, nil, nil, false, nil
 	}
 
 	// let's check the value of the item we got back
 	val, ok := result.Item[valName]
 
 	if !ok {
-		return nil, nil, false, nil
+		return nil, nil, nil, false, nil
 	}
 
 	// check that the item hasn't actually expired but wasn't cleaned up
@@ -264,14 +295,14 @@ func (s *Storage) Read(kg string, id string) ([]string, []vclock.VClock, bool, e
 			expiry, err := strconv.Atoi(expiration.Value)
 			if err != nil {
 				log.Error().Msg(errors.New(err).ErrorStack())
-				return nil, nil, false, errors.New(err)
+				return nil, nil, nil, false, errors.New(err)
 			}
 
 			log.Debug().Msgf("Read found key expiring at %d, it is %d now", expiry, time.Now().UTC().Unix())
 
 			// oops, item has expired - we treat this as "not found"
 			if int64(expiry) < time.Now().UTC().Unix() {
-				return nil, nil, false, nil
+				return nil, nil, nil, false, nil
 			}
 		}
 	}
@@ -281,10 +312,11 @@ func (s *Storage) Read(kg string, id string) ([]string, []vclock.VClock, bool, e
 	values, ok := val.(*dynamoDBTypes.AttributeValueMemberM)
 
 	if !ok || values == nil || len(values.Value) == 0 {
-		return nil, nil, false, nil
+		return nil, nil, nil, false, nil
 	}
 
 	items := make([]string, 0, len(values.Value))
+	tombstones := make([]bool, 0, len(values.Value))
 	vvectors := make([]vclock.VClock, 0, len(values.Value))
 
 	for v, data := range values.Value {
@@ -292,25 +324,26 @@ func (s *Storage) Read(kg string, id string) ([]string, []vclock.VClock, bool, e
 
 		if err != nil {
 			log.Error().Msg(errors.New(err).ErrorStack())
-			return nil, nil, false, errors.New(err)
+			return nil, nil, nil, false, errors.New(err)
 		}
 
-		i, ok := data.(*dynamoDBTypes.AttributeValueMemberS)
+		i, tombstoned, ok := decodeValue(data)
 
 		if !ok {
-			return nil, nil, false, errors.Errorf("Read: can't parse item value")
+			return nil, nil, nil, false, errors.Errorf("Read: can't parse item value")
 		}
 
 		vvectors = append(vvectors, version)
 
-		items = append(items, i.Value)
+		items = append(items, i)
+		tombstones = append(tombstones, tombstoned)
 	}
 
-	return items, vvectors, true, nil
+	return items, tombstones, vvectors, true, nil
 
 }
 
-func (s *Storage) ReadSome(kg string, id string, count uint64) ([]string, []string, []vclock.VClock, error) {
+func (s *Storage) ReadSome(kg string, id string, count uint64) ([]string, []string, []bool, []vclock.VClock, error) {
 
 	// in this case we need to get all items with "Keygroup" kg and then sort them
 	filt := expression.Name(keygroupName).Equal(expression.Value(kg)).And(expression.Name(keyName).GreaterThanEqual(expression.Value(id)))
@@ -320,7 +353,7 @@ func (s *Storage) ReadSome(kg string, id string, count uint64) ([]string, []stri
 
 	if err != nil {
 		log.Error().Msg(errors.New(err).ErrorStack())
-		return nil, nil, nil, errors.New(err)
+		return nil, nil, nil, nil, errors.New(err)
 	}
 
 	params := &dynamodb.ScanInput{
@@ -340,9 +373,10 @@ func (s *Storage) ReadSome(kg string, id string, count uint64) ([]string, []stri
 	}
 
 	type item struct {
-		key     string
-		val     string
-		version vclock.VClock
+		key        string
+		val        string
+		tombstoned bool
+		version    vclock.VClock
 	}
 
 	items := make([]item, 0, len(result.Items))
@@ -351,13 +385,13 @@ func (s *Storage) ReadSome(kg string, id string, count uint64) ([]string, []stri
 		key, ok := i[keyName]
 
 		if !ok {
-			return nil, nil, nil, errors.Errorf("ReadSome: internal error, can't find key")
+			return nil, nil, nil, nil, errors.Errorf("ReadSome: internal error, can't find key")
 		}
 
 		k, ok := key.(*dynamoDBTypes.AttributeValueMemberS)
 
 		if !ok {
-			return nil, nil, nil, errors.Errorf("ReadSome: malformed key")
+			return nil, nil, nil, nil, errors.Errorf("ReadSome: malformed key")
 		}
 
 		if k.Value == NULLValue {
@@ -367,7 +401,7 @@ func (s *Storage) ReadSome(kg string, id string, count uint64) ([]string, []stri
 		val, ok := i[valName]
 
 		if !ok {
-			return nil, nil, nil, errors.Errorf("ReadSome: malformed value")
+			return nil, nil, nil, nil, errors.Errorf("ReadSome: malformed value")
 		}
 
 		if e, ok := i[expiryKey]; ok {
@@ -377,7 +411,7 @@ func (s *Storage) ReadSome(kg string, id string, count uint64) ([]string, []stri
 				expiry, err := strconv.Atoi(expiration.Value)
 				if err != nil {
 					log.Error().Msg(errors.New(err).ErrorStack())
-					return nil, nil, nil, errors.New(err)
+					return nil, nil, nil, nil, errors.New(err)
 				}
 
 				log.Debug().Msgf("ReadSome found key expiring at %d, it is %d now", expiry, time.Now().UTC().Unix())
@@ -400,18 +434,19 @@ func (s *Storage) ReadSome(kg string, id string, count uint64) ([]string, []stri
 
 			if err != nil {
 				log.Error().Msg(errors.New(err).ErrorStack())
-				return nil, nil, nil, errors.New(err)
+				return nil, nil, nil, nil, errors.New(err)
 			}
 
-			it, ok := data.(*dynamoDBTypes.AttributeValueMemberS)
+			it, tombstoned, ok := decodeValue(data)
 
 			if !ok {
-				return nil, nil, nil, errors.Errorf("ReadSome: malformed item")
+				return nil, nil, nil, nil, errors.Errorf("ReadSome: malformed item")
 			}
 
 			items = append(items, item{
 				k.Value,
-				it.Value,
+				it,
+				tombstoned,
 				version,
 			})
 
@@ -421,6 +456,7 @@ func (s *Storage) ReadSome(kg string, id string, count uint64) ([]string, []stri
 	// now we have a list of items, sort them and convert to lists
 	keys := make([]string, 0, len(items))
 	values := make([]string, 0, len(items))
+	tombstones := make([]bool, 0, len(items))
 	versions := make([]vclock.VClock, 0, len(items))
 
 	// now we have lists of keys and items, we need to sort them by the key attribute alphabetically
@@ -441,14 +477,285 @@ func (s *Storage) ReadSome(kg string, id string, count uint64) ([]string, []stri
 
 		keys = append(keys, x.key)
 		values = append(values, x.val)
+		tombstones = append(tombstones, x.tombstoned)
 		versions = append(versions, x.version)
 	}
 
-	return keys, values, versions, nil
+	return keys, values, tombstones, versions, nil
+}
+
+// ReadPage returns all versions of the next count items in the specified keygroup that come after id.
+func (s *Storage) ReadPage(kg string, after string, count uint64) ([]string, []string, []bool, []vclock.VClock, error) {
+	// we can use a query here instead of a scan, as DynamoDB returns items sorted by their sort key ("Key") for a
+	// given partition key ("Keygroup")
+	keyCond := expression.Key(keygroupName).Equal(expression.Value(kg))
+
+	if after != "" {
+		keyCond = keyCond.And(expression.Key(keyName).GreaterThan(expression.Value(after)))
+	}
+
+	proj := expression.NamesList(expression.Name(keyName), expression.Name(valName), expression.Name(expiryKey))
+
+	expr, err := expression.NewBuilder().WithKeyCondition(keyCond).WithProjection(proj).Build()
+
+	if err != nil {
+		log.Error().Msg(errors.New(err).ErrorStack())
+		return nil, nil, nil, nil, errors.New(err)
+	}
+
+	keys := make([]string, 0)
+	values := make([]string, 0)
+	tombstones := make([]bool, 0)
+	versions := make([]vclock.VClock, 0)
+
+	var ids uint64
+	var startKey map[string]dynamoDBTypes.AttributeValue
+
+	// expired items and the keygroup configuration are filtered out here, so we may need more than one query to fill
+	// the page
+	for ids < count {
+		params := &dynamodb.QueryInput{
+			ExpressionAttributeNames:  expr.Names(),
+			ExpressionAttributeValues: expr.Values(),
+			KeyConditionExpression:    expr.KeyCondition(),
+			ProjectionExpression:      expr.Projection(),
+			TableName:                 aws.String(s.dynamotable),
+			ExclusiveStartKey:         startKey,
+			Limit:                     aws.Int32(int32(count - ids)),
+		}
+
+		result, err := s.svc.Query(context.TODO(), params)
+		if err != nil {
+			log.Error().Msg(errors.New(err).ErrorStack())
+			return nil, nil, nil, nil, errors.New(err)
+		}
+
+		for _, i := range result.Items {
+			key, ok := i[keyName]
+
+			if !ok {
+				return nil, nil, nil, nil, errors.Errorf("ReadPage: internal error, can't find key")
+			}
+
+			k, ok := key.(*dynamoDBTypes.AttributeValueMemberS)
+
+			if !ok {
+				return nil, nil, nil, nil, errors.Errorf("ReadPage: malformed key")
+			}
+
+			if k.Value == NULLValue {
+				continue
+			}
+
+			val, ok := i[valName]
+
+			if !ok {
+				return nil, nil, nil, nil, errors.Errorf("ReadPage: malformed value")
+			}
+
+			if e, ok := i[expiryKey]; ok {
+				expiration, ok := e.(*dynamoDBTypes.AttributeValueMemberN)
+
+				if ok {
+					expiry, err := strconv.Atoi(expiration.Value)
+					if err != nil {
+						log.Error().Msg(errors.New(err).ErrorStack())
+						return nil, nil, nil, nil, errors.New(err)
+					}
+
+					// oops, item has expired - we treat this as "not found"
+					if int64(expiry) < time.Now().UTC().Unix() {
+						continue
+					}
+				}
+			}
+
+			vals, ok := val.(*dynamoDBTypes.AttributeValueMemberM)
+
+			if !ok || vals == nil || len(vals.Value) == 0 {
+				continue
+			}
+
+			for v, data := range vals.Value {
+				version, err := vectorFromString(v)
+
+				if err != nil {
+					log.Error().Msg(errors.New(err).ErrorStack())
+					return nil, nil, nil, nil, errors.New(err)
+				}
+
+				it, tombstoned, ok := decodeValue(data)
+
+				if !ok {
+					return nil, nil, nil, nil, errors.Errorf("ReadPage: malformed item")
+				}
+
+				keys = append(keys, k.Value)
+				values = append(values, it)
+				tombstones = append(tombstones, tombstoned)
+				versions = append(versions, version)
+			}
+
+			ids++
+		}
+
+		if len(result.LastEvaluatedKey) == 0 {
+			break
+		}
+
+		startKey = result.LastEvaluatedKey
+	}
+
+	return keys, values, tombstones, versions, nil
+}
+
+// ReadRange returns all versions of up to count items in the specified keygroup with ids between start (inclusive) and
+// end (exclusive) that start with prefix. Items are ordered lexicographically by their ids, or in reverse. An empty
+// start or end means that the range is not bounded on that side.
+func (s *Storage) ReadRange(kg string, start string, end string, prefix string, reverse bool, count uint64) ([]string, []string, []bool, []vclock.VClock, error) {
+	keys := make([]string, 0)
+	values := make([]string, 0)
+	tombstones := make([]bool, 0)
+	versions := make([]vclock.VClock, 0)
+
+	if count == 0 {
+		return keys, values, tombstones, versions, nil
+	}
+
+	// DynamoDB returns items sorted by their sort key ("Key") for a given partition key ("Keygroup"), in either
+	// direction, but only allows one condition on the sort key. The other bounds are checked below.
+	keyCond := expression.Key(keygroupName).Equal(expression.Value(kg))
+
+	switch {
+	case prefix != "":
+		keyCond = keyCond.And(expression.Key(keyName).BeginsWith(prefix))
+	case start != "" && end != "":
+		keyCond = keyCond.And(expression.Key(keyName).Between(expression.Value(start), expression.Value(end)))
+	case start != "":
+		keyCond = keyCond.And(expression.Key(keyName).GreaterThanEqual(expression.Value(start)))
+	case end != "":
+		keyCond = keyCond.And(expression.Key(keyName).LessThan(expression.Value(end)))
+	}
+
+	proj := expression.NamesList(expression.Name(keyName), expression.Name(valName), expression.Name(expiryKey))
+
+	expr, err := expression.NewBuilder().WithKeyCondition(keyCond).WithProjection(proj).Build()
+
+	if err != nil {
+		log.Error().Msg(errors.New(err).ErrorStack())
+		return nil, nil, nil, nil, errors.New(err)
+	}
+
+	var ids uint64
+	var startKey map[string]dynamoDBTypes.AttributeValue
+
+	for ids < count {
+		params := &dynamodb.QueryInput{
+			ExpressionAttributeNames:  expr.Names(),
+			ExpressionAttributeValues: expr.Values(),
+			KeyConditionExpression:    expr.KeyCondition(),
+			ProjectionExpression:      expr.Projection(),
+			TableName:                 aws.String(s.dynamotable),
+			ExclusiveStartKey:         startKey,
+			ScanIndexForward:          aws.Bool(!reverse),
+			Limit:                     aws.Int32(int32(count - ids)),
+		}
+
+		result, err := s.svc.Query(context.TODO(), params)
+		if err != nil {
+			log.Error().Msg(errors.New(err).ErrorStack())
+			return nil, nil, nil, nil, errors.New(err)
+		}
+
+		for _, i := range result.Items {
+			if ids == count {
+				break
+			}
+
+			key, ok := i[keyName]
+
+			if !ok {
+				return nil, nil, nil, nil, errors.Errorf("ReadRange: internal error, can't find key")
+			}
+
+			k, ok := key.(*dynamoDBTypes.AttributeValueMemberS)
+
+			if !ok {
+				return nil, nil, nil, nil, errors.Errorf("ReadRange: malformed key")
+			}
+
+			if k.Value == NULLValue {
+				continue
+			}
+
+			if k.Value < start || (end != "" && k.Value >= end) {
+				continue
+			}
+
+			val, ok := i[valName]
+
+			if !ok {
+				return nil, nil, nil, nil, errors.Errorf("ReadRange: malformed value")
+			}
+
+			if e, ok := i[expiryKey]; ok {
+				expiration, ok := e.(*dynamoDBTypes.AttributeValueMemberN)
+
+				if ok {
+					expiry, err := strconv.Atoi(expiration.Value)
+					if err != nil {
+						log.Error().Msg(errors.New(err).ErrorStack())
+						return nil, nil, nil, nil, errors.New(err)
+					}
+
+					// oops, item has expired - we treat this as "not found"
+					if int64(expiry) < time.Now().UTC().Unix() {
+						continue
+					}
+				}
+			}
+
+			vals, ok := val.(*dynamoDBTypes.AttributeValueMemberM)
+
+			if !ok || vals == nil || len(vals.Value) == 0 {
+				continue
+			}
+
+			for v, data := range vals.Value {
+				version, err := vectorFromString(v)
+
+				if err != nil {
+					log.Error().Msg(errors.New(err).ErrorStack())
+					return nil, nil, nil, nil, errors.New(err)
+				}
+
+				it, tombstoned, ok := decodeValue(data)
+
+				if !ok {
+					return nil, nil, nil, nil, errors.Errorf("ReadRange: malformed item")
+				}
+
+				keys = append(keys, k.Value)
+				values = append(values, it)
+				tombstones = append(tombstones, tombstoned)
+				versions = append(versions, version)
+			}
+
+			ids++
+		}
+
+		if len(result.LastEvaluatedKey) == 0 {
+			break
+		}
+
+		startKey = result.LastEvaluatedKey
+	}
+
+	return keys, values, tombstones, versions, nil
 }
 
 // ReadAll returns all items in the specified keygroup.
-func (s *Storage) ReadAll(kg string) ([]string, []string, []vclock.VClock, error) {
+func (s *Storage) ReadAll(kg string) ([]string, []string, []bool, []vclock.VClock, error) {
 
 	// in this case we need to get all items with "Keygroup" kg and then sort them
 	filt := expression.Name(keygroupName).Equal(expression.Value(kg))
@@ -458,7 +765,7 @@ func (s *Storage) ReadAll(kg string) ([]string, []string, []vclock.VClock, error
 
 	if err != nil {
 		log.Error().Msg(errors.New(err).ErrorStack())
-		return nil, nil, nil, errors.New(err)
+		return nil, nil, nil, nil, errors.New(err)
 	}
 
 	params := &dynamodb.ScanInput{
@@ -473,15 +780,16 @@ func (s *Storage) ReadAll(kg string) ([]string, []string, []vclock.VClock, error
 	result, err := s.svc.Scan(context.TODO(), params)
 	if err != nil {
 		log.Error().Msg(errors.New(err).ErrorStack())
-		return nil, nil, nil, errors.New(err)
+		return nil, nil, nil, nil, errors.New(err)
 	}
 
 	log.Debug().Msgf("ReadAll: got %d items", len(result.Items))
 
 	type item struct {
-		key     string
-		val     string
-		version vclock.VClock
+		key        string
+		val        string
+		tombstoned bool
+		version    vclock.VClock
 	}
 
 	items := make([]item, 0, len(result.Items))
@@ -490,13 +798,13 @@ func (s *Storage) ReadAll(kg string) ([]string, []string, []vclock.VClock, error
 		key, ok := i[keyName]
 
 		if !ok {
-			return nil, nil, nil, nil
+			return nil, nil, nil, nil, nil
 		}
 
 		k, ok := key.(*dynamoDBTypes.AttributeValueMemberS)
 
 		if !ok {
-			return nil, nil, nil, errors.Errorf("ReadAll: malformed key")
+			return nil, nil, nil, nil, errors.Errorf("ReadAll: malformed key")
 		}
 
 		if k.Value == NULLValue {
@@ -506,7 +814,7 @@ func (s *Storage) ReadAll(kg string) ([]string, []string, []vclock.VClock, error
 		val, ok := i[valName]
 
 		if !ok {
-			return nil, nil, nil, errors.Errorf("ReadAll: malformed value")
+			return nil, nil, nil, nil, errors.Errorf("ReadAll: malformed value")
 		}
 
 		if e, ok := i[expiryKey]; ok {
@@ -516,7 +824,7 @@ func (s *Storage) ReadAll(kg string) ([]string, []string, []vclock.VClock, error
 				expiry, err := strconv.Atoi(expiration.Value)
 				if err != nil {
 					log.Error().Msg(errors.New(err).ErrorStack())
-					return nil, nil, nil, errors.New(err)
+					return nil, nil, nil, nil, errors.New(err)
 				}
 
 				log.Debug().Msgf("ReadAll found key expiring at %d, it is %d now", expiry, time.Now().UTC().Unix())
@@ -538,18 +846,19 @@ func (s *Storage) ReadAll(kg string) ([]string, []string, []vclock.VClock, error
 
 			if err != nil {
 				log.Error().Msg(errors.New(err).ErrorStack())
-				return nil, nil, nil, errors.New(err)
+				return nil, nil, nil, nil, errors.New(err)
 			}
 
-			it, ok := data.(*dynamoDBTypes.AttributeValueMemberS)
+			it, tombstoned, ok := decodeValue(data)
 
 			if !ok {
-				return nil, nil, nil, errors.Errorf("ReadAll: malformed item")
+				return nil, nil, nil, nil, errors.Errorf("ReadAll: malformed item")
 			}
 
 			items = append(items, item{
 				k.Value,
-				it.Value,
+				it,
+				tombstoned,
 				version,
 			})
 		}
@@ -558,6 +867,7 @@ func (s *Storage) ReadAll(kg string) ([]string, []string, []vclock.VClock, error
 	// now we have a list of items, sort them and convert to lists
 	keys := make([]string, 0, len(items))
 	values := make([]string, 0, len(items))
+	tombstones := make([]bool, 0, len(items))
 	versions := make([]vclock.VClock, 0, len(items))
 
 	// now we have lists of keys and items, we need to sort them by the key attribute alphabetically
@@ -568,10 +878,11 @@ func (s *Storage) ReadAll(kg string) ([]string, []string, []vclock.VClock, error
 	for _, x := range items {
 		keys = append(keys, x.key)
 		values = append(values, x.val)
+		tombstones = append(tombstones, x.tombstoned)
 		versions = append(versions, x.version)
 	}
 
-	return keys, values, versions, nil
+	return keys, values, tombstones, versions, nil
 }
 
 // Append appends the item to the specified keygroup by incrementing the latest key by one.
@@ -595,9 +906,7 @@ func (s *Storage) Append(kg string, id string, val string, expiry int) error {
 			},
 			valName: &dynamoDBTypes.AttributeValueMemberM{
 				Value: map[string]dynamoDBTypes.AttributeValue{
-					vectorToString(vclock.VClock{}): &dynamoDBTypes.AttributeValueMemberS{
-						Value: val,
-					},
+					vectorToString(vclock.VClock{}): encodeValue(val, false),
 				},
 			},
 		},
@@ -702,7 +1011,7 @@ func (s *Storage) IDs(kg string) ([]string, error) {
 }
 
 // Update updates the item with the specified id in the specified keygroup.
-func (s *Storage) Update(kg string, id string, val string, expiry int, vvector vclock.VClock) error {
+func (s *Storage) Update(kg string, id string, val string, tombstoned bool, expiry int, vvector vclock.VClock) error {
 	version := vectorToString(vvector)
 
 	input := &dynamodb.UpdateItemInput{
@@ -720,9 +1029,7 @@ func (s *Storage) Update(kg string, id string, val string, expiry int, vvector v
 			"#version": version,
 		},
 		ExpressionAttributeValues: map[string]dynamoDBTypes.AttributeValue{
-			":data": &dynamoDBTypes.AttributeValueMemberS{
-				Value: val,
-			},
+			":data": encodeValue(val, tombstoned),
 		},
 		UpdateExpression: aws.String("SET #value.#version = :data"),
 	}
@@ -770,9 +1077,7 @@ func (s *Storage) Update(kg string, id string, val string, expiry int, vvector v
 						ExpressionAttributeValues: map[string]dynamoDBTypes.AttributeValue{
 							":data": &dynamoDBTypes.AttributeValueMemberM{
 								Value: map[string]dynamoDBTypes.AttributeValue{
-									version: &dynamoDBTypes.AttributeValueMemberS{
-										Value: val,
-									},
+									version: encodeValue(val, tombstoned),
 								},
 							},
 						},
@@ -804,8 +1109,209 @@ func (s *Storage) Update(kg string, id string, val string, expiry int, vvector v
 	return nil
 }
 
-// Delete deletes the item with the specified id from the specified keygroup.
+// UpdateBatch stores new versions of several items and removes the versions they replace in a single transaction.
+// As DynamoDB cannot create the map of versions for an item and add to it within the same transaction, we first read
+// the current versions of each item and then replace the items entirely. Each item is only replaced if it still has the
+// versions that were read, otherwise the whole batch is read and written again, up to batchAttempts times.
+func (s *Storage) UpdateBatch(kg string, ids []string, vals []string, tombstones []bool, expiries []int, vvectors []vclock.VClock, replaced [][]vclock.VClock) error {
+	// this is the maximum number of actions in a DynamoDB transaction
+	if len(ids) > 100 {
+		return errors.Errorf("UpdateBatch: cannot write more than 100 items at once, got %d", len(ids))
+	}
+
+	retainVersions, retainWindow, err := s.getRetention(kg)
+
+	if err != nil {
+		return err
+	}
+
+	for attempt := 1; ; attempt++ {
+		puts, err := s.batchPuts(kg, ids, vals, tombstones, expiries, vvectors, replaced, retainVersions, retainWindow)
+
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Msgf("UpdateBatch: writing %d items in keygroup %s", len(ids), kg)
+
+		_, err = s.svc.TransactWriteItems(context.TODO(), &dynamodb.TransactWriteItemsInput{
+			TransactItems: puts,
+		})
+
+		if err == nil {
+			return nil
+		}
+
+		if !conditionFailed(err) {
+			log.Error().Msg(errors.New(err).ErrorStack())
+			return errors.New(err)
+		}
+
+		if attempt == batchAttempts {
+			return errors.Errorf("UpdateBatch: items in keygroup %s were changed concurrently in all %d attempts", kg, batchAttempts)
+		}
+
+		log.Debug().Msgf("UpdateBatch: items in keygroup %s were changed concurrently, trying again", kg)
+	}
+}
+
+// batchPuts reads the items of a batch and returns the puts that write their new versions. Each put only succeeds if
+// the item still has the versions that were read.
+func (s *Storage) batchPuts(kg string, ids []string, vals []string, tombstones []bool, expiries []int, vvectors []vclock.VClock, replaced [][]vclock.VClock, retainVersions int, retainWindow int) ([]dynamoDBTypes.TransactWriteItem, error) {
+	puts := make([]dynamoDBTypes.TransactWriteItem, len(ids))
+
+	for i := range ids {
+		old, err := s.getItem(kg, ids[i])
+
+		if err != nil {
+			return nil, err
+		}
+
+		versions := make(map[string]dynamoDBTypes.AttributeValue)
+
+		// versions of an item that has expired but wasn't cleaned up are gone
+		if current, ok := old[valName].(*dynamoDBTypes.AttributeValueMemberM); ok && !expired(old) {
+			for v, data := range current.Value {
+				versions[v] = data
+			}
+		}
+
+		for _, v := range replaced[i] {
+			delete(versions, vectorToString(v))
+		}
+
+		versions[vectorToString(vvectors[i])] = encodeValue(vals[i], tombstones[i])
+
+		item := map[string]dynamoDBTypes.AttributeValue{
+			keygroupName: &dynamoDBTypes.AttributeValueMemberS{
+				Value: kg,
+			},
+			keyName: &dynamoDBTypes.AttributeValueMemberS{
+				Value: ids[i],
+			},
+			valName: &dynamoDBTypes.AttributeValueMemberM{
+				Value: versions,
+			},
+		}
+
+		if expiries[i] > 0 {
+			item[expiryKey] = &dynamoDBTypes.AttributeValueMemberN{
+				Value: strconv.FormatInt(time.Now().UTC().Unix()+int64(expiries[i]), 10),
+			}
+		}
+
+		// the item is replaced entirely, so its history has to be written again as well
+		if retainVersions > 0 || retainWindow > 0 {
+			history, err := decodeHistory(old[historyName])
+
+			if err != nil {
+				return nil, err
+			}
+
+			archive(old, history, replaced[i], retainVersions, retainWindow)
+
+			item[historyName] = encodeHistory(history)
+		}
+
+		cond, err := unchanged(old)
+
+		if err != nil {
+			return nil, err
+		}
+
+		expr, err := expression.NewBuilder().WithCondition(cond).Build()
+
+		if err != nil {
+			log.Error().Msg(errors.New(err).ErrorStack())
+			return nil, errors.New(err)
+		}
+
+		puts[i] = dynamoDBTypes.TransactWriteItem{
+			Put: &dynamoDBTypes.Put{
+				Item:                      item,
+				TableName:                 aws.String(s.dynamotable),
+				ConditionExpression:       expr.Condition(),
+				ExpressionAttributeNames:  expr.Names(),
+				ExpressionAttributeValues: expr.Values(),
+			},
+		}
+	}
+
+	return puts, nil
+}
+
+// unchanged returns a condition that holds as long as an item has the same versions with the same values as when it
+// was read.
+func unchanged(old map[string]dynamoDBTypes.AttributeValue) (expression.ConditionBuilder, error) {
+	current, ok := old[valName].(*dynamoDBTypes.AttributeValueMemberM)
+
+	if !ok {
+		return expression.Name(valName).AttributeNotExists(), nil
+	}
+
+	cond := expression.Name(valName).Size().Equal(expression.Value(len(current.Value)))
+
+	for v, data := range current.Value {
+		// version vectors can contain dots, which must not be read as paths
+		name := expression.Name(valName).AppendName(expression.NameNoDotSplit(v))
+
+		switch d := data.(type) {
+		case *dynamoDBTypes.AttributeValueMemberB:
+			cond = cond.And(name.Equal(expression.Value(*d)))
+		case *dynamoDBTypes.AttributeValueMemberS:
+			cond = cond.And(name.Equal(expression.Value(*d)))
+		case *dynamoDBTypes.AttributeValueMemberNULL:
+			cond = cond.And(name.AttributeType(expression.Null))
+		default:
+			return cond, errors.Errorf("unchanged: can't parse item value")
+		}
+	}
+
+	return cond, nil
+}
+
+// conditionFailed returns whether a transaction was canceled because the condition of one of its actions failed.
+func conditionFailed(err error) bool {
+	var e *dynamoDBTypes.TransactionCanceledException
+
+	if !errors.As(err, &e) {
+		return false
+	}
+
+	for _, r := range e.CancellationReasons {
+		if r.Code != nil && *r.Code == "ConditionalCheckFailed" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// expired returns whether an item has expired but wasn't cleaned up yet.
+func expired(item map[string]dynamoDBTypes.AttributeValue) bool {
+	e, ok := item[expiryKey].(*dynamoDBTypes.AttributeValueMemberN)
+
+	if !ok {
+		return false
+	}
+
+	expiry, err := strconv.ParseInt(e.Value, 10, 64)
+
+	return err == nil && expiry < time.Now().UTC().Unix()
+}
+
+// Delete deletes a version of the item with the specified id from the specified keygroup. The version is kept in the
+// history of the item if the keygroup keeps one.
 func (s *Storage) Delete(kg string, id string, vvector vclock.VClock) error {
+	versions, window, err := s.getRetention(kg)
+
+	if err != nil {
+		return err
+	}
+
+	if versions > 0 || window > 0 {
+		return s.archiveVersion(kg, id, vvector, versions, window)
+	}
 
 	version := vectorToString(vvector)
 
@@ -828,7 +1334,7 @@ func (s *Storage) Delete(kg string, id string, vvector vclock.VClock) error {
 
 	log.Debug().Msgf("Delete: removing item %s (keygroup %s) with version %s", id, kg, version)
 
-	_, err := s.svc.UpdateItem(context.TODO(), input)
+	_, err = s.svc.UpdateItem(context.TODO(), input)
 	if err != nil {
 		if e, ok := err.(*smithy.OperationError); ok {
 			// ignore error if it says that the key does not exist
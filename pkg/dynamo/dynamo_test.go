@@ -9,6 +9,10 @@ import (
 	"time"
 
 	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	dynamoDBTypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/smithy-go"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
@@ -109,7 +113,7 @@ func TestItemExists(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg, id, value, 0, vclock.VClock{})
+	err = db.Update(kg, id, value, false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 	ex := db.Exists(kg, id)
@@ -130,17 +134,149 @@ func TestItemGet(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg, id, value, 0, vclock.VClock{})
+	err = db.Update(kg, id, value, false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	retr, _, _, err := db.Read(kg, id)
+	retr, _, _, _, err := db.Read(kg, id)
 	assert.NoError(t, err)
 
 	assert.Len(t, retr, 1)
 	assert.Equal(t, retr[0], value)
 }
 
+func TestEmptyValue(t *testing.T) {
+	kg := "test-kg-empty"
+
+	err := db.CreateKeygroup(kg)
+
+	assert.NoError(t, err)
+
+	err = db.Update(kg, "empty", "", false, 0, vclock.VClock{"X": 1})
+
+	assert.NoError(t, err)
+
+	err = db.Update(kg, "deleted", "", true, 0, vclock.VClock{"X": 1})
+
+	assert.NoError(t, err)
+
+	retr, tombstones, _, found, err := db.Read(kg, "empty")
+
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []string{""}, retr)
+	assert.Equal(t, []bool{false}, tombstones)
+
+	_, tombstones, _, found, err = db.Read(kg, "deleted")
+
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []bool{true}, tombstones)
+}
+
+func TestDecodeValue(t *testing.T) {
+	val, tombstoned, ok := decodeValue(encodeValue("\x00\xff", false))
+	assert.True(t, ok)
+	assert.False(t, tombstoned)
+	assert.Equal(t, "\x00\xff", val)
+
+	_, tombstoned, ok = decodeValue(encodeValue("", true))
+	assert.True(t, ok)
+	assert.True(t, tombstoned)
+
+	// values written before they were binary are strings where empty strings are tombstones
+	val, tombstoned, ok = decodeValue(&dynamoDBTypes.AttributeValueMemberS{Value: "old"})
+	assert.True(t, ok)
+	assert.False(t, tombstoned)
+	assert.Equal(t, "old", val)
+
+	_, tombstoned, ok = decodeValue(&dynamoDBTypes.AttributeValueMemberS{Value: ""})
+	assert.True(t, ok)
+	assert.True(t, tombstoned)
+
+	_, _, ok = decodeValue(&dynamoDBTypes.AttributeValueMemberN{Value: "1"})
+	assert.False(t, ok)
+}
+
+func TestUnchanged(t *testing.T) {
+	cond, err := unchanged(nil)
+	assert.NoError(t, err)
+
+	expr, err := expression.NewBuilder().WithCondition(cond).Build()
+	assert.NoError(t, err)
+	assert.Contains(t, *expr.Condition(), "attribute_not_exists")
+
+	// version vectors with dots in their node ids are single map keys
+	v := vectorToString(vclock.VClock{"node.a": 1})
+
+	cond, err = unchanged(map[string]dynamoDBTypes.AttributeValue{
+		valName: &dynamoDBTypes.AttributeValueMemberM{Value: map[string]dynamoDBTypes.AttributeValue{
+			v: encodeValue("value", false),
+		}},
+	})
+	assert.NoError(t, err)
+
+	expr, err = expression.NewBuilder().WithCondition(cond).Build()
+	assert.NoError(t, err)
+	assert.Contains(t, *expr.Condition(), "size")
+
+	names := make([]string, 0, len(expr.Names()))
+	for _, n := range expr.Names() {
+		names = append(names, n)
+	}
+	assert.ElementsMatch(t, []string{valName, v}, names)
+
+	_, err = unchanged(map[string]dynamoDBTypes.AttributeValue{
+		valName: &dynamoDBTypes.AttributeValueMemberM{Value: map[string]dynamoDBTypes.AttributeValue{
+			v: &dynamoDBTypes.AttributeValueMemberN{Value: "1"},
+		}},
+	})
+	assert.Error(t, err)
+}
+
+func TestConditionFailed(t *testing.T) {
+	canceled := func(codes ...string) error {
+		reasons := make([]dynamoDBTypes.CancellationReason, len(codes))
+
+		for i, c := range codes {
+			reasons[i] = dynamoDBTypes.CancellationReason{Code: aws.String(c)}
+		}
+
+		return &smithy.OperationError{
+			ServiceID:     "DynamoDB",
+			OperationName: "TransactWriteItems",
+			Err:           &dynamoDBTypes.TransactionCanceledException{CancellationReasons: reasons},
+		}
+	}
+
+	assert.True(t, conditionFailed(canceled("None", "ConditionalCheckFailed")))
+	assert.False(t, conditionFailed(canceled("None", "ThrottlingError")))
+	assert.False(t, conditionFailed(fmt.Errorf("other error")))
+}
+
+func TestTrimHistory(t *testing.T) {
+	history := map[string]historyEntry{
+		"X:1": {val: "a", replaced: 10},
+		"X:2": {val: "b", replaced: 20, expires: 25},
+		"X:3": {val: "c", replaced: 30},
+		"X:4": {val: "d", replaced: 40},
+	}
+
+	trimHistory(history, 2, 30)
+
+	assert.Len(t, history, 2)
+	assert.Contains(t, history, "X:3")
+	assert.Contains(t, history, "X:4")
+
+	decoded, err := decodeHistory(encodeHistory(history))
+	assert.NoError(t, err)
+	assert.Equal(t, history, decoded)
+
+	empty, err := decodeHistory(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, empty)
+}
+
 func TestItemDelete(t *testing.T) {
 	kg := "test-kg-item-delete"
 	id := "name"
@@ -150,11 +286,11 @@ func TestItemDelete(t *testing.T) {
 	err := db.CreateKeygroup(kg)
 
 	assert.NoError(t, err)
-	err = db.Update(kg, id, value, 0, vclock.VClock{})
+	err = db.Update(kg, id, value, false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	retr, _, _, err := db.Read(kg, id)
+	retr, _, _, _, err := db.Read(kg, id)
 
 	assert.NoError(t, err)
 
@@ -165,7 +301,7 @@ func TestItemDelete(t *testing.T) {
 
 	assert.NoError(t, err, "read a deleted item")
 
-	_, _, found, err := db.Read(kg, id)
+	_, _, _, found, err := db.Read(kg, id)
 
 	assert.NoError(t, err)
 	assert.False(t, found)
@@ -193,13 +329,13 @@ func TestReadSome(t *testing.T) {
 		ids[i] = "id" + strconv.Itoa(i)
 		vals[i] = "val" + strconv.Itoa(i)
 
-		err = db.Update(kg, ids[i], vals[i], 0, vclock.VClock{})
+		err = db.Update(kg, ids[i], vals[i], false, 0, vclock.VClock{})
 
 		assert.NoError(t, err)
 
 	}
 
-	keys, values, _, err := db.ReadSome(kg, "id"+strconv.Itoa(scanStart), uint64(scanRange))
+	keys, values, _, _, err := db.ReadSome(kg, "id"+strconv.Itoa(scanStart), uint64(scanRange))
 
 	assert.NoError(t, err)
 
@@ -220,15 +356,15 @@ func TestReadAll(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg, "id-1", "data-1", 0, vclock.VClock{})
+	err = db.Update(kg, "id-1", "data-1", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg, "id-2", "data-2", 0, vclock.VClock{})
+	err = db.Update(kg, "id-2", "data-2", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg, "id-3", "data-3", 0, vclock.VClock{})
+	err = db.Update(kg, "id-3", "data-3", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
@@ -238,19 +374,19 @@ func TestReadAll(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg2, "id-4", "data-4", 0, vclock.VClock{})
+	err = db.Update(kg2, "id-4", "data-4", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg2, "id-5", "data-5", 0, vclock.VClock{})
+	err = db.Update(kg2, "id-5", "data-5", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg2, "id-6", "data-6", 0, vclock.VClock{})
+	err = db.Update(kg2, "id-6", "data-6", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	keys, values, _, err := db.ReadAll(kg)
+	keys, values, _, _, err := db.ReadAll(kg)
 
 	assert.NoError(t, err)
 
@@ -271,15 +407,15 @@ func TestIDs(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg, "id-1", "data-1", 0, vclock.VClock{})
+	err = db.Update(kg, "id-1", "data-1", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg, "id-2", "data-2", 0, vclock.VClock{})
+	err = db.Update(kg, "id-2", "data-2", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg, "id-3", "data-3", 0, vclock.VClock{})
+	err = db.Update(kg, "id-3", "data-3", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
@@ -289,15 +425,15 @@ func TestIDs(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg2, "id-1", "data-1", 0, vclock.VClock{})
+	err = db.Update(kg2, "id-1", "data-1", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg2, "id-2", "data-2", 0, vclock.VClock{})
+	err = db.Update(kg2, "id-2", "data-2", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg2, "id-3", "data-3", 0, vclock.VClock{})
+	err = db.Update(kg2, "id-3", "data-3", false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
@@ -320,7 +456,7 @@ func TestItemAfterDeleteKeygroup(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg, id, value, 0, vclock.VClock{})
+	err = db.Update(kg, id, value, false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
@@ -328,7 +464,7 @@ func TestItemAfterDeleteKeygroup(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	_, _, found, err := db.Read(kg, id)
+	_, _, _, found, err := db.Read(kg, id)
 
 	assert.NoError(t, err)
 	assert.False(t, found)
@@ -343,11 +479,11 @@ func TestExpiry(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg, id, value, 10, vclock.VClock{})
+	err = db.Update(kg, id, value, false, 10, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	retr, _, _, err := db.Read(kg, id)
+	retr, _, _, _, err := db.Read(kg, id)
 
 	assert.NoError(t, err)
 
@@ -359,7 +495,7 @@ func TestExpiry(t *testing.T) {
 
 	time.Sleep(11 * time.Second)
 
-	_, _, found, err := db.Read(kg, id)
+	_, _, _, found, err := db.Read(kg, id)
 
 	assert.NoError(t, err)
 	assert.False(t, found)
@@ -553,11 +689,11 @@ func TestPutItemVersion(t *testing.T) {
 	v1.Set("X", 10)
 	v1.Set("Y", 3)
 	v1.Set("Z", 7)
-	err = db.Update(kg, id, value, 0, v1)
+	err = db.Update(kg, id, value, false, 0, v1)
 
 	assert.NoError(t, err)
 
-	retr, clock, found, err := db.Read(kg, id)
+	retr, _, clock, found, err := db.Read(kg, id)
 
 	assert.NoError(t, err)
 
@@ -568,6 +704,33 @@ func TestPutItemVersion(t *testing.T) {
 	assert.True(t, clock[0].Compare(v1, vclock.Equal))
 }
 
+func TestUpdateBatch(t *testing.T) {
+	kg := "test-kg-update-batch"
+
+	err := db.CreateKeygroup(kg)
+	assert.NoError(t, err)
+
+	v1 := vclock.VClock{"X": 1}
+	v2 := vclock.VClock{"X": 2}
+
+	err = db.Update(kg, "a", "old", false, 0, v1)
+	assert.NoError(t, err)
+
+	err = db.UpdateBatch(kg, []string{"a", "b"}, []string{"new", ""}, []bool{false, true}, []int{0, 0}, []vclock.VClock{v2, v1}, [][]vclock.VClock{{v1}, nil})
+	assert.NoError(t, err)
+
+	vals, _, versions, found, err := db.Read(kg, "a")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []string{"new"}, vals)
+	assert.True(t, versions[0].Compare(v2, vclock.Equal))
+
+	_, tombstones, _, found, err := db.Read(kg, "b")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []bool{true}, tombstones)
+}
+
 func TestDeleteVersion(t *testing.T) {
 	kg := "test-kg-item-delete-version"
 	id := "name"
@@ -582,7 +745,7 @@ func TestDeleteVersion(t *testing.T) {
 	v1.Set("X", 10)
 	v1.Set("Y", 3)
 	v1.Set("Z", 7)
-	err = db.Update(kg, id, value, 0, v1)
+	err = db.Update(kg, id, value, false, 0, v1)
 
 	assert.NoError(t, err)
 
@@ -590,7 +753,7 @@ func TestDeleteVersion(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	_, _, found, err := db.Read(kg, id)
+	_, _, _, found, err := db.Read(kg, id)
 
 	assert.NoError(t, err)
 	assert.False(t, found)
@@ -609,11 +772,11 @@ func TestClose(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	err = db.Update(kg, id, value, 0, vclock.VClock{})
+	err = db.Update(kg, id, value, false, 0, vclock.VClock{})
 
 	assert.NoError(t, err)
 
-	retr, _, _, err := db.Read(kg, id)
+	retr, _, _, _, err := db.Read(kg, id)
 	assert.NoError(t, err)
 
 	assert.Len(t, retr, 1)
@@ -0,0 +1,362 @@
+package dynamo
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	dynamoDBTypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/go-errors/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	historyName         = "History"
+	historyVersionsName = "HistoryVersions"
+	historyWindowName   = "HistoryWindow"
+	historyValue        = "v"
+	historyReplaced     = "r"
+	historyExpires      = "e"
+)
+
+// historyEntry is a replaced version of an item. The "History" attribute of an item maps version vectors to these, each
+// stored as a map with the value ("v"), the time at which the version was replaced ("r"), and the time at which it
+// expires ("e", 0 if never), all times in unix seconds. As all versions of an item share the "Expiry" attribute,
+// replaced versions have their own expiry so that they don't live longer because the item is updated.
+type historyEntry struct {
+	val        string
+	tombstoned bool
+	replaced   int64
+	expires    int64
+}
+
+// expired returns whether a replaced version has expired.
+func (h historyEntry) expired(now int64) bool {
+	return h.expires > 0 && h.expires < now
+}
+
+func encodeHistory(history map[string]historyEntry) dynamoDBTypes.AttributeValue {
+	m := make(map[string]dynamoDBTypes.AttributeValue, len(history))
+
+	for v, h := range history {
+		m[v] = &dynamoDBTypes.AttributeValueMemberM{
+			Value: map[string]dynamoDBTypes.AttributeValue{
+				historyValue:    encodeValue(h.val, h.tombstoned),
+				historyReplaced: &dynamoDBTypes.AttributeValueMemberN{Value: strconv.FormatInt(h.replaced, 10)},
+				historyExpires:  &dynamoDBTypes.AttributeValueMemberN{Value: strconv.FormatInt(h.expires, 10)},
+			},
+		}
+	}
+
+	return &dynamoDBTypes.AttributeValueMemberM{Value: m}
+}
+
+// decodeHistory decodes the "History" attribute of an item, a missing attribute is an empty history.
+func decodeHistory(data dynamoDBTypes.AttributeValue) (map[string]historyEntry, error) {
+	history := make(map[string]historyEntry)
+
+	if data == nil {
+		return history, nil
+	}
+
+	m, ok := data.(*dynamoDBTypes.AttributeValueMemberM)
+
+	if !ok {
+		return nil, errors.Errorf("can't parse item history")
+	}
+
+	for v, e := range m.Value {
+		entry, ok := e.(*dynamoDBTypes.AttributeValueMemberM)
+
+		if !ok {
+			return nil, errors.Errorf("can't parse item history")
+		}
+
+		var h historyEntry
+
+		h.val, h.tombstoned, ok = decodeValue(entry.Value[historyValue])
+
+		if !ok {
+			return nil, errors.Errorf("can't parse item history value")
+		}
+
+		for name, t := range map[string]*int64{historyReplaced: &h.replaced, historyExpires: &h.expires} {
+			n, ok := entry.Value[name].(*dynamoDBTypes.AttributeValueMemberN)
+
+			if !ok {
+				return nil, errors.Errorf("can't parse item history time")
+			}
+
+			var err error
+			*t, err = strconv.ParseInt(n.Value, 10, 64)
+
+			if err != nil {
+				return nil, errors.New(err)
+			}
+		}
+
+		history[v] = h
+	}
+
+	return history, nil
+}
+
+// historyOrder returns the versions in a history from the oldest to the newest replaced version.
+func historyOrder(history map[string]historyEntry) []string {
+	keys := make([]string, 0, len(history))
+
+	for v := range history {
+		keys = append(keys, v)
+	}
+
+	sort.Slice(keys, func(a, b int) bool {
+		if history[keys[a]].replaced != history[keys[b]].replaced {
+			return history[keys[a]].replaced < history[keys[b]].replaced
+		}
+		return keys[a] < keys[b]
+	})
+
+	return keys
+}
+
+// trimHistory removes the replaced versions that have expired and the oldest ones if there are more than versions.
+func trimHistory(history map[string]historyEntry, versions int, now int64) {
+	for v, h := range history {
+		if h.expired(now) {
+			delete(history, v)
+		}
+	}
+
+	if versions <= 0 || len(history) <= versions {
+		return
+	}
+
+	keys := historyOrder(history)
+
+	for _, v := range keys[:len(keys)-versions] {
+		delete(history, v)
+	}
+}
+
+// archive moves versions of an item into its history. The replaced versions expire with the item, or when they leave
+// the history window if that is earlier.
+func archive(item map[string]dynamoDBTypes.AttributeValue, history map[string]historyEntry, replaced []vclock.VClock, versions int, window int) {
+	now := time.Now().UTC().Unix()
+
+	var expires int64
+
+	if e, ok := item[expiryKey].(*dynamoDBTypes.AttributeValueMemberN); ok {
+		expires, _ = strconv.ParseInt(e.Value, 10, 64)
+	}
+
+	if window > 0 && (expires == 0 || now+int64(window) < expires) {
+		expires = now + int64(window)
+	}
+
+	values, _ := item[valName].(*dynamoDBTypes.AttributeValueMemberM)
+
+	for _, r := range replaced {
+		if values == nil {
+			break
+		}
+
+		data, ok := values.Value[vectorToString(r)]
+
+		if !ok {
+			continue
+		}
+
+		val, tombstoned, ok := decodeValue(data)
+
+		if !ok {
+			continue
+		}
+
+		history[vectorToString(r)] = historyEntry{
+			val:        val,
+			tombstoned: tombstoned,
+			replaced:   now,
+			expires:    expires,
+		}
+	}
+
+	trimHistory(history, versions, now)
+}
+
+// archiveVersion moves a version of an item into its history instead of just removing it.
+func (s *Storage) archiveVersion(kg string, id string, vvector vclock.VClock, versions int, window int) error {
+	item, err := s.getItem(kg, id)
+
+	if err != nil {
+		return err
+	}
+
+	if item == nil {
+		return nil
+	}
+
+	history, err := decodeHistory(item[historyName])
+
+	if err != nil {
+		return err
+	}
+
+	archive(item, history, []vclock.VClock{vvector}, versions, window)
+
+	input := &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.dynamotable),
+		Key: map[string]dynamoDBTypes.AttributeValue{
+			keygroupName: &dynamoDBTypes.AttributeValueMemberS{
+				Value: kg,
+			},
+			keyName: &dynamoDBTypes.AttributeValueMemberS{
+				Value: id,
+			},
+		},
+		ExpressionAttributeNames: map[string]string{
+			"#history": historyName,
+			"#value":   valName,
+			"#version": vectorToString(vvector),
+		},
+		ExpressionAttributeValues: map[string]dynamoDBTypes.AttributeValue{
+			":history": encodeHistory(history),
+		},
+		UpdateExpression: aws.String("SET #history = :history REMOVE #value.#version"),
+	}
+
+	log.Debug().Msgf("Delete: moving item %s (keygroup %s) with version %s to its history", id, kg, vectorToString(vvector))
+
+	_, err = s.svc.UpdateItem(context.TODO(), input)
+
+	if err != nil {
+		log.Error().Msg(errors.New(err).ErrorStack())
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+// getItem returns all attributes of an item, nil if there is no such item.
+func (s *Storage) getItem(kg string, id string) (map[string]dynamoDBTypes.AttributeValue, error) {
+	result, err := s.svc.GetItem(context.TODO(), &dynamodb.GetItemInput{
+		Key: map[string]dynamoDBTypes.AttributeValue{
+			keygroupName: &dynamoDBTypes.AttributeValueMemberS{
+				Value: kg,
+			},
+			keyName: &dynamoDBTypes.AttributeValueMemberS{
+				Value: id,
+			},
+		},
+		TableName: &s.dynamotable,
+	})
+
+	if err != nil {
+		log.Error().Msg(errors.New(err).ErrorStack())
+		return nil, errors.New(err)
+	}
+
+	return result.Item, nil
+}
+
+// getRetention returns how many replaced versions of each item of a keygroup are kept and for how many seconds. Both
+// are 0 for keygroups without a history.
+func (s *Storage) getRetention(kg string) (int, int, error) {
+	config, err := s.getItem(kg, NULLValue)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var r [2]int
+
+	for j, name := range []string{historyVersionsName, historyWindowName} {
+		n, ok := config[name].(*dynamoDBTypes.AttributeValueMemberN)
+
+		if !ok {
+			continue
+		}
+
+		r[j], err = strconv.Atoi(n.Value)
+
+		if err != nil {
+			return 0, 0, errors.New(err)
+		}
+	}
+
+	return r[0], r[1], nil
+}
+
+// SetKeygroupHistory sets how many replaced versions of each item of a keygroup are kept and for how long.
+func (s *Storage) SetKeygroupHistory(kg string, versions int, window int) error {
+	input := &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.dynamotable),
+		Key: map[string]dynamoDBTypes.AttributeValue{
+			keygroupName: &dynamoDBTypes.AttributeValueMemberS{
+				Value: kg,
+			},
+			keyName: &dynamoDBTypes.AttributeValueMemberS{
+				Value: NULLValue,
+			},
+		},
+		ExpressionAttributeNames: map[string]string{
+			"#versions": historyVersionsName,
+			"#window":   historyWindowName,
+		},
+		ExpressionAttributeValues: map[string]dynamoDBTypes.AttributeValue{
+			":versions": &dynamoDBTypes.AttributeValueMemberN{Value: strconv.Itoa(versions)},
+			":window":   &dynamoDBTypes.AttributeValueMemberN{Value: strconv.Itoa(window)},
+		},
+		UpdateExpression: aws.String("SET #versions = :versions, #window = :window"),
+	}
+
+	_, err := s.svc.UpdateItem(context.TODO(), input)
+
+	if err != nil {
+		log.Error().Msg(errors.New(err).ErrorStack())
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+// ReadHistory returns the replaced versions of an item that are kept, from the oldest to the newest.
+func (s *Storage) ReadHistory(kg string, id string) ([]string, []bool, []vclock.VClock, []int64, error) {
+	item, err := s.getItem(kg, id)
+
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	history, err := decodeHistory(item[historyName])
+
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	trimHistory(history, 0, time.Now().UTC().Unix())
+
+	keys := historyOrder(history)
+
+	vals := make([]string, len(keys))
+	tombstones := make([]bool, len(keys))
+	vvectors := make([]vclock.VClock, len(keys))
+	replaced := make([]int64, len(keys))
+
+	for j, v := range keys {
+		vvectors[j], err = vectorFromString(v)
+
+		if err != nil {
+			return nil, nil, nil, nil, errors.New(err)
+		}
+
+		vals[j] = history[v].val
+		tombstones[j] = history[v].tombstoned
+		replaced[j] = history[v].replaced
+	}
+
+	return vals, tombstones, vvectors, replaced, nil
+}
@@ -0,0 +1,153 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+
+	"github.com/go-errors/errors"
+)
+
+// KMS wraps and unwraps data keys with a master key that never leaves it, e.g., a key management service.
+type KMS interface {
+	// Wrap encrypts a data key with the current master key.
+	Wrap(key []byte) ([]byte, error)
+	// Unwrap decrypts a data key that was wrapped by Wrap, possibly with an earlier master key.
+	Unwrap(wrapped []byte) ([]byte, error)
+}
+
+// keySize is the size of master keys and data keys in bytes, they are AES-256 keys.
+const keySize = 32
+
+// keyIDSize is the size of the id of a master key that wrapped data keys start with.
+const keyIDSize = 8
+
+// FileKMS is a KMS with master keys that are loaded from local files. Each file holds a key of 32 bytes, either raw
+// or hex-encoded.
+type FileKMS struct {
+	current []byte
+	keys    map[string]cipher.AEAD
+}
+
+// NewFileKMS loads the master key from a file. Data keys that were wrapped with one of the old master keys can still
+// be unwrapped, so that the master key can be replaced.
+func NewFileKMS(path string, old ...string) (*FileKMS, error) {
+	k := &FileKMS{
+		keys: make(map[string]cipher.AEAD),
+	}
+
+	for i, p := range append([]string{path}, old...) {
+		id, aead, err := loadKey(p)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if i == 0 {
+			k.current = id
+		}
+
+		k.keys[string(id)] = aead
+	}
+
+	return k, nil
+}
+
+// loadKey reads a master key from a file and returns its id and cipher.
+func loadKey(path string) ([]byte, cipher.AEAD, error) {
+	data, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, nil, errors.New(err)
+	}
+
+	key := data
+
+	if len(key) != keySize {
+		key, err = hex.DecodeString(string(bytes.TrimSpace(data)))
+
+		if err != nil || len(key) != keySize {
+			return nil, nil, errors.Errorf("master key in %s must have %d bytes, raw or hex-encoded", path, keySize)
+		}
+	}
+
+	aead, err := newAEAD(key)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sum := sha256.Sum256(key)
+
+	return sum[:keyIDSize], aead, nil
+}
+
+// newAEAD creates an AES-GCM cipher from a key.
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	return aead, nil
+}
+
+// Wrap encrypts a data key with the current master key. The result starts with the id of the master key, followed by
+// the nonce and the encrypted key.
+func (k *FileKMS) Wrap(key []byte) ([]byte, error) {
+	aead := k.keys[string(k.current)]
+
+	nonce := make([]byte, aead.NonceSize())
+
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.New(err)
+	}
+
+	wrapped := append(append([]byte{}, k.current...), nonce...)
+
+	return aead.Seal(wrapped, nonce, key, k.current), nil
+}
+
+// Unwrap decrypts a data key that was wrapped with one of the master keys.
+func (k *FileKMS) Unwrap(wrapped []byte) ([]byte, error) {
+	if len(wrapped) < keyIDSize {
+		return nil, errors.Errorf("wrapped key is too short")
+	}
+
+	id := wrapped[:keyIDSize]
+	aead, ok := k.keys[string(id)]
+
+	if !ok {
+		return nil, errors.Errorf("key was wrapped with unknown master key %x", id)
+	}
+
+	if len(wrapped) < keyIDSize+aead.NonceSize() {
+		return nil, errors.Errorf("wrapped key is too short")
+	}
+
+	nonce := wrapped[keyIDSize : keyIDSize+aead.NonceSize()]
+
+	key, err := aead.Open(nil, nonce, wrapped[keyIDSize+aead.NonceSize():], id)
+
+	if err != nil {
+		return nil, errors.Errorf("cannot unwrap key with master key %x: %v", id, err)
+	}
+
+	return key, nil
+}
+
+// Current returns whether a data key was wrapped with the current master key.
+func (k *FileKMS) Current(wrapped []byte) bool {
+	return len(wrapped) >= keyIDSize && bytes.Equal(wrapped[:keyIDSize], k.current)
+}
@@ -0,0 +1,793 @@
+// Package encryption encrypts the values of items before they are written to a store, with a data key for each keygroup
+// that is wrapped by a master key.
+package encryption
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/fred"
+	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/go-errors/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// keysKeygroup is the keygroup in the underlying store that holds the wrapped data keys. Its name is not a valid name
+// for a keygroup of clients, so that it cannot clash with one.
+const keysKeygroup = "fred-keys"
+
+// magic is how encrypted values start. It is followed by the generation of the data key (uint32) and the time at which
+// the value expires (int64 unix seconds, 0 if it doesn't), the nonce, and the encrypted value.
+const magic = "FENC"
+
+const headerSize = len(magic) + 4 + 8
+
+// pageSize is the number of items that are re-encrypted at once.
+const pageSize = 100
+
+// stripes is the number of locks that serialize writes to the items in the store with their re-encryption.
+const stripes = 64
+
+// dataKey is a data key of a keygroup as it is stored.
+type dataKey struct {
+	Generation uint32
+	Wrapped    []byte
+	Created    int64
+}
+
+// keyring holds all data keys of a keygroup, the last one is the current one. Earlier keys are kept because replaced
+// versions in the history of items are not re-encrypted.
+type keyring struct {
+	Keys []dataKey
+	// Rotating is set while items are re-encrypted with the current key.
+	Rotating bool
+	// Legacy is set if the keygroup had items when its first data key was created. Their values may look like encrypted
+	// values, so values that cannot be decrypted are read as they are while Plain is set, i.e., until all items have been
+	// encrypted, and in the history of items, which is never re-encrypted.
+	Legacy bool
+	Plain  bool
+}
+
+// ring is a keyring with the unwrapped keys.
+type ring struct {
+	keyring
+	aeads map[uint32]cipher.AEAD
+}
+
+// current returns the current data key.
+func (r *ring) current() (uint32, cipher.AEAD) {
+	g := r.Keys[len(r.Keys)-1].Generation
+	return g, r.aeads[g]
+}
+
+// Store is a fred.Store that encrypts all values with AES-GCM before they are written to another store and decrypts
+// them when they are read. Values that were written before encryption was enabled are read as they are and encrypted
+// in the background once the first data key of their keygroup is created.
+type Store struct {
+	s        fred.Store
+	kms      KMS
+	rotation time.Duration
+
+	ringsLock sync.Mutex
+	rings     map[string]*ring
+	running   map[string]struct{}
+
+	items [stripes]sync.Mutex
+
+	wg   sync.WaitGroup
+	done chan struct{}
+}
+
+// New creates a Store that encrypts the values of items in the given store with data keys that are wrapped by the
+// KMS. If the KMS tells which keys are wrapped with its current master key, all other data keys are wrapped again. If
+// rotation is not 0, data keys are replaced once they are older than that, and all items are re-encrypted in the
+// background.
+func New(s fred.Store, kms KMS, rotation time.Duration) (*Store, error) {
+	e := &Store{
+		s:        s,
+		kms:      kms,
+		rotation: rotation,
+		rings:    make(map[string]*ring),
+		running:  make(map[string]struct{}),
+		done:     make(chan struct{}),
+	}
+
+	if !s.ExistsKeygroup(keysKeygroup) {
+		if err := s.CreateKeygroup(keysKeygroup); err != nil {
+			return nil, err
+		}
+	}
+
+	kgs, vals, _, _, err := s.ReadAll(keysKeygroup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	current, rewrap := kms.(interface{ Current(wrapped []byte) bool })
+
+	for i, kg := range kgs {
+		var k keyring
+
+		if err := json.Unmarshal([]byte(vals[i]), &k); err != nil {
+			return nil, errors.Errorf("malformed keyring of keygroup %s: %v", kg, err)
+		}
+
+		r := &ring{
+			keyring: k,
+			aeads:   make(map[uint32]cipher.AEAD, len(k.Keys)),
+		}
+
+		changed := false
+
+		for j, d := range k.Keys {
+			key, err := kms.Unwrap(d.Wrapped)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if r.aeads[d.Generation], err = newAEAD(key); err != nil {
+				return nil, err
+			}
+
+			if rewrap && !current.Current(d.Wrapped) {
+				if r.Keys[j].Wrapped, err = kms.Wrap(key); err != nil {
+					return nil, err
+				}
+
+				changed = true
+			}
+		}
+
+		if changed {
+			log.Info().Msgf("wrapped data keys of keygroup %s with the current master key", kg)
+
+			if err := e.save(kg, r.keyring); err != nil {
+				return nil, err
+			}
+		}
+
+		e.rings[kg] = r
+	}
+
+	e.wg.Add(1)
+	go e.rotate()
+
+	return e, nil
+}
+
+// save stores the keyring of a keygroup.
+func (e *Store) save(kg string, k keyring) error {
+	data, err := json.Marshal(k)
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	return e.s.Update(keysKeygroup, kg, string(data), false, 0, vclock.VClock{})
+}
+
+// addKey adds a new data key to the keyring of a keygroup and makes it the current one, the lock has to be held.
+// rotating marks that items have to be re-encrypted with the new key. If the keygroup has no data key yet but has
+// items, they were written before encryption was enabled and have to be encrypted as well.
+func (e *Store) addKey(kg string, rotating bool) (*ring, error) {
+	r, ok := e.rings[kg]
+
+	if !ok {
+		r = &ring{
+			aeads: make(map[uint32]cipher.AEAD),
+		}
+
+		if e.s.ExistsKeygroup(kg) {
+			ids, _, _, _, err := e.s.ReadPage(kg, "", 1)
+
+			if err != nil {
+				return nil, err
+			}
+
+			r.Legacy = len(ids) > 0
+			r.Plain = r.Legacy
+		}
+	}
+
+	key := make([]byte, keySize)
+
+	if _, err := rand.Read(key); err != nil {
+		return nil, errors.New(err)
+	}
+
+	wrapped, err := e.kms.Wrap(key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	g := uint32(1)
+
+	if len(r.Keys) > 0 {
+		g = r.Keys[len(r.Keys)-1].Generation + 1
+	}
+
+	k := keyring{
+		Keys:     append(append([]dataKey{}, r.Keys...), dataKey{Generation: g, Wrapped: wrapped, Created: time.Now().Unix()}),
+		Rotating: rotating || r.Plain,
+		Legacy:   r.Legacy,
+		Plain:    r.Plain,
+	}
+
+	if err := e.save(kg, k); err != nil {
+		return nil, err
+	}
+
+	r.keyring = k
+	r.aeads[g] = aead
+	e.rings[kg] = r
+
+	return r, nil
+}
+
+// encrypt encrypts a value of an item with the current data key of its keygroup, which is created if there is none.
+// expires is the time at which the value expires, 0 if it doesn't.
+func (e *Store) encrypt(kg string, id string, val string, expires int64) (string, error) {
+	e.ringsLock.Lock()
+
+	r, ok := e.rings[kg]
+
+	if !ok {
+		var err error
+		if r, err = e.addKey(kg, false); err != nil {
+			e.ringsLock.Unlock()
+			return "", err
+		}
+
+		if r.Rotating {
+			e.reencrypt(kg)
+		}
+	}
+
+	g, aead := r.current()
+
+	e.ringsLock.Unlock()
+
+	header := make([]byte, headerSize, headerSize+aead.NonceSize()+len(val)+aead.Overhead())
+	copy(header, magic)
+	binary.BigEndian.PutUint32(header[len(magic):], g)
+	binary.BigEndian.PutUint64(header[len(magic)+4:], uint64(expires))
+
+	nonce := make([]byte, aead.NonceSize())
+
+	if _, err := rand.Read(nonce); err != nil {
+		return "", errors.New(err)
+	}
+
+	out := append(header, nonce...)
+
+	return string(aead.Seal(out, nonce, []byte(val), additionalData(kg, id, header))), nil
+}
+
+// additionalData binds an encrypted value to its item and header, so that it cannot be moved to another item.
+func additionalData(kg string, id string, header []byte) []byte {
+	return append([]byte(kg+"|"+id+"|"), header...)
+}
+
+// parse returns the generation of the data key and the expiry of an encrypted value. Values that are not encrypted
+// have generation 0.
+func parse(val string) (uint32, int64) {
+	if len(val) < headerSize || !strings.HasPrefix(val, magic) {
+		return 0, 0
+	}
+
+	return binary.BigEndian.Uint32([]byte(val[len(magic):])), int64(binary.BigEndian.Uint64([]byte(val[len(magic)+4:])))
+}
+
+// open decrypts a value of an item and returns the generation of the data key that it was encrypted with. Values that
+// are not encrypted are returned as they are with generation 0. This includes values that cannot be decrypted if they
+// may have been written before encryption was enabled, history tells whether the value is a replaced version.
+func (e *Store) open(kg string, id string, val string, history bool) (string, uint32, error) {
+	g, _ := parse(val)
+
+	if g == 0 {
+		return val, 0, nil
+	}
+
+	e.ringsLock.Lock()
+	r, ok := e.rings[kg]
+	var aead cipher.AEAD
+	if ok {
+		aead = r.aeads[g]
+	}
+	// without a data key, no value of the keygroup has been encrypted
+	legacy := !ok || r.Plain || (history && r.Legacy)
+	e.ringsLock.Unlock()
+
+	fail := func(format string, a ...interface{}) (string, uint32, error) {
+		if legacy {
+			return val, 0, nil
+		}
+
+		return "", 0, errors.Errorf(format, a...)
+	}
+
+	if aead == nil {
+		return fail("no data key of generation %d for keygroup %s", g, kg)
+	}
+
+	if len(val) < headerSize+aead.NonceSize() {
+		return fail("encrypted value of item %s in keygroup %s is too short", id, kg)
+	}
+
+	data := []byte(val)
+	header := data[:headerSize]
+	nonce := data[headerSize : headerSize+aead.NonceSize()]
+
+	plain, err := aead.Open(nil, nonce, data[headerSize+aead.NonceSize():], additionalData(kg, id, header))
+
+	if err != nil {
+		return fail("cannot decrypt value of item %s in keygroup %s: %v", id, kg, err)
+	}
+
+	return string(plain), g, nil
+}
+
+// decrypt decrypts the current value of an item. Values that are not encrypted are returned as they are.
+func (e *Store) decrypt(kg string, id string, val string) (string, error) {
+	plain, _, err := e.open(kg, id, val, false)
+	return plain, err
+}
+
+// decryptAll decrypts the values of several items of a keygroup in place.
+func (e *Store) decryptAll(kg string, ids []string, vals []string) error {
+	for i := range vals {
+		var err error
+		if vals[i], err = e.decrypt(kg, ids[i], vals[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// expires returns when a value with the given expiry in seconds expires, 0 if it doesn't.
+func expires(expiry int) int64 {
+	if expiry <= 0 {
+		return 0
+	}
+
+	return time.Now().Unix() + int64(expiry)
+}
+
+// lock locks the items with the given ids, always in the same order so that there are no deadlocks.
+func (e *Store) lock(kg string, ids ...string) func() {
+	set := make(map[int]struct{}, len(ids))
+
+	for _, id := range ids {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(kg + "|" + id))
+		set[int(h.Sum32()%stripes)] = struct{}{}
+	}
+
+	l := make([]int, 0, len(set))
+
+	for i := range set {
+		l = append(l, i)
+	}
+
+	sort.Ints(l)
+
+	for _, i := range l {
+		e.items[i].Lock()
+	}
+
+	return func() {
+		for _, i := range l {
+			e.items[i].Unlock()
+		}
+	}
+}
+
+// Rotate replaces the data key of a keygroup with a new one and re-encrypts all items of the keygroup in the
+// background. Earlier data keys are kept so that values can be read while they are re-encrypted.
+func (e *Store) Rotate(kg string) error {
+	e.ringsLock.Lock()
+	defer e.ringsLock.Unlock()
+
+	if _, ok := e.running[kg]; ok {
+		return errors.Errorf("data key of keygroup %s is being rotated already", kg)
+	}
+
+	if _, err := e.addKey(kg, true); err != nil {
+		return err
+	}
+
+	e.reencrypt(kg)
+
+	return nil
+}
+
+// reencrypt starts re-encrypting the items of a keygroup in the background, the lock has to be held.
+func (e *Store) reencrypt(kg string) {
+	e.running[kg] = struct{}{}
+	e.wg.Add(1)
+
+	go func() {
+		defer e.wg.Done()
+
+		err := e.reencryptAll(kg)
+
+		e.ringsLock.Lock()
+		defer e.ringsLock.Unlock()
+
+		delete(e.running, kg)
+
+		if err != nil {
+			log.Err(err).Msgf("could not re-encrypt items of keygroup %s, trying again later", kg)
+			return
+		}
+
+		r := e.rings[kg]
+		r.Rotating = false
+		r.Plain = false
+
+		if err := e.save(kg, r.keyring); err != nil {
+			log.Err(err).Msgf("could not store keyring of keygroup %s", kg)
+		}
+
+		log.Info().Msgf("re-encrypted all items of keygroup %s", kg)
+	}()
+}
+
+// reencryptAll re-encrypts all versions of all items of a keygroup that are not encrypted with the current data key.
+func (e *Store) reencryptAll(kg string) error {
+	e.ringsLock.Lock()
+	g, _ := e.rings[kg].current()
+	e.ringsLock.Unlock()
+
+	after := ""
+
+	for {
+		select {
+		case <-e.done:
+			return errors.Errorf("store was closed")
+		default:
+		}
+
+		ids, vals, _, versions, err := e.s.ReadPage(kg, after, pageSize)
+
+		if err != nil {
+			return err
+		}
+
+		if len(ids) == 0 {
+			return nil
+		}
+
+		for i := range ids {
+			if _, v, err := e.open(kg, ids[i], vals[i], false); err == nil && v == g {
+				continue
+			}
+
+			if err := e.rewrite(kg, ids[i], versions[i], g); err != nil {
+				return err
+			}
+		}
+
+		after = ids[len(ids)-1]
+	}
+}
+
+// rewrite encrypts a version of an item with the current data key, unless it has been replaced in the meantime.
+func (e *Store) rewrite(kg string, id string, version vclock.VClock, g uint32) error {
+	unlock := e.lock(kg, id)
+	defer unlock()
+
+	vals, tombstones, versions, found, err := e.s.Read(kg, id)
+
+	if err != nil || !found {
+		return err
+	}
+
+	for i := range versions {
+		if !versions[i].Compare(version, vclock.Equal) {
+			continue
+		}
+
+		val, v, err := e.open(kg, id, vals[i], false)
+
+		if err != nil {
+			return err
+		}
+
+		if v == g {
+			return nil
+		}
+
+		// values that were written before encryption was enabled don't tell when they expire
+		var exp int64
+
+		if v != 0 {
+			_, exp = parse(vals[i])
+		}
+
+		expiry := 0
+
+		if exp > 0 {
+			expiry = int(exp - time.Now().Unix())
+
+			// the version expires anyway
+			if expiry <= 0 {
+				return nil
+			}
+		}
+
+		if val, err = e.encrypt(kg, id, val, exp); err != nil {
+			return err
+		}
+
+		return e.s.Update(kg, id, val, tombstones[i], expiry, versions[i])
+	}
+
+	return nil
+}
+
+// rotate rotates data keys that are older than the rotation interval and resumes rotations that have not finished.
+func (e *Store) rotate() {
+	defer e.wg.Done()
+
+	interval := time.Minute
+
+	if e.rotation > 0 && e.rotation < interval {
+		interval = e.rotation
+	}
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		e.ringsLock.Lock()
+
+		for kg, r := range e.rings {
+			if _, ok := e.running[kg]; ok || !e.s.ExistsKeygroup(kg) {
+				continue
+			}
+
+			if r.Rotating {
+				e.reencrypt(kg)
+				continue
+			}
+
+			if e.rotation > 0 && time.Since(time.Unix(r.Keys[len(r.Keys)-1].Created, 0)) >= e.rotation {
+				log.Info().Msgf("rotating data key of keygroup %s", kg)
+
+				if _, err := e.addKey(kg, true); err != nil {
+					log.Err(err).Msgf("could not rotate data key of keygroup %s", kg)
+					continue
+				}
+
+				e.reencrypt(kg)
+			}
+		}
+
+		e.ringsLock.Unlock()
+
+		select {
+		case <-e.done:
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Update encrypts the value and stores it in the underlying store.
+func (e *Store) Update(kg string, id string, val string, tombstoned bool, expiry int, vvector vclock.VClock) error {
+	unlock := e.lock(kg, id)
+	defer unlock()
+
+	val, err := e.encrypt(kg, id, val, expires(expiry))
+
+	if err != nil {
+		return err
+	}
+
+	return e.s.Update(kg, id, val, tombstoned, expiry, vvector)
+}
+
+// Delete deletes a version of an item from the underlying store.
+func (e *Store) Delete(kg string, id string, vvector vclock.VClock) error {
+	unlock := e.lock(kg, id)
+	defer unlock()
+
+	return e.s.Delete(kg, id, vvector)
+}
+
+// UpdateBatch encrypts the values and stores them in the underlying store.
+func (e *Store) UpdateBatch(kg string, ids []string, vals []string, tombstones []bool, expiries []int, vvectors []vclock.VClock, replaced [][]vclock.VClock) error {
+	unlock := e.lock(kg, ids...)
+	defer unlock()
+
+	encrypted := make([]string, len(vals))
+
+	for i := range vals {
+		var err error
+		if encrypted[i], err = e.encrypt(kg, ids[i], vals[i], expires(expiries[i])); err != nil {
+			return err
+		}
+	}
+
+	return e.s.UpdateBatch(kg, ids, encrypted, tombstones, expiries, vvectors, replaced)
+}
+
+// Append encrypts the value and appends it to the underlying store.
+func (e *Store) Append(kg string, id string, val string, expiry int) error {
+	unlock := e.lock(kg, id)
+	defer unlock()
+
+	val, err := e.encrypt(kg, id, val, expires(expiry))
+
+	if err != nil {
+		return err
+	}
+
+	return e.s.Append(kg, id, val, expiry)
+}
+
+// Read reads the versions of an item from the underlying store and decrypts them.
+func (e *Store) Read(kg string, id string) ([]string, []bool, []vclock.VClock, bool, error) {
+	vals, tombstones, versions, found, err := e.s.Read(kg, id)
+
+	if err != nil {
+		return nil, nil, nil, false, err
+	}
+
+	for i := range vals {
+		if vals[i], err = e.decrypt(kg, id, vals[i]); err != nil {
+			return nil, nil, nil, false, err
+		}
+	}
+
+	return vals, tombstones, versions, found, nil
+}
+
+// ReadSome reads items from the underlying store and decrypts them.
+func (e *Store) ReadSome(kg string, id string, count uint64) ([]string, []string, []bool, []vclock.VClock, error) {
+	ids, vals, tombstones, versions, err := e.s.ReadSome(kg, id, count)
+
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	if err := e.decryptAll(kg, ids, vals); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	return ids, vals, tombstones, versions, nil
+}
+
+// ReadPage reads items from the underlying store and decrypts them.
+func (e *Store) ReadPage(kg string, after string, count uint64) ([]string, []string, []bool, []vclock.VClock, error) {
+	ids, vals, tombstones, versions, err := e.s.ReadPage(kg, after, count)
+
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	if err := e.decryptAll(kg, ids, vals); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	return ids, vals, tombstones, versions, nil
+}
+
+// ReadRange reads items from the underlying store and decrypts them.
+func (e *Store) ReadRange(kg string, start string, end string, prefix string, reverse bool, count uint64) ([]string, []string, []bool, []vclock.VClock, error) {
+	ids, vals, tombstones, versions, err := e.s.ReadRange(kg, start, end, prefix, reverse, count)
+
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	if err := e.decryptAll(kg, ids, vals); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	return ids, vals, tombstones, versions, nil
+}
+
+// ReadAll reads all items of a keygroup from the underlying store and decrypts them.
+func (e *Store) ReadAll(kg string) ([]string, []string, []bool, []vclock.VClock, error) {
+	ids, vals, tombstones, versions, err := e.s.ReadAll(kg)
+
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	if err := e.decryptAll(kg, ids, vals); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	return ids, vals, tombstones, versions, nil
+}
+
+// ReadHistory reads the replaced versions of an item from the underlying store and decrypts them.
+func (e *Store) ReadHistory(kg string, id string) ([]string, []bool, []vclock.VClock, []int64, error) {
+	vals, tombstones, versions, replaced, err := e.s.ReadHistory(kg, id)
+
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	for i := range vals {
+		if vals[i], _, err = e.open(kg, id, vals[i], true); err != nil {
+			return nil, nil, nil, nil, err
+		}
+	}
+
+	return vals, tombstones, versions, replaced, nil
+}
+
+// IDs returns the ids of the items of a keygroup in the underlying store.
+func (e *Store) IDs(kg string) ([]string, error) {
+	return e.s.IDs(kg)
+}
+
+// Exists checks whether an item exists in the underlying store.
+func (e *Store) Exists(kg string, id string) bool {
+	return e.s.Exists(kg, id)
+}
+
+// CreateKeygroup creates a keygroup in the underlying store.
+func (e *Store) CreateKeygroup(kg string) error {
+	return e.s.CreateKeygroup(kg)
+}
+
+// SetKeygroupHistory sets the history retention of a keygroup in the underlying store.
+func (e *Store) SetKeygroupHistory(kg string, versions int, window int) error {
+	return e.s.SetKeygroupHistory(kg, versions, window)
+}
+
+// DeleteKeygroup deletes a keygroup in the underlying store. Its data keys are kept, as the store may still hold some of
+// its items.
+func (e *Store) DeleteKeygroup(kg string) error {
+	return e.s.DeleteKeygroup(kg)
+}
+
+// ExistsKeygroup checks whether a keygroup exists in the underlying store.
+func (e *Store) ExistsKeygroup(kg string) bool {
+	return e.s.ExistsKeygroup(kg)
+}
+
+// AddKeygroupTrigger adds a trigger to a keygroup in the underlying store.
+func (e *Store) AddKeygroupTrigger(kg string, id string, host string) error {
+	return e.s.AddKeygroupTrigger(kg, id, host)
+}
+
+// DeleteKeygroupTrigger removes a trigger from a keygroup in the underlying store.
+func (e *Store) DeleteKeygroupTrigger(kg string, id string) error {
+	return e.s.DeleteKeygroupTrigger(kg, id)
+}
+
+// GetKeygroupTrigger returns the triggers of a keygroup in the underlying store.
+func (e *Store) GetKeygroupTrigger(kg string) (map[string]string, error) {
+	return e.s.GetKeygroupTrigger(kg)
+}
+
+// Close stops re-encrypting items and closes the underlying store.
+func (e *Store) Close() error {
+	close(e.done)
+	e.wg.Wait()
+
+	return e.s.Close()
+}
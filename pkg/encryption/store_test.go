@@ -0,0 +1,233 @@
+package encryption
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/badgerdb"
+	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/stretchr/testify/assert"
+)
+
+// masterKey writes a new hex-encoded master key to a file.
+func masterKey(t *testing.T, name string) string {
+	key := make([]byte, keySize)
+	_, err := rand.Read(key)
+	assert.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), name)
+	assert.NoError(t, os.WriteFile(path, []byte(hex.EncodeToString(key)+"\n"), 0600))
+
+	return path
+}
+
+// waitRotated waits until all items of a keygroup have been re-encrypted.
+func waitRotated(t *testing.T, s *Store, kg string) {
+	assert.Eventually(t, func() bool {
+		s.ringsLock.Lock()
+		defer s.ringsLock.Unlock()
+
+		return !s.rings[kg].Rotating
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestFileKMS(t *testing.T) {
+	oldKey := masterKey(t, "old.key")
+	newKey := masterKey(t, "new.key")
+
+	old, err := NewFileKMS(oldKey)
+	assert.NoError(t, err)
+
+	wrapped, err := old.Wrap([]byte("data key"))
+	assert.NoError(t, err)
+
+	k, err := NewFileKMS(newKey, oldKey)
+	assert.NoError(t, err)
+	assert.False(t, k.Current(wrapped))
+
+	key, err := k.Unwrap(wrapped)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("data key"), key)
+
+	wrapped, err = k.Wrap(key)
+	assert.NoError(t, err)
+	assert.True(t, k.Current(wrapped))
+
+	// without the old master key, its data keys are lost
+	_, err = old.Unwrap(wrapped)
+	assert.Error(t, err)
+
+	_, err = NewFileKMS(filepath.Join(t.TempDir(), "missing.key"))
+	assert.Error(t, err)
+}
+
+func TestStore(t *testing.T) {
+	kg := "enckg"
+
+	inner := badgerdb.NewMemory()
+	assert.NoError(t, inner.CreateKeygroup(kg))
+
+	// an item that was written before encryption was enabled
+	assert.NoError(t, inner.Update(kg, "old", "plain", false, 0, vclock.VClock{"X": 1}))
+
+	kms, err := NewFileKMS(masterKey(t, "master.key"))
+	assert.NoError(t, err)
+
+	s, err := New(inner, kms, 0)
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = s.Close() })
+
+	assert.NoError(t, s.Update(kg, "a", "secret", false, 0, vclock.VClock{"X": 1}))
+	assert.NoError(t, s.UpdateBatch(kg, []string{"b"}, []string{"another secret"}, []bool{false}, []int{60}, []vclock.VClock{{"X": 1}}, [][]vclock.VClock{nil}))
+
+	// the item that was written before is encrypted in the background
+	waitRotated(t, s, kg)
+
+	vals, _, _, found, err := s.Read(kg, "a")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []string{"secret"}, vals)
+
+	// the underlying store only sees encrypted values
+	raw, _, _, _, err := inner.Read(kg, "a")
+	assert.NoError(t, err)
+	assert.False(t, strings.Contains(raw[0], "secret"))
+
+	g, _ := parse(raw[0])
+	assert.Equal(t, uint32(1), g)
+
+	// values cannot be moved to another item
+	assert.NoError(t, inner.Update(kg, "c", raw[0], false, 0, vclock.VClock{"X": 1}))
+	_, _, _, _, err = s.Read(kg, "c")
+	assert.Error(t, err)
+	assert.NoError(t, inner.Delete(kg, "c", vclock.VClock{"X": 1}))
+
+	ids, vals, _, _, err := s.ReadPage(kg, "", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "old"}, ids)
+	assert.Equal(t, []string{"secret", "another secret", "plain"}, vals)
+
+	// rotating the key re-encrypts everything
+	assert.NoError(t, s.Rotate(kg))
+	waitRotated(t, s, kg)
+
+	_, raws, _, _, err := inner.ReadPage(kg, "", 10)
+	assert.NoError(t, err)
+
+	for _, r := range raws {
+		g, _ := parse(r)
+		assert.Equal(t, uint32(2), g)
+	}
+
+	// the expiry is kept
+	_, exp := parse(raws[1])
+	assert.NotZero(t, exp)
+
+	ids, vals, _, _, err = s.ReadPage(kg, "", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "old"}, ids)
+	assert.Equal(t, []string{"secret", "another secret", "plain"}, vals)
+}
+
+func TestReopen(t *testing.T) {
+	kg := "enckg"
+
+	inner := badgerdb.NewMemory()
+	assert.NoError(t, inner.CreateKeygroup(kg))
+
+	oldKey := masterKey(t, "old.key")
+
+	kms, err := NewFileKMS(oldKey)
+	assert.NoError(t, err)
+
+	s, err := New(inner, kms, 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, s.Update(kg, "a", "secret", false, 0, vclock.VClock{"X": 1}))
+
+	// stop the store without closing the underlying store
+	close(s.done)
+	s.wg.Wait()
+
+	// with a new master key, the data keys are wrapped again
+	kms, err = NewFileKMS(masterKey(t, "new.key"), oldKey)
+	assert.NoError(t, err)
+
+	s, err = New(inner, kms, 0)
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = s.Close() })
+
+	vals, _, _, _, err := s.Read(kg, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"secret"}, vals)
+
+	for _, d := range s.rings[kg].Keys {
+		assert.True(t, kms.Current(d.Wrapped))
+	}
+}
+
+func TestLegacyValues(t *testing.T) {
+	kg := "legacykg"
+
+	inner := badgerdb.NewMemory()
+	assert.NoError(t, inner.CreateKeygroup(kg))
+
+	// values that were written before encryption was enabled and look like encrypted ones, with generation 1
+	lookalike := magic + "\x00\x00\x00\x01" + strings.Repeat("\x00", 8) + strings.Repeat("x", 64)
+	short := magic + "\x00\x00\x00\x01"
+
+	assert.NoError(t, inner.Update(kg, "lookalike", lookalike, false, 0, vclock.VClock{"X": 1}))
+	assert.NoError(t, inner.Update(kg, "short", short, false, 0, vclock.VClock{"X": 1}))
+
+	kms, err := NewFileKMS(masterKey(t, "master.key"))
+	assert.NoError(t, err)
+
+	s, err := New(inner, kms, 0)
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = s.Close() })
+
+	// the first write creates the data key of generation 1
+	assert.NoError(t, s.Update(kg, "a", "secret", false, 0, vclock.VClock{"X": 1}))
+
+	check := func() {
+		ids, vals, _, _, err := s.ReadPage(kg, "", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "lookalike", "short"}, ids)
+		assert.Equal(t, []string{"secret", lookalike, short}, vals)
+	}
+
+	check()
+
+	waitRotated(t, s, kg)
+
+	// all values are encrypted now
+	ids, raws, _, _, err := inner.ReadPage(kg, "", 10)
+	assert.NoError(t, err)
+
+	for i := range raws {
+		_, g, err := s.open(kg, ids[i], raws[i], false)
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(1), g)
+	}
+
+	check()
+
+	// so values that cannot be decrypted are errors again
+	assert.NoError(t, inner.Update(kg, "moved", raws[1], false, 0, vclock.VClock{"X": 1}))
+	_, _, _, _, err = s.Read(kg, "moved")
+	assert.Error(t, err)
+
+	// a keygroup without items before encryption was enabled never has plain values that look encrypted
+	assert.NoError(t, s.CreateKeygroup("newkg"))
+	assert.NoError(t, s.Update("newkg", "a", "secret", false, 0, vclock.VClock{"X": 1}))
+	assert.False(t, s.rings["newkg"].Legacy)
+
+	assert.NoError(t, inner.Update("newkg", "lookalike", lookalike, false, 0, vclock.VClock{"X": 1}))
+	_, _, _, _, err = s.Read("newkg", "lookalike")
+	assert.Error(t, err)
+}
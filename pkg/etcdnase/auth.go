@@ -65,3 +65,41 @@ func (n *NameService) GetUserPermissions(user string, kg fred.KeygroupName) (map
 
 	return permissions, nil
 }
+
+// GetKeygroupPermissions returns the permissions of all users on kg from etcd, mapped by user.
+func (n *NameService) GetKeygroupPermissions(kg fred.KeygroupName) (map[string]map[fred.Method]struct{}, error) {
+	exists, err := n.ExistsKeygroup(kg)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, errors.Errorf("keygroup does not exist")
+	}
+
+	// permissions are stored by user first, so we have to look at the permissions of all users
+	res, err := n.getPrefix(userPrefixString)
+
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := make(map[string]map[fred.Method]struct{})
+
+	for k := range res {
+		// user|<user>|kg|<keygroup>|method|<method>
+		parts := strings.Split(k, sep)
+
+		if len(parts) != 6 || parts[3] != string(kg) {
+			continue
+		}
+
+		if _, ok := permissions[parts[1]]; !ok {
+			permissions[parts[1]] = make(map[fred.Method]struct{})
+		}
+
+		permissions[parts[1]][fred.Method(parts[5])] = struct{}{}
+	}
+
+	return permissions, nil
+}
@@ -4,6 +4,7 @@
 package etcdnase
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"net/url"
@@ -153,6 +154,150 @@ func TestNodes(t *testing.T) {
 	assert.Equal(t, nodes[0].Host, extHost)
 }
 
+func TestNodeStatus(t *testing.T) {
+	altNodeID := fred.NodeID("liveness")
+
+	status, err := n.GetNodeStatus(nodeID)
+	assert.NoError(t, err)
+	assert.Equal(t, fred.NodeAlive, status)
+
+	_, err = n.GetNodeStatus(altNodeID)
+	assert.Error(t, err)
+
+	done := make(chan struct{})
+	defer close(done)
+
+	changes := make(chan fred.NodeStatus, 10)
+
+	err = n.WatchNodes(done, func(id fred.NodeID, status fred.NodeStatus) {
+		if id == altNodeID {
+			changes <- status
+		}
+	})
+	assert.NoError(t, err)
+
+	n2, err := NewNameService(string(altNodeID), []string{"127.0.0.1:6000"}, certBasePath+"nodeB.crt", certBasePath+"nodeB.key", certBasePath+"ca.crt", false, false)
+	assert.NoError(t, err)
+
+	n2.leaseTTL = 2 * time.Second
+
+	err = n2.RegisterSelf("localhost:8001", "localhost:9001")
+	assert.NoError(t, err)
+
+	select {
+	case status := <-changes:
+		assert.Equal(t, fred.NodeAlive, status)
+	case <-time.After(5 * time.Second):
+		t.Fatal("node did not become alive")
+	}
+
+	// the lease is renewed while the node runs
+	time.Sleep(3 * time.Second)
+
+	status, err = n.GetNodeStatus(altNodeID)
+	assert.NoError(t, err)
+	assert.Equal(t, fred.NodeAlive, status)
+
+	// once it stops, it is reported as dead but stays registered
+	assert.NoError(t, n2.kv.Close())
+
+	select {
+	case status := <-changes:
+		assert.Equal(t, fred.NodeDead, status)
+	case <-time.After(10 * time.Second):
+		t.Fatal("node did not die")
+	}
+
+	status, err = n.GetNodeStatus(altNodeID)
+	assert.NoError(t, err)
+	assert.Equal(t, fred.NodeDead, status)
+}
+
+// interruptedKV hands out a first watch that only ends once interrupt is closed and forwards later watches to the KV.
+type interruptedKV struct {
+	KV
+	interrupt chan []Event
+	watched   bool
+}
+
+func (i *interruptedKV) Watch(ctx context.Context, key string, prefix bool) <-chan []Event {
+	if i.watched {
+		return i.KV.Watch(ctx, key, prefix)
+	}
+
+	i.watched = true
+	return i.interrupt
+}
+
+func TestWatchNodesInterrupted(t *testing.T) {
+	altNodeID := fred.NodeID("rewatch")
+
+	kv := &interruptedKV{KV: n.kv, interrupt: make(chan []Event)}
+	watcher := &NameService{kv: kv, leaseTTL: nodeLeaseTTL, NodeID: nodeID}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	changes := make(chan fred.NodeStatus, 10)
+
+	err := watcher.WatchNodes(done, func(id fred.NodeID, status fred.NodeStatus) {
+		if id == altNodeID {
+			changes <- status
+		}
+	})
+	assert.NoError(t, err)
+
+	n2, err := NewNameService(string(altNodeID), []string{"127.0.0.1:6000"}, certBasePath+"nodeB.crt", certBasePath+"nodeB.key", certBasePath+"ca.crt", false, false)
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, n2.kv.Close())
+	}()
+
+	// the node registers while the watch does not forward changes, so its status is only known once it is read again
+	err = n2.RegisterSelf("localhost:8003", "localhost:9003")
+	assert.NoError(t, err)
+
+	select {
+	case <-changes:
+		t.Fatal("change was reported by an interrupted watch")
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	close(kv.interrupt)
+
+	select {
+	case status := <-changes:
+		assert.Equal(t, fred.NodeAlive, status)
+	case <-time.After(5 * time.Second):
+		t.Fatal("node status was not read again after the watch was interrupted")
+	}
+}
+
+func TestDeregisterNode(t *testing.T) {
+	altNodeID := fred.NodeID("deregister")
+
+	n2, err := NewNameService(string(altNodeID), []string{"127.0.0.1:6000"}, certBasePath+"nodeB.crt", certBasePath+"nodeB.key", certBasePath+"ca.crt", false, false)
+	assert.NoError(t, err)
+
+	err = n2.RegisterSelf("localhost:8002", "localhost:9002")
+	assert.NoError(t, err)
+
+	assert.NoError(t, n2.kv.Close())
+
+	_, err = n.GetNodeAddress(altNodeID)
+	assert.NoError(t, err)
+
+	err = n.DeregisterNode(altNodeID)
+	assert.NoError(t, err)
+
+	_, err = n.GetNodeAddress(altNodeID)
+	assert.Error(t, err)
+
+	nodes, err := n.GetAllNodes()
+	assert.NoError(t, err)
+	assert.NotContains(t, nodes, fred.Node{ID: altNodeID, Host: "localhost:8002"})
+}
+
 func TestNonexistentKeygroups(t *testing.T) {
 	kg := fred.KeygroupName("kg-noexist")
 
@@ -198,6 +343,68 @@ func TestKeygroups(t *testing.T) {
 	assert.Equal(t, expiry, exp)
 }
 
+func TestReplicationPolicy(t *testing.T) {
+	kg := fred.KeygroupName("kg-policy")
+
+	err := n.CreateKeygroup(kg, true, 0)
+	assert.NoError(t, err)
+
+	p, err := n.GetKeygroupReplicationPolicy(kg)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, p.MinReplicas)
+
+	policy := fred.ReplicationPolicy{MinReplicas: 2, Labels: map[string]string{"zone": "a"}, GeohashPrefix: "u33"}
+
+	err = n.SetKeygroupReplicationPolicy(kg, policy)
+	assert.NoError(t, err)
+
+	p, err = n.GetKeygroupReplicationPolicy(kg)
+	assert.NoError(t, err)
+	assert.Equal(t, policy, p)
+
+	kgs, err := n.GetAllKeygroups()
+	assert.NoError(t, err)
+	assert.Contains(t, kgs, kg)
+
+	info := fred.NodeInfo{Labels: map[string]string{"zone": "a"}, Geohash: "u33dc0", Location: &fred.Location{Lat: 52.52, Lng: 13.405}}
+
+	err = n.SetNodeInfo(info)
+	assert.NoError(t, err)
+
+	i, err := n.GetNodeInfo(nodeID)
+	assert.NoError(t, err)
+	assert.Equal(t, info, i)
+}
+
+func TestReadOnly(t *testing.T) {
+	kg := fred.KeygroupName("kg-readonly")
+
+	err := n.CreateKeygroup(kg, true, 0)
+	assert.NoError(t, err)
+
+	r, err := n.IsReadOnly(kg)
+	assert.NoError(t, err)
+	assert.False(t, r)
+
+	err = n.SetKeygroupReadOnly(kg, true)
+	assert.NoError(t, err)
+
+	r, err = n.IsReadOnly(kg)
+	assert.NoError(t, err)
+	assert.True(t, r)
+
+	// a new keygroup with the same name is writable
+	err = n.DeleteKeygroup(kg)
+	assert.NoError(t, err)
+
+	err = n.CreateKeygroup(kg, true, 0)
+	assert.NoError(t, err)
+
+	r, err = n.IsReadOnly(kg)
+	assert.NoError(t, err)
+	assert.False(t, r)
+}
+
 func TestKeygroupMembers(t *testing.T) {
 	kg := fred.KeygroupName("kg-members")
 	mutable := true
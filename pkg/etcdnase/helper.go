@@ -8,7 +8,6 @@ import (
 
 	"github.com/go-errors/errors"
 	"github.com/rs/zerolog/log"
-	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
 // getPrefix gets every key that starts(!) with the specified string
@@ -51,45 +50,35 @@ func (n *NameService) getPrefix(prefix string) (kv map[string]string, err error)
 
 	defer cncl()
 
-	resp, err := n.cli.Get(ctx, prefix, clientv3.WithPrefix(), clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	kv, err = n.kv.Get(ctx, prefix, true)
 
 	if err != nil {
 		return nil, errors.New(err)
 	}
 
-	kv = make(map[string]string)
-
-	for _, val := range resp.Kvs {
-		kv[string(val.Key)] = string(val.Value)
-	}
-
 	if n.cached {
 		n.local.Set(prefix, kv, 1)
 
 		// we start the watcher directly
 		go func() {
 			watchCtx, watchCncl := context.WithCancel(context.Background())
-			c := n.watcher.Watch(watchCtx, prefix, clientv3.WithPrefix())
+			c := n.kv.Watch(watchCtx, prefix, true)
 			log.Debug().Msgf("nase cache: watching for changes to prefix %s", prefix)
 
 			defer watchCncl()
-			for r := range c {
-				if err := r.Err(); err != nil {
-					log.Err(err).Msgf("nase cache: error getting changes to prefix %s", prefix)
-				}
-				log.Debug().Msgf("nase cache: got %d changes to prefix %s", len(r.Events), prefix)
+			for events := range c {
+				log.Debug().Msgf("nase cache: got %d changes to prefix %s", len(events), prefix)
 
-				for _, ev := range r.Events {
+				for _, ev := range events {
 
-					if ev.Type == clientv3.EventTypeDelete {
-						delete(kv, string(ev.Kv.Key))
-						log.Debug().Msgf("prefix: %s remote cache invalidation for key %s", prefix, string(ev.Kv.Key))
+					if ev.Deleted {
+						delete(kv, ev.Key)
+						log.Debug().Msgf("prefix: %s remote cache invalidation for key %s", prefix, ev.Key)
+						continue
 					}
 
-					if ev.Type == clientv3.EventTypePut {
-						kv[string(ev.Kv.Key)] = string(ev.Kv.Value)
-						log.Debug().Msgf("prefix: %s remote cache update for key %s", prefix, string(ev.Kv.Key))
-					}
+					kv[ev.Key] = ev.Value
+					log.Debug().Msgf("prefix: %s remote cache update for key %s", prefix, ev.Key)
 				}
 
 				prefixMap := make(map[string]string)
@@ -126,15 +115,13 @@ func (n *NameService) getExact(key string) (v string, err error) {
 
 	defer cncl()
 
-	resp, err := n.cli.Get(ctx, key)
+	resp, err := n.kv.Get(ctx, key, false)
 
 	if err != nil {
 		return "", errors.New(err)
 	}
 
-	if len(resp.Kvs) != 0 {
-		v = string(resp.Kvs[0].Value)
-	}
+	v = resp[key]
 
 	if n.cached {
 		n.local.Set(key, v, 1)
@@ -144,25 +131,20 @@ func (n *NameService) getExact(key string) (v string, err error) {
 		// that watcher should exit on key deletion, though!
 		go func() {
 			watchCtx, watchCncl := context.WithCancel(context.Background())
-			c := n.watcher.Watch(watchCtx, key)
+			c := n.kv.Watch(watchCtx, key, false)
 
 			defer watchCncl()
-			for r := range c {
-				if err := r.Err(); err != nil {
-					log.Err(err).Msgf("nase cache: error getting changes to key %s", key)
-				}
-				log.Debug().Msgf("nase cache: got %d changes to key %s", len(r.Events), key)
-				for _, ev := range r.Events {
-					if ev.Type == clientv3.EventTypeDelete {
+			for events := range c {
+				log.Debug().Msgf("nase cache: got %d changes to key %s", len(events), key)
+				for _, ev := range events {
+					if ev.Deleted {
 						n.local.Del(key)
 						log.Debug().Msgf("key: %s remote cache invalidation", key)
 						return
 					}
 
-					if ev.Type == clientv3.EventTypePut {
-						n.local.Set(key, string(ev.Kv.Value), 1)
-						log.Debug().Msgf("key: %s remote cache update", key)
-					}
+					n.local.Set(key, ev.Value, 1)
+					log.Debug().Msgf("key: %s remote cache update", key)
 				}
 			}
 		}()
@@ -226,11 +208,15 @@ func (n *NameService) put(key, value string, prefix ...string) (err error) {
 			n.local.Set(p, prefixMap, int64(len(prefixMap)))
 			log.Debug().Msgf("prefix: %s local cache update for key %s", p, key)
 		}
+		// sets are buffered and a set of a key that is still buffered is dropped, so a value that was read just before
+		// could otherwise stay in the cache: remove it and wait for the buffer before caching the new value
+		n.local.Del(key)
+		n.local.Wait()
 		n.local.Set(key, value, 1)
 		log.Debug().Msgf("key: %s local cache update", key)
 	}
 
-	_, err = n.cli.Put(ctx, key, value)
+	err = n.kv.Put(ctx, key, value)
 
 	if err != nil {
 		return errors.New(err)
@@ -274,10 +260,11 @@ func (n *NameService) delete(key string, prefix ...string) (err error) {
 
 		}
 		n.local.Del(key)
+		n.local.Wait()
 		log.Debug().Msgf("key: %s local cache invalidation", key)
 	}
 
-	_, err = n.cli.Delete(ctx, key)
+	err = n.kv.Delete(ctx, key, false)
 
 	if err != nil {
 		return errors.New(err)
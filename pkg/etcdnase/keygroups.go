@@ -2,12 +2,14 @@ package etcdnase
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
 	"git.tu-berlin.de/mcc-fred/fred/pkg/fred"
 	"github.com/go-errors/errors"
 	"github.com/rs/zerolog/log"
-	"go.etcd.io/etcd/client/v3"
 )
 
 // ExitOtherNodeFromKeygroup deletes the node from the NaSe
@@ -74,6 +76,264 @@ func (n *NameService) IsMutable(kg fred.KeygroupName) (bool, error) {
 	return status == "true", nil
 }
 
+// SetKeygroupIndexes stores the index definitions (index name -> JSON path) of a keygroup, replacing earlier ones.
+func (n *NameService) SetKeygroupIndexes(kg fred.KeygroupName, indexes map[string]string) error {
+	if indexes == nil {
+		indexes = make(map[string]string)
+	}
+
+	data, err := json.Marshal(indexes)
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	return n.put(fmt.Sprintf(fmtKgIndexesString, string(kg)), string(data))
+}
+
+// GetKeygroupIndexes returns the index definitions (index name -> JSON path) of a keygroup.
+func (n *NameService) GetKeygroupIndexes(kg fred.KeygroupName) (map[string]string, error) {
+	exists, err := n.ExistsKeygroup(kg)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, errors.Errorf("keygroup does not exist")
+	}
+
+	resp, err := n.getExact(fmt.Sprintf(fmtKgIndexesString, string(kg)))
+
+	if err != nil {
+		return nil, err
+	}
+
+	indexes := make(map[string]string)
+
+	// keygroups that were created before indexes were introduced have no entry
+	if resp == "" {
+		return indexes, nil
+	}
+
+	if err := json.Unmarshal([]byte(resp), &indexes); err != nil {
+		return nil, errors.Errorf("malformed index definitions %s for keygroup %s", resp, kg)
+	}
+
+	return indexes, nil
+}
+
+// SetConflictPolicy stores the conflict policy of a keygroup.
+func (n *NameService) SetConflictPolicy(kg fred.KeygroupName, policy fred.ConflictPolicy) error {
+	if policy == "" {
+		policy = fred.KeepSiblings
+	}
+
+	return n.put(fmt.Sprintf(fmtKgConflictString, string(kg)), string(policy))
+}
+
+// GetConflictPolicy returns the conflict policy of a keygroup.
+func (n *NameService) GetConflictPolicy(kg fred.KeygroupName) (fred.ConflictPolicy, error) {
+	resp, err := n.getExact(fmt.Sprintf(fmtKgConflictString, string(kg)))
+
+	if err != nil {
+		return "", err
+	}
+
+	// keygroups that were created before conflict policies were introduced have no entry
+	if resp == "" {
+		return fred.KeepSiblings, nil
+	}
+
+	return fred.ConflictPolicy(resp), nil
+}
+
+// SetKeygroupType stores the type of the items of a keygroup.
+func (n *NameService) SetKeygroupType(kg fred.KeygroupName, t fred.KeygroupType) error {
+	return n.put(fmt.Sprintf(fmtKgTypeString, string(kg)), string(t))
+}
+
+// GetKeygroupType returns the type of the items of a keygroup.
+func (n *NameService) GetKeygroupType(kg fred.KeygroupName) (fred.KeygroupType, error) {
+	resp, err := n.getExact(fmt.Sprintf(fmtKgTypeString, string(kg)))
+
+	if err != nil {
+		return "", err
+	}
+
+	// keygroups that were created before types were introduced have no entry and are plain
+	return fred.KeygroupType(resp), nil
+}
+
+// SetKeygroupHistory stores which replaced versions of the items of a keygroup are kept.
+func (n *NameService) SetKeygroupHistory(kg fred.KeygroupName, h fred.HistoryRetention) error {
+	data, err := json.Marshal(h)
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	return n.put(fmt.Sprintf(fmtKgHistoryString, string(kg)), string(data))
+}
+
+// GetKeygroupHistory returns which replaced versions of the items of a keygroup are kept.
+func (n *NameService) GetKeygroupHistory(kg fred.KeygroupName) (fred.HistoryRetention, error) {
+	resp, err := n.getExact(fmt.Sprintf(fmtKgHistoryString, string(kg)))
+
+	if err != nil {
+		return fred.HistoryRetention{}, err
+	}
+
+	var h fred.HistoryRetention
+
+	// keygroups that were created before histories were introduced have no entry and keep no history
+	if resp == "" {
+		return h, nil
+	}
+
+	if err := json.Unmarshal([]byte(resp), &h); err != nil {
+		return h, errors.Errorf("malformed history retention %s for keygroup %s", resp, kg)
+	}
+
+	return h, nil
+}
+
+// SetKeygroupQuota stores the quota of a keygroup.
+func (n *NameService) SetKeygroupQuota(kg fred.KeygroupName, q fred.Quota) error {
+	data, err := json.Marshal(q)
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	return n.put(fmt.Sprintf(fmtKgQuotaString, string(kg)), string(data))
+}
+
+// GetKeygroupQuota returns the quota of a keygroup.
+func (n *NameService) GetKeygroupQuota(kg fred.KeygroupName) (fred.Quota, error) {
+	resp, err := n.getExact(fmt.Sprintf(fmtKgQuotaString, string(kg)))
+
+	if err != nil {
+		return fred.Quota{}, err
+	}
+
+	var q fred.Quota
+
+	// keygroups that were created before quotas were introduced have no entry and no limits
+	if resp == "" {
+		return q, nil
+	}
+
+	if err := json.Unmarshal([]byte(resp), &q); err != nil {
+		return q, errors.Errorf("malformed quota %s for keygroup %s", resp, kg)
+	}
+
+	return q, nil
+}
+
+// SetKeygroupCompression stores the codec that the values of a keygroup are compressed with.
+func (n *NameService) SetKeygroupCompression(kg fred.KeygroupName, c fred.Compression) error {
+	return n.put(fmt.Sprintf(fmtKgCompressionString, string(kg)), string(c))
+}
+
+// GetKeygroupCompression returns the codec that the values of a keygroup are compressed with.
+func (n *NameService) GetKeygroupCompression(kg fred.KeygroupName) (fred.Compression, error) {
+	resp, err := n.getExact(fmt.Sprintf(fmtKgCompressionString, string(kg)))
+
+	if err != nil {
+		return "", err
+	}
+
+	// keygroups that were created before compression was introduced have no entry and are not compressed
+	return fred.Compression(resp), nil
+}
+
+// SetKeygroupReplicationPolicy stores the replication policy of a keygroup.
+func (n *NameService) SetKeygroupReplicationPolicy(kg fred.KeygroupName, p fred.ReplicationPolicy) error {
+	data, err := json.Marshal(p)
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	return n.put(fmt.Sprintf(fmtKgReplicationString, string(kg)), string(data))
+}
+
+// GetKeygroupReplicationPolicy returns the replication policy of a keygroup.
+func (n *NameService) GetKeygroupReplicationPolicy(kg fred.KeygroupName) (fred.ReplicationPolicy, error) {
+	resp, err := n.getExact(fmt.Sprintf(fmtKgReplicationString, string(kg)))
+
+	if err != nil {
+		return fred.ReplicationPolicy{}, err
+	}
+
+	var p fred.ReplicationPolicy
+
+	// keygroups that were created before replication policies were introduced have no entry and are not reconciled
+	if resp == "" {
+		return p, nil
+	}
+
+	if err := json.Unmarshal([]byte(resp), &p); err != nil {
+		return p, errors.Errorf("malformed replication policy %s for keygroup %s", resp, kg)
+	}
+
+	return p, nil
+}
+
+// SetKeygroupReadOnly marks a keygroup as read-only or writable again. Clients cannot write to a read-only keygroup,
+// e.g., while it is renamed.
+func (n *NameService) SetKeygroupReadOnly(kg fred.KeygroupName, readOnly bool) error {
+	data := "false"
+
+	if readOnly {
+		data = "true"
+	}
+
+	return n.put(fmt.Sprintf(fmtKgReadOnlyString, string(kg)), data)
+}
+
+// IsReadOnly checks whether a keygroup is read-only.
+func (n *NameService) IsReadOnly(kg fred.KeygroupName) (bool, error) {
+	resp, err := n.getExact(fmt.Sprintf(fmtKgReadOnlyString, string(kg)))
+
+	if err != nil {
+		return false, err
+	}
+
+	return resp == "true", nil
+}
+
+// GetAllKeygroups returns the names of all keygroups that exist. They are always read from the NaSe, never from the
+// cache, as that would keep all keys of all keygroups in memory.
+func (n *NameService) GetAllKeygroups() ([]fred.KeygroupName, error) {
+	ctx, cncl := context.WithTimeout(context.Background(), timeout)
+	defer cncl()
+
+	resp, err := n.kv.Get(ctx, kgPrefixString, true)
+
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	kgs := make([]fred.KeygroupName, 0)
+
+	for k, v := range resp {
+		split := strings.Split(k, sep)
+
+		if len(split) != 3 || split[2] != "status" || v != "created" {
+			continue
+		}
+
+		kgs = append(kgs, fred.KeygroupName(split[1]))
+	}
+
+	sort.Slice(kgs, func(i, j int) bool {
+		return kgs[i] < kgs[j]
+	})
+
+	return kgs, nil
+}
+
 // GetExpiry checks the expiration time for items of the keygroup on a replica.
 func (n *NameService) GetExpiry(kg fred.KeygroupName) (int, error) {
 	exists, err := n.ExistsKeygroup(kg)
@@ -109,6 +369,13 @@ func (n *NameService) CreateKeygroup(kg fred.KeygroupName, mutable bool, expiry
 		return err
 	}
 
+	// an earlier keygroup with this name may have been left read-only by a rename
+	err = n.SetKeygroupReadOnly(kg, false)
+
+	if err != nil {
+		return err
+	}
+
 	// Save the expiry attribute of the keygroup for this replica
 	err = n.addKgExpiryEntry(string(kg), n.NodeID, expiry)
 
@@ -131,7 +398,7 @@ func (n *NameService) CreateKeygroup(kg fred.KeygroupName, mutable bool, expiry
 
 	defer cncl()
 
-	_, err = n.cli.Delete(ctx, fmt.Sprintf(fmtKgNodeStringPrefix, string(kg)), clientv3.WithPrefix())
+	err = n.kv.Delete(ctx, fmt.Sprintf(fmtKgNodeStringPrefix, string(kg)), true)
 
 	if err != nil {
 		return errors.New(err)
@@ -209,3 +476,35 @@ func (n *NameService) JoinNodeIntoKeygroup(kg fred.KeygroupName, nodeID fred.Nod
 
 	return n.addOtherKgNodeEntry(string(nodeID), string(kg), "ok")
 }
+
+// SetTransferCheckpoint stores the id of the last item of a keygroup that was transferred from source to a new replica.
+func (n *NameService) SetTransferCheckpoint(kg fred.KeygroupName, nodeID fred.NodeID, source fred.NodeID, checkpoint string) error {
+	return n.put(fmt.Sprintf(fmtKgTransferString, string(kg), string(nodeID)), string(source)+sep+checkpoint)
+}
+
+// GetTransferCheckpoint returns the source and the id of the last transferred item of an unfinished transfer of a
+// keygroup to a new replica. If there is no such transfer, source and checkpoint are empty.
+func (n *NameService) GetTransferCheckpoint(kg fred.KeygroupName, nodeID fred.NodeID) (source fred.NodeID, checkpoint string, err error) {
+	resp, err := n.getExact(fmt.Sprintf(fmtKgTransferString, string(kg), string(nodeID)))
+
+	if err != nil {
+		return "", "", err
+	}
+
+	if resp == "" {
+		return "", "", nil
+	}
+
+	split := strings.SplitN(resp, sep, 2)
+
+	if len(split) != 2 {
+		return "", "", errors.Errorf("malformed transfer checkpoint %s for node %s in keygroup %s", resp, nodeID, kg)
+	}
+
+	return fred.NodeID(split[0]), split[1], nil
+}
+
+// DeleteTransferCheckpoint removes the checkpoint of a transfer of a keygroup to a new replica once it is complete.
+func (n *NameService) DeleteTransferCheckpoint(kg fred.KeygroupName, nodeID fred.NodeID) error {
+	return n.delete(fmt.Sprintf(fmtKgTransferString, string(kg), string(nodeID)))
+}
@@ -0,0 +1,297 @@
+package etcdnase
+
+import (
+	"context"
+	"time"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/grpcutil"
+	"github.com/go-errors/errors"
+	"github.com/rs/zerolog/log"
+	"go.etcd.io/etcd/client/pkg/v3/transport"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+// Event is a change to a key in the NaSe.
+type Event struct {
+	Key   string
+	Value string
+	// Deleted is set if the key was removed, Value is then empty.
+	Deleted bool
+}
+
+// KV is the key-value store that holds the state of the NaSe. The NameService only needs a few simple operations on
+// it, which lets it run on etcd as well as on the embedded store in package raftnase.
+type KV interface {
+	// Get Needs: key, whether all keys that start with the key are requested; Returns: keys and their values
+	Get(ctx context.Context, key string, prefix bool) (map[string]string, error)
+	// Put Needs: key, value
+	Put(ctx context.Context, key string, value string) error
+	// Delete Needs: key, whether all keys that start with the key are deleted
+	Delete(ctx context.Context, key string, prefix bool) error
+	// Watch Needs: key, whether all keys that start with the key are watched; Returns: channel with the changes to
+	// these keys, in the order they were made. The channel is closed once ctx is canceled or the watch fails.
+	Watch(ctx context.Context, key string, prefix bool) <-chan []Event
+	// Lease Needs: key, value, time to live; sets the key and keeps it set until ctx is canceled or the store is
+	// closed. If the lease is not renewed within its time to live, e.g. because this node crashed, the key is removed.
+	// Returns once the key is first set.
+	Lease(ctx context.Context, key string, value string, ttl time.Duration) error
+	// Campaign Needs: election key, value that identifies this node, time to live; blocks until this node is elected,
+	// only one node at a time is, or until ctx is canceled. This node stays elected until ctx is canceled or it fails to
+	// renew its lease within the time to live. Returns: channel that is closed once this node is no longer elected
+	Campaign(ctx context.Context, key string, value string, ttl time.Duration) (<-chan struct{}, error)
+	// Close indicates that the connection to the store is no longer needed.
+	Close() error
+}
+
+// etcdKV is a KV that is backed by an etcd cluster.
+type etcdKV struct {
+	cli *clientv3.Client
+}
+
+// NewEtcdKV connects to an etcd cluster.
+func NewEtcdKV(endpoints []string, certFile string, keyFile string, caFile string, skipVerify bool) (KV, error) {
+	_, _, err := grpcutil.GetCreds(certFile, keyFile, []string{caFile}, false, skipVerify)
+
+	if err != nil {
+		return nil, errors.Errorf("Error configuring certificates for the etcd client: %v", err)
+	}
+
+	tlsInfo := transport.TLSInfo{
+		CertFile:           certFile,
+		KeyFile:            keyFile,
+		TrustedCAFile:      caFile,
+		InsecureSkipVerify: skipVerify,
+	}
+
+	tlsConfig, err := tlsInfo.ClientConfig()
+
+	if err != nil {
+		return nil, errors.Errorf("Error configuring certificates for the etcd client: %v", err)
+	}
+
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints:   endpoints,
+		DialTimeout: timeout,
+		TLS:         tlsConfig,
+	})
+
+	if err != nil {
+		// Deadline Exceeded
+		return nil, errors.Errorf("Error starting the etcd client: %v", err)
+	}
+
+	return &etcdKV{
+		cli: cli,
+	}, nil
+}
+
+// Get gets a key or every key that starts(!) with the specified string.
+func (e *etcdKV) Get(ctx context.Context, key string, prefix bool) (map[string]string, error) {
+	opts := []clientv3.OpOption{clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend)}
+
+	if prefix {
+		opts = append(opts, clientv3.WithPrefix())
+	}
+
+	resp, err := e.cli.Get(ctx, key, opts...)
+
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	kv := make(map[string]string)
+
+	for _, val := range resp.Kvs {
+		kv[string(val.Key)] = string(val.Value)
+	}
+
+	return kv, nil
+}
+
+// Put puts the value into etcd.
+func (e *etcdKV) Put(ctx context.Context, key string, value string) error {
+	if _, err := e.cli.Put(ctx, key, value); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+// Delete removes a key or every key that starts with the specified string from etcd.
+func (e *etcdKV) Delete(ctx context.Context, key string, prefix bool) error {
+	var opts []clientv3.OpOption
+
+	if prefix {
+		opts = append(opts, clientv3.WithPrefix())
+	}
+
+	if _, err := e.cli.Delete(ctx, key, opts...); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+// Watch forwards the events of an etcd watcher.
+func (e *etcdKV) Watch(ctx context.Context, key string, prefix bool) <-chan []Event {
+	var opts []clientv3.OpOption
+
+	if prefix {
+		opts = append(opts, clientv3.WithPrefix())
+	}
+
+	c := e.cli.Watch(ctx, key, opts...)
+	out := make(chan []Event)
+
+	go func() {
+		defer close(out)
+
+		for r := range c {
+			if err := r.Err(); err != nil {
+				log.Err(err).Msgf("etcd: error getting changes to key %s", key)
+			}
+
+			events := make([]Event, len(r.Events))
+
+			for i, ev := range r.Events {
+				events[i] = Event{
+					Key:     string(ev.Kv.Key),
+					Value:   string(ev.Kv.Value),
+					Deleted: ev.Type == clientv3.EventTypeDelete,
+				}
+			}
+
+			select {
+			case out <- events:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Lease puts the value into etcd with an etcd lease and keeps the lease alive in the background. If the lease is lost,
+// e.g. because etcd could not be reached for longer than the time to live, the key is put again with a new lease.
+// Once ctx is canceled, the lease is revoked, which removes the key.
+func (e *etcdKV) Lease(ctx context.Context, key string, value string, ttl time.Duration) error {
+	pctx, cncl := context.WithTimeout(ctx, timeout)
+	id, err := e.grant(pctx, key, value, ttl)
+	cncl()
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			ka, err := e.cli.KeepAlive(ctx, id)
+
+			if err == nil {
+				// the channel is closed once ctx is canceled or the lease can no longer be kept alive
+				for range ka {
+				}
+			}
+
+			if ctx.Err() != nil || e.cli.Ctx().Err() != nil {
+				rctx, cncl := context.WithTimeout(context.Background(), timeout)
+				_, _ = e.cli.Revoke(rctx, id)
+				cncl()
+				return
+			}
+
+			log.Warn().Msgf("etcd: lost lease for key %s, putting it again", key)
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-e.cli.Ctx().Done():
+					return
+				case <-time.After(ttl / 3):
+				}
+
+				pctx, cncl := context.WithTimeout(ctx, timeout)
+				id, err = e.grant(pctx, key, value, ttl)
+				cncl()
+
+				if err == nil {
+					break
+				}
+
+				log.Err(err).Msgf("etcd: could not renew lease for key %s", key)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Campaign runs an etcd election. The session of the election holds a lease that is kept alive in the background, and
+// the election is resigned once ctx is canceled.
+func (e *etcdKV) Campaign(ctx context.Context, key string, value string, ttl time.Duration) (<-chan struct{}, error) {
+	seconds := int(ttl / time.Second)
+
+	if seconds < 1 {
+		seconds = 1
+	}
+
+	sess, err := concurrency.NewSession(e.cli, concurrency.WithTTL(seconds), concurrency.WithContext(ctx))
+
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	el := concurrency.NewElection(sess, key)
+
+	if err := el.Campaign(ctx, value); err != nil {
+		_ = sess.Close()
+		return nil, errors.New(err)
+	}
+
+	lost := make(chan struct{})
+
+	go func() {
+		defer close(lost)
+
+		select {
+		case <-sess.Done():
+		case <-ctx.Done():
+			rctx, cncl := context.WithTimeout(context.Background(), timeout)
+			_ = el.Resign(rctx)
+			cncl()
+
+			_ = sess.Close()
+		}
+	}()
+
+	return lost, nil
+}
+
+// grant puts the value into etcd with a new lease.
+func (e *etcdKV) grant(ctx context.Context, key string, value string, ttl time.Duration) (clientv3.LeaseID, error) {
+	seconds := int64(ttl / time.Second)
+
+	if seconds < 1 {
+		seconds = 1
+	}
+
+	l, err := e.cli.Grant(ctx, seconds)
+
+	if err != nil {
+		return 0, errors.New(err)
+	}
+
+	if _, err := e.cli.Put(ctx, key, value, clientv3.WithLease(l.ID)); err != nil {
+		return 0, errors.New(err)
+	}
+
+	return l.ID, nil
+}
+
+// Close closes the etcd client.
+func (e *etcdKV) Close() error {
+	return e.cli.Close()
+}
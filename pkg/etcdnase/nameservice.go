@@ -1,79 +1,75 @@
 package etcdnase
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"git.tu-berlin.de/mcc-fred/fred/pkg/fred"
-	"git.tu-berlin.de/mcc-fred/fred/pkg/grpcutil"
 	"github.com/dgraph-io/ristretto"
 	"github.com/go-errors/errors"
 	"github.com/rs/zerolog/log"
-	"go.etcd.io/etcd/client/pkg/v3/transport"
-	"go.etcd.io/etcd/client/v3"
 )
 
 const (
 	fmtKgNodeStringPrefix         = "kg|%s|node|"
 	fmtKgStatusString             = "kg|%s|status"
 	fmtKgMutableString            = "kg|%s|mutable"
+	fmtKgIndexesString            = "kg|%s|indexes"
+	fmtKgConflictString           = "kg|%s|conflict"
+	fmtKgTypeString               = "kg|%s|type"
+	fmtKgHistoryString            = "kg|%s|history"
+	fmtKgQuotaString              = "kg|%s|quota"
+	fmtKgCompressionString        = "kg|%s|compression"
+	fmtKgReplicationString        = "kg|%s|replication"
+	fmtKgReadOnlyString           = "kg|%s|readonly"
 	fmtKgExpiryStringPrefix       = "kg|%s|expiry|node|"
+	fmtKgTransferString           = "kg|%s|transfer|node|%s"
 	fmtNodeAdressString           = "node|%s|address"
 	fmtNodeExternalAdressString   = "node|%s|extaddress"
+	fmtNodeAliveString            = "node|%s|alive"
+	fmtNodePrefix                 = "node|%s|"
+	fmtNodeInfoString             = "node|%s|info"
+	fmtElectionString             = "election|%s"
+	kgPrefixString                = "kg|"
 	fmtUserPermissionStringPrefix = "user|%s|kg|%s|method|"
 	fmtFailedNodeKgStringPrefix   = "failnode|%s|kg|%s|" // Node, Keygroup, ID
 	fmtFailedNodePrefix           = "failnode|%s|"
 	nodePrefixString              = "node|"
+	userPrefixString              = "user|"
 	sep                           = "|"
 	timeout                       = 5 * time.Second
+	// nodeLeaseTTL is how long a node that stops renewing its lease is still considered alive.
+	nodeLeaseTTL = 10 * time.Second
 )
 
 // NameService is the interface to the etcd server that serves as NaSe
 // It is used by the replservice to sync updates to keygroups with other nodes and thereby makes sure that ReplicationStorage always has up to date information
 type NameService struct {
-	cli     *clientv3.Client
-	watcher clientv3.Watcher
-	local   *ristretto.Cache
-	cached  bool
-	NodeID  string
+	kv       KV
+	local    *ristretto.Cache
+	cached   bool
+	leaseTTL time.Duration
+	NodeID   string
 }
 
 // NewNameService creates a new NameService
 func NewNameService(nodeID string, endpoints []string, certFile string, keyFile string, caFile string, skipVerfiy bool, cached bool) (*NameService, error) {
-
-	_, _, err := grpcutil.GetCreds(certFile, keyFile, []string{caFile}, false, skipVerfiy)
+	kv, err := NewEtcdKV(endpoints, certFile, keyFile, caFile, skipVerfiy)
 
 	if err != nil {
-		return nil, errors.Errorf("Error configuring certificates for the etcd client: %v", err)
-	}
-
-	tlsInfo := transport.TLSInfo{
-		CertFile:           certFile,
-		KeyFile:            keyFile,
-		TrustedCAFile:      caFile,
-		InsecureSkipVerify: skipVerfiy,
+		return nil, err
 	}
 
-	tlsConfig, err := tlsInfo.ClientConfig()
-
-	if err != nil {
-		return nil, errors.Errorf("Error configuring certificates for the etcd client: %v", err)
-	}
-
-	cli, err := clientv3.New(clientv3.Config{
-		Endpoints:   endpoints,
-		DialTimeout: timeout,
-		TLS:         tlsConfig,
-	})
-
-	if err != nil {
-		// Deadline Exceeded
-		return nil, errors.Errorf("Error starting the etcd client: %v", err)
-	}
+	return NewNameServiceFromKV(nodeID, kv, cached)
+}
 
+// NewNameServiceFromKV creates a new NameService that keeps its state in the given store instead of etcd.
+func NewNameServiceFromKV(nodeID string, kv KV, cached bool) (*NameService, error) {
 	var cache *ristretto.Cache
-	var watcher clientv3.Watcher
 	if cached {
+		var err error
 		cache, err = ristretto.NewCache(&ristretto.Config{
 			NumCounters: 1e7,     // number of keys to track frequency of (10M).
 			MaxCost:     1 << 30, // maximum cost of cache (1GB).
@@ -83,19 +79,19 @@ func NewNameService(nodeID string, endpoints []string, certFile string, keyFile
 		if err != nil {
 			return nil, errors.Errorf("error creating a local ristretto cache: %s", err.Error())
 		}
-		watcher = clientv3.NewWatcher(cli)
 	}
 
 	return &NameService{
-		cli:     cli,
-		watcher: watcher,
-		local:   cache,
-		NodeID:  nodeID,
-		cached:  cached,
+		kv:       kv,
+		local:    cache,
+		NodeID:   nodeID,
+		cached:   cached,
+		leaseTTL: nodeLeaseTTL,
 	}, nil
 }
 
-// RegisterSelf stores information about this node
+// RegisterSelf stores information about this node and starts holding a lease that tells other nodes that it is alive.
+// The addresses stay in the NaSe when this node stops, the lease expires.
 func (n *NameService) RegisterSelf(host string, externalHost string) error {
 	key := fmt.Sprintf(fmtNodeAdressString, n.NodeID)
 	log.Debug().Msgf("NaSe: registering self as %s // %s", key, host)
@@ -108,10 +104,57 @@ func (n *NameService) RegisterSelf(host string, externalHost string) error {
 
 	key = fmt.Sprintf(fmtNodeExternalAdressString, n.NodeID)
 	log.Debug().Msgf("NaSe: registering external address as %s // %s", key, externalHost)
-	return n.put(key, externalHost)
+	err = n.put(key, externalHost)
+
+	if err != nil {
+		return err
+	}
+
+	key = fmt.Sprintf(fmtNodeAliveString, n.NodeID)
+	log.Debug().Msgf("NaSe: holding lease on %s with a time to live of %s", key, n.leaseTTL)
+
+	// the lease is kept alive in the background for as long as this node runs
+	return n.kv.Lease(context.Background(), key, "true", n.leaseTTL)
 }
 
 // GetNodeID returns the ID of this node.
 func (n *NameService) GetNodeID() fred.NodeID {
 	return fred.NodeID(n.NodeID)
 }
+
+// SetNodeInfo stores the description of this node that replicas are placed by.
+func (n *NameService) SetNodeInfo(info fred.NodeInfo) error {
+	data, err := json.Marshal(info)
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	key := fmt.Sprintf(fmtNodeInfoString, n.NodeID)
+	log.Debug().Msgf("NaSe: registering node info as %s // %s", key, data)
+
+	return n.put(key, string(data))
+}
+
+// Campaign blocks until this node is elected in an election, which only one node at a time is, or until done is
+// closed. It stays elected until done is closed or it fails to renew its lease in time. If done is nil, it stays
+// elected for as long as it runs. Returns: channel that is closed once this node is no longer elected
+func (n *NameService) Campaign(election string, done <-chan struct{}) (<-chan struct{}, error) {
+	ctx, cncl := context.WithCancel(context.Background())
+
+	go func() {
+		if done != nil {
+			<-done
+			cncl()
+		}
+	}()
+
+	lost, err := n.kv.Campaign(ctx, fmt.Sprintf(fmtElectionString, election), n.NodeID, n.leaseTTL)
+
+	if err != nil {
+		cncl()
+		return nil, errors.New(err)
+	}
+
+	return lost, nil
+}
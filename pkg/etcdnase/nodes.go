@@ -1,8 +1,11 @@
 package etcdnase
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"git.tu-berlin.de/mcc-fred/fred/pkg/fred"
 	"github.com/go-errors/errors"
@@ -79,3 +82,181 @@ func (n *NameService) getAllNodesBySuffix(suffix string) (nodes []fred.Node, err
 	}
 	return
 }
+
+// GetNodeStatus returns whether a node currently holds its lease. The status is always read from the NaSe, never from
+// the cache, as it changes without anyone writing to it.
+func (n *NameService) GetNodeStatus(nodeID fred.NodeID) (fred.NodeStatus, error) {
+	ctx, cncl := context.WithTimeout(context.Background(), timeout)
+	defer cncl()
+
+	resp, err := n.kv.Get(ctx, fmt.Sprintf(fmtNodePrefix, string(nodeID)), true)
+
+	if err != nil {
+		return "", errors.New(err)
+	}
+
+	if _, ok := resp[fmt.Sprintf(fmtNodeAdressString, string(nodeID))]; !ok {
+		return "", errors.Errorf("no such node %s", nodeID)
+	}
+
+	if _, ok := resp[fmt.Sprintf(fmtNodeAliveString, string(nodeID))]; !ok {
+		return fred.NodeDead, nil
+	}
+
+	return fred.NodeAlive, nil
+}
+
+// WatchNodes calls f with the status of every node and then with every change of that status, until done is closed.
+// If done is nil, the nodes are watched for as long as this node runs. If the watch fails, e.g. because the store
+// compacted the changes it was at, the nodes are watched again and their status is read again, as changes in between
+// are lost. Until that succeeds, all known nodes are reported as alive so that nothing waits on a node that may be
+// alive again.
+func (n *NameService) WatchNodes(done <-chan struct{}, f func(nodeID fred.NodeID, status fred.NodeStatus)) error {
+	ctx, cncl := context.WithCancel(context.Background())
+
+	if done != nil {
+		go func() {
+			<-done
+			cncl()
+		}()
+	}
+
+	// start watching before reading the current status so that no change in between is missed
+	c := n.kv.Watch(ctx, nodePrefixString, true)
+
+	known := make(map[fred.NodeID]struct{})
+
+	if err := n.readNodes(ctx, known, f); err != nil {
+		cncl()
+		return err
+	}
+
+	go func() {
+		defer cncl()
+
+		for {
+			for events := range c {
+				for _, ev := range events {
+					if !strings.HasSuffix(ev.Key, sep+"alive") {
+						continue
+					}
+
+					nodeID := fred.NodeID(strings.Split(ev.Key, sep)[1])
+					known[nodeID] = struct{}{}
+
+					log.Debug().Msgf("NaSe: WatchNodes: lease of node %s changed, deleted: %t", nodeID, ev.Deleted)
+
+					if ev.Deleted {
+						f(nodeID, fred.NodeDead)
+						continue
+					}
+
+					f(nodeID, fred.NodeAlive)
+				}
+			}
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			log.Warn().Msg("NaSe: WatchNodes: watching nodes was interrupted, watching them again")
+
+			for id := range known {
+				f(id, fred.NodeAlive)
+			}
+
+			c = n.kv.Watch(ctx, nodePrefixString, true)
+
+			for {
+				err := n.readNodes(ctx, known, f)
+
+				if err == nil {
+					break
+				}
+
+				log.Err(err).Msg("NaSe: WatchNodes: could not read the status of nodes")
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(timeout):
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// readNodes calls f with the current status of every node and adds the nodes to known.
+func (n *NameService) readNodes(ctx context.Context, known map[fred.NodeID]struct{}, f func(nodeID fred.NodeID, status fred.NodeStatus)) error {
+	gctx, gcncl := context.WithTimeout(ctx, timeout)
+	resp, err := n.kv.Get(gctx, nodePrefixString, true)
+	gcncl()
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	for k := range resp {
+		if !strings.HasSuffix(k, sep+"address") {
+			continue
+		}
+
+		nodeID := strings.Split(k, sep)[1]
+		known[fred.NodeID(nodeID)] = struct{}{}
+
+		if _, ok := resp[fmt.Sprintf(fmtNodeAliveString, nodeID)]; ok {
+			f(fred.NodeID(nodeID), fred.NodeAlive)
+			continue
+		}
+
+		f(fred.NodeID(nodeID), fred.NodeDead)
+	}
+
+	return nil
+}
+
+// GetNodeInfo returns the description of a node that replicas are placed by. Nodes that have not stored one yet have
+// an empty description.
+func (n *NameService) GetNodeInfo(nodeID fred.NodeID) (fred.NodeInfo, error) {
+	resp, err := n.getExact(fmt.Sprintf(fmtNodeInfoString, string(nodeID)))
+
+	if err != nil {
+		return fred.NodeInfo{}, err
+	}
+
+	var info fred.NodeInfo
+
+	if resp == "" {
+		return info, nil
+	}
+
+	if err := json.Unmarshal([]byte(resp), &info); err != nil {
+		return info, errors.Errorf("malformed info %s for node %s", resp, nodeID)
+	}
+
+	return info, nil
+}
+
+// DeregisterNode removes a node from the NaSe together with the items it has missed, so that other nodes no longer
+// know about it. It should no longer be a replica of any keygroup. If the node is still running, it keeps working
+// until it is stopped but is no longer found by other nodes.
+func (n *NameService) DeregisterNode(nodeID fred.NodeID) error {
+	log.Debug().Msgf("NaSe: deregistering node %s", nodeID)
+
+	for _, f := range []string{fmtNodeAdressString, fmtNodeExternalAdressString, fmtNodeInfoString, fmtNodeAliveString} {
+		if err := n.delete(fmt.Sprintf(f, string(nodeID)), nodePrefixString); err != nil {
+			return err
+		}
+	}
+
+	ctx, cncl := context.WithTimeout(context.Background(), timeout)
+	defer cncl()
+
+	if err := n.kv.Delete(ctx, fmt.Sprintf(fmtFailedNodePrefix, string(nodeID)), true); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
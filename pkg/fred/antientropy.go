@@ -0,0 +1,239 @@
+package fred
+
+import (
+	"encoding/binary"
+	"math/rand"
+	"time"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/vector"
+	"github.com/cespare/xxhash/v2"
+	"github.com/go-errors/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// merkleLeaves is the number of buckets that the items of a keygroup are hashed into for anti-entropy.
+const merkleLeaves = 256
+
+// merkleTree is a binary hash tree over all items of a keygroup. It is stored in heap order: the root is at index 0
+// and the children of node n are at 2n+1 and 2n+2. Each of the merkleLeaves leaves covers all items whose id hashes
+// into its bucket, so two replicas only need to exchange the items of the buckets where their leaves differ.
+type merkleTree []uint64
+
+// newMerkleTree creates an empty merkle tree.
+func newMerkleTree() merkleTree {
+	return make(merkleTree, 2*merkleLeaves-1)
+}
+
+// merkleBucket returns the bucket (i.e. leaf) an item id belongs to.
+func merkleBucket(id string) int {
+	return int(xxhash.Sum64String(id) % merkleLeaves)
+}
+
+// add adds an item version to the leaf of its bucket. Leaves are the XOR of their item hashes, so the order in which
+// items are added does not matter.
+func (t merkleTree) add(i Item) {
+	t[merkleLeaves-1+merkleBucket(i.ID)] ^= xxhash.Sum64String(i.ID + "|" + vector.SortedVCString(i.Version) + "|" + i.Val)
+}
+
+// seal computes all inner nodes of the tree from its leaves. It has to be called after all items are added.
+func (t merkleTree) seal() {
+	b := make([]byte, 16)
+
+	for n := merkleLeaves - 2; n >= 0; n-- {
+		binary.LittleEndian.PutUint64(b[:8], t[2*n+1])
+		binary.LittleEndian.PutUint64(b[8:], t[2*n+2])
+		t[n] = xxhash.Sum64(b)
+	}
+}
+
+// diff returns all buckets where two trees are different.
+func (t merkleTree) diff(o merkleTree) []int {
+	var buckets []int
+
+	if len(o) != len(t) {
+		// the other tree uses a different layout, so we can't say which parts are the same
+		buckets = make([]int, merkleLeaves)
+		for b := range buckets {
+			buckets[b] = b
+		}
+		return buckets
+	}
+
+	nodes := []int{0}
+
+	for len(nodes) > 0 {
+		n := nodes[len(nodes)-1]
+		nodes = nodes[:len(nodes)-1]
+
+		if t[n] == o[n] {
+			continue
+		}
+
+		if n >= merkleLeaves-1 {
+			buckets = append(buckets, n-(merkleLeaves-1))
+			continue
+		}
+
+		nodes = append(nodes, 2*n+2, 2*n+1)
+	}
+
+	return buckets
+}
+
+// merkleTree builds the merkle tree over all items of a keygroup in the store.
+func (s *storeService) merkleTree(kg KeygroupName) (merkleTree, error) {
+	t := newMerkleTree()
+
+	after := ""
+
+	for {
+		items, err := s.readPage(kg, after, transferPageSize)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(items) == 0 {
+			break
+		}
+
+		for _, item := range items {
+			t.add(item)
+		}
+
+		after = items[len(items)-1].ID
+	}
+
+	t.seal()
+
+	return t, nil
+}
+
+// readBuckets reads all items of a keygroup that fall into one of the given buckets. The items are passed to send
+// page by page.
+func (s *storeService) readBuckets(kg KeygroupName, buckets []int, send func(items []Item) error) error {
+	want := make(map[int]struct{}, len(buckets))
+
+	for _, b := range buckets {
+		if b < 0 || b >= merkleLeaves {
+			return errors.Errorf("invalid bucket %d, there are only %d buckets", b, merkleLeaves)
+		}
+		want[b] = struct{}{}
+	}
+
+	after := ""
+
+	for {
+		items, err := s.readPage(kg, after, transferPageSize)
+
+		if err != nil {
+			return err
+		}
+
+		if len(items) == 0 {
+			return nil
+		}
+
+		page := make([]Item, 0, len(items))
+
+		for _, item := range items {
+			if _, ok := want[merkleBucket(item.ID)]; ok {
+				page = append(page, item)
+			}
+		}
+
+		if len(page) > 0 {
+			s.addTimestamps(kg, page)
+
+			if err := send(page); err != nil {
+				return errors.New(err)
+			}
+		}
+
+		after = items[len(items)-1].ID
+	}
+}
+
+// runAntiEntropy compares every keygroup on this node with another replica each interval and repairs any differences.
+// It does not return.
+func (s *replicationService) runAntiEntropy(interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for range t.C {
+		for _, kg := range s.s.keygroups() {
+			if err := s.antiEntropy(kg); err != nil {
+				log.Err(err).Msgf("antiEntropy from replservice: could not compare keygroup %s with other replicas", kg)
+			}
+		}
+	}
+}
+
+// antiEntropy compares the data of a keygroup with a random other replica and pulls all items that this node is
+// missing or has an older version of. As every replica does this, all replicas eventually converge.
+func (s *replicationService) antiEntropy(kg KeygroupName) error {
+	members, err := s.n.GetKeygroupMembers(kg, false)
+
+	if err != nil {
+		return err
+	}
+
+	expiry, ok := members[s.n.GetNodeID()]
+
+	if !ok {
+		// we are not (or no longer) a replica of this keygroup
+		return nil
+	}
+
+	var peers []NodeID
+
+	for id, e := range members {
+		if id == s.n.GetNodeID() {
+			continue
+		}
+
+		// a replica with a different expiry might still hold items that have already expired here
+		if expiry != 0 && e != expiry {
+			continue
+		}
+
+		peers = append(peers, id)
+	}
+
+	if len(peers) == 0 {
+		return nil
+	}
+
+	peer := peers[rand.Intn(len(peers))]
+
+	addr, err := s.n.GetNodeAddress(peer)
+
+	if err != nil {
+		return err
+	}
+
+	remote, err := s.c.SendGetMerkleTree(addr, kg)
+
+	if err != nil {
+		return err
+	}
+
+	local, err := s.s.merkleTree(kg)
+
+	if err != nil {
+		return err
+	}
+
+	buckets := local.diff(remote)
+
+	if len(buckets) == 0 {
+		log.Debug().Msgf("antiEntropy from replservice: keygroup %s is in sync with %s", kg, peer)
+		return nil
+	}
+
+	log.Info().Msgf("antiEntropy from replservice: keygroup %s differs from %s in %d buckets, repairing", kg, peer, len(buckets))
+
+	return s.c.SendGetBucketItems(addr, kg, buckets, func(items []Item) error {
+		return s.storeRemoteItems(kg, peer, items)
+	})
+}
@@ -0,0 +1,127 @@
+package fred
+
+import (
+	"fmt"
+	"testing"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/badgerdb"
+	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerkleTreeDiff(t *testing.T) {
+	a := newMerkleTree()
+	b := newMerkleTree()
+
+	for i := 0; i < 1000; i++ {
+		item := Item{
+			ID:      fmt.Sprintf("item-%d", i),
+			Val:     "value",
+			Version: vclock.VClock{"nodeA": uint64(i)},
+		}
+		a.add(item)
+		b.add(item)
+	}
+
+	a.seal()
+	b.seal()
+
+	assert.Empty(t, a.diff(b))
+
+	// a newer version of an item and a new item should only show up in their own buckets
+	b = newMerkleTree()
+
+	for i := 0; i < 1000; i++ {
+		item := Item{
+			ID:      fmt.Sprintf("item-%d", i),
+			Val:     "value",
+			Version: vclock.VClock{"nodeA": uint64(i)},
+		}
+
+		if i == 42 {
+			item.Version = vclock.VClock{"nodeA": uint64(i), "nodeB": 1}
+		}
+
+		b.add(item)
+	}
+
+	b.add(Item{ID: "new-item", Val: "value"})
+	b.seal()
+
+	expected := []int{merkleBucket("item-42")}
+	if merkleBucket("new-item") != expected[0] {
+		expected = append(expected, merkleBucket("new-item"))
+	}
+
+	assert.ElementsMatch(t, expected, a.diff(b))
+	assert.ElementsMatch(t, expected, b.diff(a))
+}
+
+// repairNaSe is a NameService with a mutable keygroup whose concurrent values are merged.
+type repairNaSe struct {
+	NameService
+}
+
+func (n *repairNaSe) GetNodeID() NodeID {
+	return "X"
+}
+
+func (n *repairNaSe) IsMutable(_ KeygroupName) (bool, error) {
+	return true, nil
+}
+
+func (n *repairNaSe) GetExpiry(_ KeygroupName) (int, error) {
+	return 0, nil
+}
+
+func (n *repairNaSe) GetConflictPolicy(_ KeygroupName) (ConflictPolicy, error) {
+	return MergePolicy("union"), nil
+}
+
+func TestStoreRemoteItemsPublishes(t *testing.T) {
+	kg := KeygroupName("repairkg")
+
+	store := badgerdb.NewMemory()
+	t.Cleanup(func() { _ = store.Close() })
+
+	l := badgerdb.NewMemoryChangeLog()
+	t.Cleanup(func() { _ = l.Close() })
+
+	s := newStoreService(store, "X")
+	assert.NoError(t, s.createKeygroup(kg))
+
+	_, err := s.update(Item{Keygroup: kg, ID: "a", Val: `["x"]`}, 0, nil)
+	assert.NoError(t, err)
+
+	w := newWatchService()
+	c := newChangeLogService(l, "X", 0, 0)
+	r := newReplicationService(s, nil, &repairNaSe{}, w, c, false, nil, 0)
+
+	x := w.subscribe(kg, "")
+	defer w.unsubscribe(kg, x)
+
+	// a concurrent version from Y is merged with ours, and a version we already have changes nothing
+	assert.NoError(t, r.storeRemoteItems(kg, "Y", []Item{
+		{ID: "a", Val: `["y"]`, Version: vclock.VClock{"Y": 1}},
+		{ID: "a", Val: `["x"]`, Version: vclock.VClock{"X": 1}},
+	}))
+
+	assert.Len(t, x.changes, 1)
+
+	i := <-x.changes
+	assert.Equal(t, `["x","y"]`, i.Val)
+	assert.Equal(t, vclock.VClock{"X": 1, "Y": 1}, i.Version)
+
+	done := make(chan struct{})
+	changes := make([]Change, 0)
+
+	assert.NoError(t, c.read(kg, 0, done, func(ch Change) error {
+		changes = append(changes, ch)
+		close(done)
+		return nil
+	}))
+
+	assert.Len(t, changes, 1)
+	assert.Equal(t, `["x","y"]`, changes[0].Val)
+	assert.Equal(t, NodeID("Y"), changes[0].Origin)
+}
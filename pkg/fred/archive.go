@@ -0,0 +1,353 @@
+package fred
+
+import (
+	"sort"
+
+	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/go-errors/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// KeygroupArchive is everything about a keygroup that is exported along with its items: the configuration of the
+// keygroup, its members, the triggers of the node that it was exported from, and the permissions of its users.
+type KeygroupArchive struct {
+	Keygroup
+	// Source is the node that the keygroup was exported from, Keygroup.Expiry is the expiry on that node.
+	Source NodeID
+	// Expiries maps the members of the keygroup to their expiry.
+	Expiries map[NodeID]int
+	Triggers []Trigger
+	// Permissions maps users to the methods that they may perform on the keygroup.
+	Permissions map[string][]Method
+}
+
+// ImportResult describes what an import has changed.
+type ImportResult struct {
+	// Versions is the number of item versions that were imported.
+	Versions int
+	// Deleted is the number of items that were deleted because they are not in the archive.
+	Deleted int
+	// Replicas are the members of the archive that were added as replicas.
+	Replicas []NodeID
+	// Skipped are the members of the archive that could not be added as replicas, e.g., because they are not part of
+	// this cluster.
+	Skipped []NodeID
+}
+
+// archiveKeygroup collects the configuration of a keygroup for an export.
+func (h *ExtHandler) archiveKeygroup(k Keygroup) (KeygroupArchive, error) {
+	a := KeygroupArchive{
+		Keygroup: Keygroup{Name: k.Name},
+		Source:   h.n.GetNodeID(),
+	}
+
+	var err error
+
+	if a.Mutable, err = h.n.IsMutable(k.Name); err != nil {
+		return a, err
+	}
+
+	if a.Expiries, err = h.n.GetKeygroupMembers(k.Name, false); err != nil {
+		return a, err
+	}
+
+	a.Expiry = a.Expiries[a.Source]
+
+	if a.Indexes, err = h.n.GetKeygroupIndexes(k.Name); err != nil {
+		return a, err
+	}
+
+	if a.ConflictPolicy, err = h.n.GetConflictPolicy(k.Name); err != nil {
+		return a, err
+	}
+
+	if a.Type, err = h.n.GetKeygroupType(k.Name); err != nil {
+		return a, err
+	}
+
+	if a.History, err = h.n.GetKeygroupHistory(k.Name); err != nil {
+		return a, err
+	}
+
+	if a.Quota, err = h.n.GetKeygroupQuota(k.Name); err != nil {
+		return a, err
+	}
+
+	if a.Compression, err = h.n.GetKeygroupCompression(k.Name); err != nil {
+		return a, err
+	}
+
+	if a.ReplicationPolicy, err = h.n.GetKeygroupReplicationPolicy(k.Name); err != nil {
+		return a, err
+	}
+
+	if a.Triggers, err = h.t.getTrigger(k); err != nil {
+		return a, err
+	}
+
+	permissions, err := h.n.GetKeygroupPermissions(k.Name)
+
+	if err != nil {
+		return a, err
+	}
+
+	a.Permissions = make(map[string][]Method, len(permissions))
+
+	for u, p := range permissions {
+		for m := range p {
+			a.Permissions[u] = append(a.Permissions[u], m)
+		}
+
+		sort.Slice(a.Permissions[u], func(i, j int) bool {
+			return a.Permissions[u][i] < a.Permissions[u][j]
+		})
+	}
+
+	return a, nil
+}
+
+// rebaseOffset returns the offset that imported versions of an item are rebased onto: the merge of all versions of the
+// item that we know, including a collected tombstone. It is empty for items that we don't know.
+func (s *storeService) rebaseOffset(kg KeygroupName, id string) (vclock.VClock, error) {
+	s.vCacheLock.RLock()
+	defer s.vCacheLock.RUnlock()
+
+	kc, ok := s.vCache[kg]
+
+	if !ok {
+		return nil, errors.Errorf("no version cache for keygroup %+v", kg)
+	}
+
+	kc.RLock()
+	defer kc.RUnlock()
+
+	offset := vclock.VClock{}
+
+	c, ok := kc.clocks[id]
+
+	if !ok {
+		return offset, nil
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	for _, v := range c.clocks {
+		offset.Merge(v)
+	}
+
+	if c.purged != nil {
+		offset.Merge(c.purged)
+	}
+
+	return offset, nil
+}
+
+// rebase shifts an imported version by an offset, i.e., adds the offset to every entry. The result is newer than every
+// version that the offset is merged from, so imported versions replace what we have, and two imported versions have
+// the same order as before. An empty offset leaves the version as it is.
+func rebase(v vclock.VClock, offset vclock.VClock) vclock.VClock {
+	r := v.Copy()
+
+	for id, t := range offset {
+		r[id] += t
+	}
+
+	return r
+}
+
+// importItems stores a page of imported items and sends them to the other replicas. The versions of each item are
+// rebased onto the versions that we knew before the import started, the offsets of all items that were imported so far
+// are kept in offsets. It returns the items as they were stored.
+func (s *replicationService) importItems(kg KeygroupName, items []Item, offsets map[string]vclock.VClock) ([]Item, error) {
+	mutable, err := s.n.IsMutable(kg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	expiry, err := s.n.GetExpiry(kg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := s.n.GetConflictPolicy(kg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stored := make([]Item, 0, len(items))
+
+	for _, i := range items {
+		i.Keygroup = kg
+		i.Timestamp = s.s.clock.now()
+
+		if !mutable {
+			// items in an immutable keygroup can never change
+			if s.s.exists(i) {
+				continue
+			}
+
+			if err := s.s.append(i, expiry); err != nil {
+				return nil, err
+			}
+
+			if err := s.relayAppend(i); err != nil {
+				return nil, err
+			}
+
+			stored = append(stored, i)
+			continue
+		}
+
+		offset, ok := offsets[i.ID]
+
+		if !ok {
+			// all versions of an item share the same offset, otherwise the first version would change it for the others
+			offset, err = s.s.rebaseOffset(kg, i.ID)
+
+			if err != nil {
+				return nil, err
+			}
+
+			offsets[i.ID] = offset
+		}
+
+		i.Version = rebase(i.Version, offset)
+
+		changed, err := s.s.addVersion(i, i.Version, expiry, policy)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.relayUpdate(i); err != nil {
+			return nil, err
+		}
+
+		stored = append(stored, changed...)
+	}
+
+	return stored, nil
+}
+
+// restoreReplicas adds the members of an archive that are not yet members of the keygroup as replicas, as long as they
+// are nodes of this cluster. If the user is not allowed to add replicas to the keygroup, they are all skipped.
+func (h *ExtHandler) restoreReplicas(user string, a KeygroupArchive, result *ImportResult) error {
+	members, err := h.n.GetKeygroupMembers(a.Name, false)
+
+	if err != nil {
+		return err
+	}
+
+	allowed, err := h.a.isAllowed(user, AddReplica, a.Name)
+
+	if err != nil {
+		return err
+	}
+
+	ids := make([]NodeID, 0, len(a.Expiries))
+
+	for id := range a.Expiries {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	for _, id := range ids {
+		if _, ok := members[id]; ok {
+			continue
+		}
+
+		if !allowed {
+			log.Warn().Msgf("ImportKeygroup: user %s cannot add replicas to keygroup %s, skipping node %s", user, a.Name, id)
+			result.Skipped = append(result.Skipped, id)
+			continue
+		}
+
+		if _, err := h.n.GetNodeAddress(id); err != nil {
+			log.Warn().Msgf("ImportKeygroup: node %s of keygroup %s is not part of this cluster, skipping it", id, a.Name)
+			result.Skipped = append(result.Skipped, id)
+			continue
+		}
+
+		if err := h.r.addReplica(Keygroup{Name: a.Name, Expiry: a.Expiries[id]}, Node{ID: id}); err != nil {
+			log.Err(err).Msgf("ImportKeygroup: could not add node %s as a replica of keygroup %s", id, a.Name)
+			result.Skipped = append(result.Skipped, id)
+			continue
+		}
+
+		result.Replicas = append(result.Replicas, id)
+	}
+
+	return nil
+}
+
+// deleteMissing deletes all items of a keygroup that were not imported, so that the keygroup has the same items as the
+// archive afterwards.
+func (h *ExtHandler) deleteMissing(kg KeygroupName, imported map[string]vclock.VClock) (int, error) {
+	deleted := 0
+	after := ""
+
+	for {
+		items, err := h.s.readPage(kg, after, transferPageSize)
+
+		if err != nil {
+			return deleted, err
+		}
+
+		if len(items) == 0 {
+			return deleted, nil
+		}
+
+		// the versions of an item are next to each other
+		for j := 0; j < len(items); {
+			k := j + 1
+			live := !items[j].Tombstoned
+
+			for k < len(items) && items[k].ID == items[j].ID {
+				live = live || !items[k].Tombstoned
+				k++
+			}
+
+			i := items[j]
+			j = k
+
+			if _, ok := imported[i.ID]; ok || !live {
+				continue
+			}
+
+			i.Val = ""
+			i.Tombstoned = true
+			i.Timestamp = h.s.clock.now()
+
+			i.Version, err = h.s.tombstone(i, nil)
+
+			if err != nil {
+				return deleted, err
+			}
+
+			h.w.notify(i)
+
+			if err := h.c.record(i, false, h.c.id); err != nil {
+				log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+			}
+
+			if err := h.r.relayUpdate(i); err != nil {
+				return deleted, err
+			}
+
+			if err := h.t.triggerDelete(i); err != nil {
+				return deleted, err
+			}
+
+			deleted++
+		}
+
+		after = items[len(items)-1].ID
+	}
+}
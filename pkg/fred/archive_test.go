@@ -0,0 +1,161 @@
+package fred
+
+import (
+	"testing"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/badgerdb"
+	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRebase(t *testing.T) {
+	kg := KeygroupName("importkg")
+
+	store := badgerdb.NewMemory()
+	t.Cleanup(func() { _ = store.Close() })
+
+	s := newStoreService(store, "X")
+	assert.NoError(t, s.createKeygroup(kg))
+
+	offset, err := s.rebaseOffset(kg, "a")
+	assert.NoError(t, err)
+	assert.Empty(t, offset)
+
+	// importing into an empty keygroup keeps the versions of the archive
+	assert.Equal(t, vclock.VClock{"A": 1}, rebase(vclock.VClock{"A": 1}, offset))
+
+	current, err := s.update(Item{Keygroup: kg, ID: "a", Val: "current"}, 0, nil)
+	assert.NoError(t, err)
+
+	current, err = s.update(Item{Keygroup: kg, ID: "a", Val: "current"}, 0, nil)
+	assert.NoError(t, err)
+
+	offset, err = s.rebaseOffset(kg, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, current, offset)
+
+	// two concurrent versions in the archive replace the current version and stay concurrent
+	a := rebase(vclock.VClock{"A": 1}, offset)
+	b := rebase(vclock.VClock{"B": 1, "X": 1}, offset)
+
+	assert.True(t, current.Compare(a, vclock.Descendant))
+	assert.True(t, current.Compare(b, vclock.Descendant))
+	assert.True(t, a.Compare(b, vclock.Concurrent))
+
+	_, err = s.addVersion(Item{Keygroup: kg, ID: "a", Val: "a"}, a, 0, KeepSiblings)
+	assert.NoError(t, err)
+	_, err = s.addVersion(Item{Keygroup: kg, ID: "a", Val: "b"}, b, 0, KeepSiblings)
+	assert.NoError(t, err)
+
+	items, err := s.read(kg, "a")
+	assert.NoError(t, err)
+	assert.Len(t, items, 2)
+
+	vals := []string{items[0].Val, items[1].Val}
+	assert.ElementsMatch(t, []string{"a", "b"}, vals)
+}
+
+// restoreNaSe is a NameService with a keygroup that only has this node as a member and one other node in the cluster.
+type restoreNaSe struct {
+	NameService
+	permissions map[string]map[Method]struct{}
+}
+
+func (n *restoreNaSe) GetKeygroupMembers(_ KeygroupName, _ bool) (map[NodeID]int, error) {
+	return map[NodeID]int{"X": 0}, nil
+}
+
+func (n *restoreNaSe) GetNodeAddress(nodeID NodeID) (string, error) {
+	return string(nodeID), nil
+}
+
+func (n *restoreNaSe) GetUserPermissions(user string, _ KeygroupName) (map[Method]struct{}, error) {
+	return n.permissions[user], nil
+}
+
+func TestRestoreReplicasNotAllowed(t *testing.T) {
+	n := &restoreNaSe{permissions: map[string]map[Method]struct{}{
+		"user": {ImportKeygroup: {}},
+	}}
+
+	h := &ExtHandler{n: n, a: newAuthService(n, nil)}
+
+	var result ImportResult
+
+	err := h.restoreReplicas("user", KeygroupArchive{
+		Keygroup: Keygroup{Name: "importkg"},
+		Expiries: map[NodeID]int{"X": 0, "Y": 0},
+	}, &result)
+	assert.NoError(t, err)
+
+	// Y is part of the cluster, but the user cannot add it
+	assert.Empty(t, result.Replicas)
+	assert.Equal(t, []NodeID{"Y"}, result.Skipped)
+}
+
+// importQuotaNaSe is a NameService with an existing mutable keygroup that has a quota and that the user may import.
+type importQuotaNaSe struct {
+	quotaNaSe
+}
+
+func (n *importQuotaNaSe) ExistsKeygroup(_ KeygroupName) (bool, error) {
+	return true, nil
+}
+
+func (n *importQuotaNaSe) GetUserPermissions(_ string, _ KeygroupName) (map[Method]struct{}, error) {
+	return map[Method]struct{}{ImportKeygroup: {}}, nil
+}
+
+func (n *importQuotaNaSe) IsMutable(_ KeygroupName) (bool, error) {
+	return true, nil
+}
+
+func (n *importQuotaNaSe) GetKeygroupType(_ KeygroupName) (KeygroupType, error) {
+	return Plain, nil
+}
+
+func (n *importQuotaNaSe) IsReadOnly(_ KeygroupName) (bool, error) {
+	return false, nil
+}
+
+func TestImportQuota(t *testing.T) {
+	kg := KeygroupName("importkg")
+
+	store := badgerdb.NewMemory()
+	t.Cleanup(func() { _ = store.Close() })
+
+	s := newStoreService(store, "X")
+	assert.NoError(t, s.createKeygroup(kg))
+
+	n := &importQuotaNaSe{quotaNaSe{quotas: map[KeygroupName]Quota{
+		kg: {MaxItems: 1},
+	}}}
+
+	q := newQuotaService(s, n)
+
+	h := &ExtHandler{s: s, q: q, a: newAuthService(n, nil), n: n}
+
+	pages := [][]Item{{
+		{ID: "a", Val: "a", Version: vclock.VClock{"A": 1}},
+		{ID: "b", Val: "b", Version: vclock.VClock{"A": 1}},
+	}}
+
+	var qerr *QuotaError
+	_, err := h.HandleImportKeygroup("user", KeygroupArchive{
+		Keygroup: Keygroup{Name: kg, Mutable: true, Type: Plain},
+	}, true, func() ([]Item, error) {
+		if len(pages) == 0 {
+			return nil, nil
+		}
+
+		p := pages[0]
+		pages = pages[1:]
+		return p, nil
+	})
+	assert.ErrorAs(t, err, &qerr)
+
+	items, err := s.readPage(kg, "", transferPageSize)
+	assert.NoError(t, err)
+	assert.Empty(t, items)
+	assert.Equal(t, Usage{}, q.get(kg).pending)
+}
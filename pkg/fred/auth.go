@@ -7,13 +7,24 @@ import (
 )
 
 type authService struct {
-	n NameService
+	n      NameService
+	admins map[string]struct{}
 }
 
-// newAuthService creates a new authorization service.
-func newAuthService(n NameService) *authService {
+// newAuthService creates a new authorization service. Admins are the users that may administer the cluster itself,
+// e.g., decommission nodes.
+func newAuthService(n NameService, admins []string) *authService {
+	a := make(map[string]struct{}, len(admins))
+
+	for _, u := range admins {
+		if u != "" {
+			a[u] = struct{}{}
+		}
+	}
+
 	return &authService{
-		n: n,
+		n:      n,
+		admins: a,
 	}
 }
 
@@ -72,3 +83,10 @@ func (a *authService) isAllowed(u string, m Method, k KeygroupName) (bool, error
 	return ok, nil
 
 }
+
+// isAdmin returns whether a user may administer the cluster.
+func (a *authService) isAdmin(u string) bool {
+	_, ok := a.admins[u]
+	log.Debug().Msgf("checking if user %s is an admin of the cluster: %t", u, ok)
+	return ok
+}
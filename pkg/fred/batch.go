@@ -0,0 +1,291 @@
+package fred
+
+import (
+	"sort"
+	"sync"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/vector"
+	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/go-errors/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// maxBatchSize is the maximum number of items that can be written in one batch.
+const maxBatchSize = 100
+
+// checkBatch checks that a batch of items is not empty or too large and that every id appears only once.
+func checkBatch(kg KeygroupName, items []Item) error {
+	if len(items) == 0 {
+		return errors.Errorf("batch for keygroup %s is empty", kg)
+	}
+
+	if len(items) > maxBatchSize {
+		return errors.Errorf("batch for keygroup %s has %d items but may have at most %d", kg, len(items), maxBatchSize)
+	}
+
+	ids := make(map[string]struct{}, len(items))
+
+	for _, i := range items {
+		if i.Keygroup != kg {
+			return errors.Errorf("item %s in batch for keygroup %s belongs to keygroup %s", i.ID, kg, i.Keygroup)
+		}
+
+		if _, ok := ids[i.ID]; ok {
+			return errors.Errorf("item %s appears more than once in batch for keygroup %s", i.ID, kg)
+		}
+
+		ids[i.ID] = struct{}{}
+	}
+
+	return checkItem(items...)
+}
+
+// lockClocks locks the cached clocks for all given items of a keygroup, creating them where necessary. Clocks are
+// always locked in the order of their ids so that concurrent batches cannot deadlock. The caller has to hold the read
+// locks on the vCache and the keygroup cache and has to call the returned function to unlock the clocks again.
+func (s *storeService) lockClocks(kg KeygroupName, items []Item) ([]*cachedClock, func()) {
+	kc := s.vCache[kg]
+
+	clocks := make([]*cachedClock, len(items))
+	order := make([]int, len(items))
+
+	for j, i := range items {
+		if _, ok := kc.clocks[i.ID]; !ok {
+			kc.RUnlock()
+			kc.Lock()
+			if _, ok := kc.clocks[i.ID]; !ok {
+				kc.clocks[i.ID] = &cachedClock{
+					clocks: []vclock.VClock{},
+					Mutex:  &sync.Mutex{},
+				}
+			}
+			kc.Unlock()
+			kc.RLock()
+		}
+
+		clocks[j] = kc.clocks[i.ID]
+		order[j] = j
+	}
+
+	sort.Slice(order, func(a, b int) bool {
+		return items[order[a]].ID < items[order[b]].ID
+	})
+
+	for _, j := range order {
+		clocks[j].Lock()
+	}
+
+	return clocks, func() {
+		for _, j := range order {
+			clocks[j].Unlock()
+		}
+	}
+}
+
+// batch updates or tombstones several items of a keygroup atomically. Like update and tombstone, this increments the
+// local counter of each item's version. expiry is the expiry of the keygroup on this node, items with a shorter TTL
+// expire earlier. It returns the new versions in the order of the items.
+func (s *storeService) batch(kg KeygroupName, items []Item, expiry int) ([]vclock.VClock, error) {
+	if err := checkBatch(kg, items); err != nil {
+		return nil, err
+	}
+
+	if !s.iS.ExistsKeygroup(string(kg)) {
+		return nil, errors.Errorf("no such keygroup in store: %+v", kg)
+	}
+
+	s.vCacheLock.RLock()
+	defer s.vCacheLock.RUnlock()
+
+	if _, ok := s.vCache[kg]; !ok {
+		return nil, errors.Errorf("no version cache for keygroup %+v", kg)
+	}
+
+	s.vCache[kg].RLock()
+	defer s.vCache[kg].RUnlock()
+
+	clocks, unlock := s.lockClocks(kg, items)
+	defer unlock()
+
+	ids := make([]string, len(items))
+	vals := make([]string, len(items))
+	tombstones := make([]bool, len(items))
+	expiries := make([]int, len(items))
+	versions := make([]vclock.VClock, len(items))
+	replaced := make([][]vclock.VClock, len(items))
+
+	for j, i := range items {
+		oldVersion := clocks[j].purged.Copy()
+		replaced[j] = make([]vclock.VClock, len(clocks[j].clocks))
+
+		for k, c := range clocks[j].clocks {
+			oldVersion.Merge(c)
+			replaced[j][k] = c.Copy()
+		}
+
+		versions[j] = oldVersion.Copy()
+		versions[j].Tick(s.id)
+
+		ids[j] = i.ID
+		vals[j] = i.Val
+		tombstones[j] = i.Tombstoned
+		expiries[j] = expiryFor(i, expiry)
+
+		// tombstones never expire, just like in tombstone
+		if i.Tombstoned {
+			vals[j] = ""
+			expiries[j] = 0
+		}
+	}
+
+	err := s.iS.UpdateBatch(string(kg), ids, vals, tombstones, expiries, versions, replaced)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]vclock.VClock, len(items))
+
+	for j := range items {
+		clocks[j].clocks = []vclock.VClock{versions[j]}
+		clocks[j].setTimestamp(versions[j], items[j].Timestamp)
+		result[j] = versions[j].Copy()
+		s.reindex(kg, items[j].ID)
+	}
+
+	return result, nil
+}
+
+// mergeVersion decides what happens to the known versions of an item when a remote version arrives, following the same
+// rules as addVersion: if a known version is newer than the remote version, the remote version is discarded, and if it
+// is equal, equal is set so that the values can be reconciled. Otherwise, all known versions that are older than the
+// remote version are replaced and the rest is kept.
+func mergeVersion(local []vclock.VClock, remote vclock.VClock) (keep []vclock.VClock, replaced []vclock.VClock, discard bool, equal bool) {
+	for _, l := range local {
+		switch l.Order(remote) {
+		case vclock.Ancestor:
+			log.Debug().Msgf("%s is an ancestor of %s: discarding version", vector.SortedVCString(remote), vector.SortedVCString(l))
+			return nil, nil, true, false
+		case vclock.Equal:
+			log.Debug().Msgf("%s is equal to %s", vector.SortedVCString(remote), vector.SortedVCString(l))
+			return nil, nil, true, true
+		case vclock.Descendant:
+			replaced = append(replaced, l)
+		default:
+			keep = append(keep, l)
+		}
+	}
+
+	return keep, replaced, false, false
+}
+
+// addVersions stores versions of several items of a keygroup that we got from another replica atomically. Versions that
+// are older than the versions we have are skipped, and versions that we already know only change our value if it
+// differs, as in addVersion. Like for batch, items with a TTL that is shorter than the expiry of the keygroup expire
+// earlier. Returns the items as they were stored, with conflicts resolved.
+func (s *storeService) addVersions(kg KeygroupName, items []Item, expiry int, p ConflictPolicy) ([]Item, error) {
+	if err := checkBatch(kg, items); err != nil {
+		return nil, err
+	}
+
+	if !s.iS.ExistsKeygroup(string(kg)) {
+		return nil, errors.Errorf("no such keygroup in store: %+v", kg)
+	}
+
+	s.vCacheLock.RLock()
+	defer s.vCacheLock.RUnlock()
+
+	if _, ok := s.vCache[kg]; !ok {
+		return nil, errors.Errorf("no version cache for keygroup %+v", kg)
+	}
+
+	s.vCache[kg].RLock()
+	defer s.vCache[kg].RUnlock()
+
+	clocks, unlock := s.lockClocks(kg, items)
+	defer unlock()
+
+	var ids, vals []string
+	var tombstones []bool
+	var expiries []int
+	var versions []vclock.VClock
+	var replaced [][]vclock.VClock
+	var updated []int
+	var equal []int
+	var newClocks [][]vclock.VClock
+
+	for j, i := range items {
+		keep, r, discard, eq := mergeVersion(clocks[j].clocks, i.Version)
+
+		if eq {
+			equal = append(equal, j)
+		}
+
+		if discard || clocks[j].collected(i.Version) {
+			continue
+		}
+
+		e := expiryFor(i, expiry)
+
+		if i.Tombstoned {
+			e = 0
+		}
+
+		ids = append(ids, i.ID)
+		vals = append(vals, i.Val)
+		tombstones = append(tombstones, i.Tombstoned)
+		expiries = append(expiries, e)
+		versions = append(versions, i.Version)
+		replaced = append(replaced, r)
+		updated = append(updated, j)
+		newClocks = append(newClocks, append(keep, i.Version.Copy()))
+	}
+
+	if len(ids) > 0 {
+		err := s.iS.UpdateBatch(string(kg), ids, vals, tombstones, expiries, versions, replaced)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stored := make([]Item, len(updated), len(updated)+len(equal))
+
+	for k, j := range updated {
+		clocks[j].clocks = newClocks[k]
+		clocks[j].setTimestamp(items[j].Version, items[j].Timestamp)
+		s.clock.observe(items[j].Timestamp)
+
+		// the batch itself is atomic, resolving conflicts afterwards is not but every replica will do it the same way
+		stored[k] = items[j]
+		stored[k].Keygroup = kg
+
+		if r, ok, err := s.resolve(kg, items[j].ID, clocks[j], expiryFor(items[j], expiry), p); err != nil {
+			log.Err(err).Msgf("addVersions: could not resolve conflict for %s in keygroup %s, keeping all versions", items[j].ID, kg)
+		} else if ok {
+			stored[k] = r
+		}
+
+		s.reindex(kg, items[j].ID)
+	}
+
+	// replicas that resolved a conflict in different steps can have different values for the same version
+	for _, j := range equal {
+		i := items[j]
+		i.Keygroup = kg
+
+		r, changed, err := s.reconcileEqual(i, i.Version, clocks[j], expiryFor(i, expiry), p)
+
+		if err != nil {
+			log.Err(err).Msgf("addVersions: could not reconcile equal versions of %s in keygroup %s", i.ID, kg)
+			continue
+		}
+
+		if changed {
+			stored = append(stored, r)
+			s.reindex(kg, i.ID)
+		}
+	}
+
+	return stored, nil
+}
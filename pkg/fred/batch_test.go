@@ -0,0 +1,150 @@
+package fred
+
+import (
+	"testing"
+	"time"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/badgerdb"
+	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatch(t *testing.T) {
+	store := badgerdb.NewMemory()
+	defer store.Close()
+
+	s := newStoreService(store, "X")
+
+	kg := KeygroupName("batchkg")
+
+	assert.NoError(t, s.createKeygroup(kg))
+
+	_, err := s.update(Item{Keygroup: kg, ID: "a", Val: "old"}, 0, nil)
+	assert.NoError(t, err)
+
+	versions, err := s.batch(kg, []Item{
+		{Keygroup: kg, ID: "a", Val: "new"},
+		{Keygroup: kg, ID: "b", Val: "value"},
+	}, 0)
+
+	assert.NoError(t, err)
+	assert.Len(t, versions, 2)
+	assert.True(t, versions[0].Compare(vclock.VClock{"X": 2}, vclock.Equal))
+	assert.True(t, versions[1].Compare(vclock.VClock{"X": 1}, vclock.Equal))
+
+	items, err := s.read(kg, "a")
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "new", items[0].Val)
+
+	// duplicate ids are not allowed in one batch
+	_, err = s.batch(kg, []Item{
+		{Keygroup: kg, ID: "a", Val: "1"},
+		{Keygroup: kg, ID: "a", Val: "2"},
+	}, 0)
+	assert.Error(t, err)
+
+	// remote versions: a newer version of a replaces ours, an old version of b is discarded, c is new
+	stored, err := s.addVersions(kg, []Item{
+		{Keygroup: kg, ID: "a", Val: "remote", Version: vclock.VClock{"X": 2, "Y": 1}},
+		{Keygroup: kg, ID: "b", Val: "stale", Version: vclock.VClock{}},
+		{Keygroup: kg, ID: "c", Val: "", Tombstoned: true, Version: vclock.VClock{"Y": 1}},
+	}, 0, KeepSiblings)
+	assert.NoError(t, err)
+	assert.Len(t, stored, 2)
+
+	items, err = s.read(kg, "a")
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "remote", items[0].Val)
+
+	items, err = s.read(kg, "b")
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "value", items[0].Val)
+
+	items, err = s.read(kg, "c")
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.True(t, items[0].Tombstoned)
+}
+
+func TestAddVersionsEqual(t *testing.T) {
+	store := badgerdb.NewMemory()
+	defer store.Close()
+
+	s := newStoreService(store, "X")
+
+	kg := KeygroupName("batchkg")
+
+	assert.NoError(t, s.createKeygroup(kg))
+
+	version := vclock.VClock{"X": 1, "Y": 1}
+
+	read := func() Item {
+		items, err := s.read(kg, "a")
+		assert.NoError(t, err)
+		assert.Len(t, items, 1)
+		return items[0]
+	}
+
+	stored, err := s.addVersions(kg, []Item{{Keygroup: kg, ID: "a", Val: "x", Version: version, Timestamp: 2}}, 0, LastWriterWins)
+	assert.NoError(t, err)
+	assert.Len(t, stored, 1)
+
+	// another replica resolved the same conflict with a value that was written later
+	stored, err = s.addVersions(kg, []Item{{Keygroup: kg, ID: "a", Val: "y", Version: version, Timestamp: 3}}, 0, LastWriterWins)
+	assert.NoError(t, err)
+	assert.Len(t, stored, 1)
+	assert.Equal(t, "y", stored[0].Val)
+	assert.Equal(t, "y", read().Val)
+
+	// an earlier value and the same value change nothing
+	stored, err = s.addVersions(kg, []Item{{Keygroup: kg, ID: "a", Val: "z", Version: version, Timestamp: 1}}, 0, LastWriterWins)
+	assert.NoError(t, err)
+	assert.Empty(t, stored)
+
+	stored, err = s.addVersions(kg, []Item{{Keygroup: kg, ID: "a", Val: "y", Version: version, Timestamp: 3}}, 0, LastWriterWins)
+	assert.NoError(t, err)
+	assert.Empty(t, stored)
+	assert.Equal(t, "y", read().Val)
+	assert.Equal(t, version, read().Version)
+}
+
+func TestBatchTTL(t *testing.T) {
+	store := badgerdb.NewMemory()
+	defer store.Close()
+
+	s := newStoreService(store, "X")
+
+	kg := KeygroupName("batchttlkg")
+
+	assert.NoError(t, s.createKeygroup(kg))
+
+	// the keygroup does not expire, only the item with a TTL does
+	_, err := s.batch(kg, []Item{
+		{Keygroup: kg, ID: "short", Val: "short", TTL: 1},
+		{Keygroup: kg, ID: "long", Val: "long"},
+	}, 0)
+	assert.NoError(t, err)
+
+	_, err = s.addVersions(kg, []Item{
+		{Keygroup: kg, ID: "remoteshort", Val: "short", TTL: 1, Version: vclock.VClock{"Y": 1}},
+		{Keygroup: kg, ID: "remotelong", Val: "long", Version: vclock.VClock{"Y": 1}},
+	}, 0, KeepSiblings)
+	assert.NoError(t, err)
+
+	time.Sleep(2 * time.Second)
+
+	for _, id := range []string{"short", "remoteshort"} {
+		items, err := s.read(kg, id)
+		assert.NoError(t, err)
+		assert.Empty(t, items, id)
+	}
+
+	for _, id := range []string{"long", "remotelong"} {
+		items, err := s.read(kg, id)
+		assert.NoError(t, err)
+		assert.Len(t, items, 1, id)
+	}
+}
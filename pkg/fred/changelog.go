@@ -0,0 +1,325 @@
+package fred
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/go-errors/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// ChangeLog is an interface for a persistent, append-only log of the changes to each keygroup on this node. Every
+// change gets a sequence number that is one more than that of the change before it, starting at 1, and sequence
+// numbers are never reused.
+type ChangeLog interface {
+	// Append Needs: keygroup, change; Returns: sequence number of the change
+	Append(kg string, change []byte) (uint64, error)
+	// Read Needs: keygroup, sequence number, maximum number of changes; Returns: sequence numbers and changes starting
+	// at that sequence number, in order
+	Read(kg string, from uint64, count int) ([]uint64, [][]byte, error)
+	// Bounds Needs: keygroup; Returns: sequence numbers of the first and the last change in the log. If there are
+	// none, first is one more than the last sequence number that was ever assigned.
+	Bounds(kg string) (uint64, uint64, error)
+	// Truncate Needs: keygroup, sequence number; removes all changes before that sequence number
+	Truncate(kg string, before uint64) error
+	// SetCursor Needs: keygroup, consumer, sequence number; stores the position of a consumer of the changes
+	SetCursor(kg string, consumer string, seq uint64) error
+	// GetCursor Needs: keygroup, consumer; Returns: stored position of the consumer, 0 if there is none
+	GetCursor(kg string, consumer string) (uint64, error)
+	// Keygroups Returns: all keygroups that changes have ever been logged for
+	Keygroups() ([]string, error)
+	// Close indicates that the underlying change log should be closed as it is no longer needed.
+	Close() error
+}
+
+// Change is a change to an item that was applied on this node.
+type Change struct {
+	Seq        uint64 `json:"-"`
+	Keygroup   KeygroupName
+	ID         string
+	Val        string
+	Version    vclock.VClock
+	Tombstoned bool
+	Append     bool
+	// Origin is the node that the change was made on or, for changes that another node passes on, the node that sent
+	// it. It is empty for changes from nodes that don't tell.
+	Origin NodeID
+	// Time is when the change was applied on this node.
+	Time time.Time
+}
+
+// TruncatedError is returned when changes are requested that have already been removed from the change log.
+type TruncatedError struct {
+	Keygroup KeygroupName
+	From     uint64
+	First    uint64
+}
+
+func (e *TruncatedError) Error() string {
+	return fmt.Sprintf("changes of keygroup %s from %d have been removed, the first change is %d", e.Keygroup, e.From, e.First)
+}
+
+const (
+	// changeLogPageSize is the number of changes that are read from the change log at once.
+	changeLogPageSize = 100
+	// changeLogRetentionInterval is how often changes that exceed the retention limits are removed.
+	changeLogRetentionInterval = 1 * time.Minute
+)
+
+// changeLogService records the changes that are applied on this node and streams them to consumers. Changes are kept
+// until there are more than the given number of entries of a keygroup or until they are older than the window, 0
+// means no limit.
+type changeLogService struct {
+	l       ChangeLog
+	id      NodeID
+	entries uint64
+	window  time.Duration
+
+	lock   sync.Mutex
+	notify map[KeygroupName]chan struct{}
+}
+
+// newChangeLogService creates a new changeLogService. If there is no change log, changes are not recorded.
+func newChangeLogService(l ChangeLog, id NodeID, entries uint64, window time.Duration) *changeLogService {
+	return &changeLogService{
+		l:       l,
+		id:      id,
+		entries: entries,
+		window:  window,
+		notify:  make(map[KeygroupName]chan struct{}),
+	}
+}
+
+// enabled returns whether this node has a change log.
+func (c *changeLogService) enabled() bool {
+	return c.l != nil
+}
+
+// record adds a change to the log of its keygroup. Changes that are made on this node have this node as their origin.
+// The change has already been applied when it is recorded, so callers only log errors and carry on with replicating it.
+func (c *changeLogService) record(i Item, appended bool, origin NodeID) error {
+	if !c.enabled() {
+		return nil
+	}
+
+	b, err := json.Marshal(Change{
+		Keygroup:   i.Keygroup,
+		ID:         i.ID,
+		Val:        i.Val,
+		Version:    i.Version,
+		Tombstoned: i.Tombstoned,
+		Append:     appended,
+		Origin:     origin,
+		Time:       time.Now(),
+	})
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	if _, err := c.l.Append(string(i.Keygroup), b); err != nil {
+		return errors.New(err)
+	}
+
+	c.wake(i.Keygroup)
+
+	return nil
+}
+
+// wake tells all readers of a keygroup that there are new changes.
+func (c *changeLogService) wake(kg KeygroupName) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if ch, ok := c.notify[kg]; ok {
+		close(ch)
+		delete(c.notify, kg)
+	}
+}
+
+// wait returns a channel that is closed once there are new changes of a keygroup.
+func (c *changeLogService) wait(kg KeygroupName) <-chan struct{} {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	ch, ok := c.notify[kg]
+
+	if !ok {
+		ch = make(chan struct{})
+		c.notify[kg] = ch
+	}
+
+	return ch
+}
+
+// read sends all changes of a keygroup starting at the given sequence number to send, and then every new change
+// until done is closed. A sequence number of 0 starts at the first change in the log. If changes that would have to
+// be sent have been removed, a *TruncatedError is returned.
+func (c *changeLogService) read(kg KeygroupName, from uint64, done <-chan struct{}, send func(Change) error) error {
+	if !c.enabled() {
+		return errors.Errorf("this node has no change log")
+	}
+
+	first, _, err := c.l.Bounds(string(kg))
+
+	if err != nil {
+		return err
+	}
+
+	if from == 0 {
+		from = first
+	}
+
+	for {
+		// we get the channel before reading so that no change can slip through in between
+		next := c.wait(kg)
+
+		seqs, changes, err := c.l.Read(string(kg), from, changeLogPageSize)
+
+		if err != nil {
+			return err
+		}
+
+		if len(seqs) > 0 && seqs[0] != from {
+			return errors.New(&TruncatedError{
+				Keygroup: kg,
+				From:     from,
+				First:    seqs[0],
+			})
+		}
+
+		if len(seqs) == 0 {
+			if first, _, err = c.l.Bounds(string(kg)); err != nil {
+				return err
+			}
+
+			if first > from {
+				return errors.New(&TruncatedError{
+					Keygroup: kg,
+					From:     from,
+					First:    first,
+				})
+			}
+
+			select {
+			case <-done:
+				return nil
+			case <-next:
+			}
+
+			continue
+		}
+
+		for j, b := range changes {
+			var ch Change
+
+			if err := json.Unmarshal(b, &ch); err != nil {
+				return errors.New(err)
+			}
+
+			ch.Seq = seqs[j]
+
+			if err := send(ch); err != nil {
+				return errors.New(err)
+			}
+		}
+
+		from = seqs[len(seqs)-1] + 1
+	}
+}
+
+// cursor returns the stored position of a consumer of the changes of a keygroup.
+func (c *changeLogService) cursor(kg KeygroupName, consumer string) (uint64, error) {
+	if !c.enabled() {
+		return 0, errors.Errorf("this node has no change log")
+	}
+
+	return c.l.GetCursor(string(kg), consumer)
+}
+
+// setCursor stores the position of a consumer of the changes of a keygroup.
+func (c *changeLogService) setCursor(kg KeygroupName, consumer string, seq uint64) error {
+	if !c.enabled() {
+		return errors.Errorf("this node has no change log")
+	}
+
+	return c.l.SetCursor(string(kg), consumer, seq)
+}
+
+// runRetention removes changes that exceed the retention limits every interval.
+func (c *changeLogService) runRetention(interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for range t.C {
+		kgs, err := c.l.Keygroups()
+
+		if err != nil {
+			log.Err(err).Msg("runRetention from changelog: could not get keygroups")
+			continue
+		}
+
+		for _, kg := range kgs {
+			if err := c.retain(KeygroupName(kg)); err != nil {
+				log.Err(err).Msgf("runRetention from changelog: could not remove old changes of keygroup %s", kg)
+			}
+		}
+	}
+}
+
+// retain removes the changes of a keygroup that exceed the retention limits.
+func (c *changeLogService) retain(kg KeygroupName) error {
+	first, last, err := c.l.Bounds(string(kg))
+
+	if err != nil {
+		return err
+	}
+
+	before := first
+
+	if c.entries > 0 && last >= c.entries && last-c.entries+1 > before {
+		before = last - c.entries + 1
+	}
+
+	if c.window > 0 {
+		limit := time.Now().Add(-c.window)
+
+	pages:
+		for {
+			seqs, changes, err := c.l.Read(string(kg), before, changeLogPageSize)
+
+			if err != nil {
+				return err
+			}
+
+			if len(seqs) == 0 {
+				break
+			}
+
+			for j, b := range changes {
+				var ch Change
+
+				if err := json.Unmarshal(b, &ch); err != nil {
+					return errors.New(err)
+				}
+
+				if ch.Time.After(limit) {
+					break pages
+				}
+
+				before = seqs[j] + 1
+			}
+		}
+	}
+
+	if before <= first {
+		return nil
+	}
+
+	log.Debug().Msgf("retain from changelog: removing changes of keygroup %s before %d", kg, before)
+
+	return c.l.Truncate(string(kg), before)
+}
@@ -0,0 +1,97 @@
+package fred
+
+import (
+	"testing"
+	"time"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/badgerdb"
+	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangeLogService(t *testing.T) {
+	l := badgerdb.NewMemoryChangeLog()
+	t.Cleanup(func() { _ = l.Close() })
+
+	c := newChangeLogService(l, "X", 3, 0)
+
+	assert.NoError(t, c.record(Item{Keygroup: "kg", ID: "a", Val: "1", Version: vclock.VClock{"X": 1}}, false, "X"))
+	assert.NoError(t, c.record(Item{Keygroup: "kg", ID: "a", Val: "2", Version: vclock.VClock{"X": 1, "Y": 1}}, false, "Y"))
+	assert.NoError(t, c.record(Item{Keygroup: "kg", ID: "b", Val: "3", Version: vclock.VClock{"X": 2}}, true, "X"))
+	assert.NoError(t, c.record(Item{Keygroup: "kg", ID: "a", Version: vclock.VClock{"X": 3, "Y": 1}, Tombstoned: true}, false, "X"))
+
+	// reading starts at the first change and follows new ones until done is closed
+	done := make(chan struct{})
+	changes := make([]Change, 0)
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		assert.NoError(t, c.record(Item{Keygroup: "kg", ID: "c", Val: "5", Version: vclock.VClock{"X": 4}}, false, "X"))
+	}()
+
+	err := c.read("kg", 0, done, func(ch Change) error {
+		changes = append(changes, ch)
+
+		if len(changes) == 5 {
+			close(done)
+		}
+
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, changes, 5)
+
+	for j, ch := range changes {
+		assert.Equal(t, uint64(j+1), ch.Seq)
+		assert.Equal(t, KeygroupName("kg"), ch.Keygroup)
+	}
+
+	assert.Equal(t, NodeID("Y"), changes[1].Origin)
+	assert.Equal(t, vclock.VClock{"X": 1, "Y": 1}, changes[1].Version)
+	assert.True(t, changes[2].Append)
+	assert.True(t, changes[3].Tombstoned)
+	assert.Equal(t, "c", changes[4].ID)
+
+	// only the last three changes are kept
+	assert.NoError(t, c.retain("kg"))
+
+	first, last, err := l.Bounds("kg")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), first)
+	assert.Equal(t, uint64(5), last)
+
+	done = make(chan struct{})
+	close(done)
+
+	err = c.read("kg", 1, done, func(ch Change) error {
+		return nil
+	})
+
+	var terr *TruncatedError
+	assert.ErrorAs(t, err, &terr)
+	assert.Equal(t, uint64(3), terr.First)
+
+	// changes that are older than the window are removed as well
+	c.window = time.Nanosecond
+	assert.NoError(t, c.retain("kg"))
+
+	first, last, err = l.Bounds("kg")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(6), first)
+	assert.Equal(t, uint64(5), last)
+
+	assert.NoError(t, c.setCursor("kg", "consumer", 4))
+
+	seq, err := c.cursor("kg", "consumer")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(4), seq)
+}
+
+func TestChangeLogServiceDisabled(t *testing.T) {
+	c := newChangeLogService(nil, "X", 0, 0)
+
+	assert.False(t, c.enabled())
+	assert.NoError(t, c.record(Item{Keygroup: "kg", ID: "a", Val: "1"}, false, "X"))
+	assert.Error(t, c.read("kg", 0, nil, func(ch Change) error { return nil }))
+}
@@ -0,0 +1,307 @@
+package fred
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/go-errors/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// cloneKeygroup copies the local copy of a keygroup into a new keygroup in the store: all versions of all items and the
+// triggers. The configuration of the new keygroup has to be in the NaSe already. It is safe to clone a keygroup twice,
+// e.g., when a clone is retried, as versions that we already have are skipped.
+func (s *storeService) cloneKeygroup(src KeygroupName, dst Keygroup, policy ConflictPolicy) error {
+	if !s.existsKeygroup(src) {
+		return errors.Errorf("no such keygroup in store: %+v", src)
+	}
+
+	if !s.existsKeygroup(dst.Name) {
+		if err := s.createKeygroup(dst.Name); err != nil {
+			return err
+		}
+	}
+
+	if err := s.setHistory(dst.Name, dst.History); err != nil {
+		return err
+	}
+
+	if err := s.setIndexes(dst.Name, dst.Indexes); err != nil {
+		return err
+	}
+
+	triggers, err := s.getKeygroupTrigger(src)
+
+	if err != nil {
+		return err
+	}
+
+	for _, t := range triggers {
+		if err := s.addKeygroupTrigger(dst.Name, t); err != nil {
+			return err
+		}
+	}
+
+	after := ""
+
+	for {
+		items, err := s.readPage(src, after, transferPageSize)
+
+		if err != nil {
+			return err
+		}
+
+		if len(items) == 0 {
+			return nil
+		}
+
+		for _, i := range items {
+			i.Keygroup = dst.Name
+
+			if dst.Mutable {
+				_, err = s.addVersion(i, i.Version, dst.Expiry, policy)
+			} else if !s.exists(i) {
+				err = s.append(i, dst.Expiry)
+			}
+
+			if err != nil {
+				return err
+			}
+		}
+
+		after = items[len(items)-1].ID
+	}
+}
+
+// keygroupConfig reads the configuration of a keygroup from the NaSe, the expiry is the one of this node.
+func (s *replicationService) keygroupConfig(kg KeygroupName) (Keygroup, error) {
+	k := Keygroup{Name: kg}
+
+	var err error
+
+	if k.Mutable, err = s.n.IsMutable(kg); err != nil {
+		return k, err
+	}
+
+	if k.Expiry, err = s.n.GetExpiry(kg); err != nil {
+		return k, err
+	}
+
+	if k.Indexes, err = s.n.GetKeygroupIndexes(kg); err != nil {
+		return k, err
+	}
+
+	if k.ConflictPolicy, err = s.n.GetConflictPolicy(kg); err != nil {
+		return k, err
+	}
+
+	if k.Type, err = s.n.GetKeygroupType(kg); err != nil {
+		return k, err
+	}
+
+	if k.History, err = s.n.GetKeygroupHistory(kg); err != nil {
+		return k, err
+	}
+
+	if k.Quota, err = s.n.GetKeygroupQuota(kg); err != nil {
+		return k, err
+	}
+
+	if k.Compression, err = s.n.GetKeygroupCompression(kg); err != nil {
+		return k, err
+	}
+
+	if k.ReplicationPolicy, err = s.n.GetKeygroupReplicationPolicy(kg); err != nil {
+		return k, err
+	}
+
+	return k, nil
+}
+
+// cloneKeygroup creates a copy of a keygroup with another name with the NaSe and tells every replica of the copy to
+// fill it. replicas maps the replicas of the copy to their expiry, if it is empty, the copy has the same replicas as the
+// keygroup. This node is always a replica of the copy. Replicas of the keygroup copy their own data and triggers, other
+// replicas are added to the copy like new replicas and get the data from us. Finally, all users get the same
+// permissions on the copy as on the keygroup. If the copy cannot be filled, it is deleted again so that the clone can be
+// retried.
+func (s *replicationService) cloneKeygroup(src KeygroupName, dst KeygroupName, replicas map[NodeID]int) error {
+	log.Debug().Msgf("cloneKeygroup from replservice: in src=%s dst=%s replicas=%+v", src, dst, replicas)
+
+	if !s.s.existsKeygroup(src) {
+		return errors.Errorf("keygroup %s has no replica on this node", src)
+	}
+
+	members, err := s.n.GetKeygroupMembers(src, false)
+
+	if err != nil {
+		return err
+	}
+
+	if len(replicas) == 0 {
+		replicas = members
+	}
+
+	k, err := s.keygroupConfig(src)
+
+	if err != nil {
+		return err
+	}
+
+	k.Name = dst
+
+	self := s.n.GetNodeID()
+
+	if e, ok := replicas[self]; ok {
+		k.Expiry = e
+	}
+
+	// makes sure that the copy does not exist yet
+	if err := s.createKeygroup(k); err != nil {
+		return err
+	}
+
+	if err := s.fillClone(src, k, members, replicas); err != nil {
+		s.rollbackClone(dst)
+		return err
+	}
+
+	return nil
+}
+
+// fillClone fills a new copy of a keygroup on all of its replicas and gives all users the same permissions on it.
+func (s *replicationService) fillClone(src KeygroupName, k Keygroup, members map[NodeID]int, replicas map[NodeID]int) error {
+	dst := k.Name
+	self := s.n.GetNodeID()
+
+	if err := s.s.cloneKeygroup(src, k, k.ConflictPolicy); err != nil {
+		return err
+	}
+
+	ids := make([]NodeID, 0, len(replicas))
+
+	for id := range replicas {
+		if id != self {
+			ids = append(ids, id)
+		}
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(ids))
+
+	for j, id := range ids {
+		if _, ok := members[id]; !ok {
+			continue
+		}
+
+		addr, err := s.n.GetNodeAddress(id)
+
+		if err != nil {
+			return err
+		}
+
+		if err := s.n.JoinNodeIntoKeygroup(dst, id, replicas[id]); err != nil {
+			return err
+		}
+
+		wg.Add(1)
+		go func(j int, id NodeID, addr string) {
+			defer wg.Done()
+
+			log.Debug().Msgf("cloneKeygroup from replservice: asking %s to clone %s into %s", id, src, dst)
+
+			if err := s.c.SendCloneKeygroup(addr, src, dst); err != nil {
+				errs[j] = err
+			}
+		}(j, id, addr)
+	}
+
+	wg.Wait()
+
+	for j, err := range errs {
+		if err != nil {
+			log.Err(err).Msgf("cloneKeygroup from replservice: %s could not clone %s into %s", ids[j], src, dst)
+			return err
+		}
+	}
+
+	// replicas that don't have the keygroup get the copy from us
+	for _, id := range ids {
+		if _, ok := members[id]; ok {
+			continue
+		}
+
+		if err := s.addReplica(Keygroup{Name: dst, Expiry: replicas[id]}, Node{ID: id}); err != nil {
+			return err
+		}
+	}
+
+	permissions, err := s.n.GetKeygroupPermissions(src)
+
+	if err != nil {
+		return err
+	}
+
+	for u, p := range permissions {
+		for m := range p {
+			if err := s.n.AddUserPermissions(u, m, dst); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// rollbackClone deletes a copy of a keygroup that could not be filled on this node, on the replicas that joined it so
+// far, and with the NaSe.
+func (s *replicationService) rollbackClone(dst KeygroupName) {
+	if s.s.existsKeygroup(dst) {
+		if err := s.s.deleteKeygroup(dst); err != nil {
+			log.Err(err).Msgf("cloneKeygroup from replservice: could not delete %s", dst)
+		}
+	}
+
+	if err := s.relayDeleteKeygroup(Keygroup{Name: dst}); err != nil {
+		log.Err(err).Msgf("cloneKeygroup from replservice: could not delete %s on its replicas", dst)
+	}
+}
+
+// renameKeygroup renames a keygroup: every replica copies its data into a keygroup with the new name, and then the
+// keygroup is deleted on all replicas. Clients cannot write to the keygroup while it is copied, so the copy has all of
+// its items. If the copy fails, the keygroup is writable again.
+func (s *replicationService) renameKeygroup(src KeygroupName, dst KeygroupName) error {
+	log.Debug().Msgf("renameKeygroup from replservice: in src=%s dst=%s", src, dst)
+
+	r, err := s.n.IsReadOnly(src)
+
+	if err != nil {
+		return err
+	}
+
+	if r {
+		return errors.Errorf("keygroup %s is already being renamed", src)
+	}
+
+	if err := s.n.SetKeygroupReadOnly(src, true); err != nil {
+		return err
+	}
+
+	if err := s.cloneKeygroup(src, dst, nil); err != nil {
+		if err := s.n.SetKeygroupReadOnly(src, false); err != nil {
+			log.Err(err).Msgf("renameKeygroup from replservice: could not make %s writable again", src)
+		}
+
+		return err
+	}
+
+	// the keygroup is only deleted once the copy is complete
+	if err := s.s.deleteKeygroup(src); err != nil {
+		return err
+	}
+
+	return s.relayDeleteKeygroup(Keygroup{Name: src})
+}
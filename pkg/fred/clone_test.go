@@ -0,0 +1,275 @@
+package fred
+
+import (
+	"testing"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/badgerdb"
+	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/go-errors/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloneKeygroup(t *testing.T) {
+	src := KeygroupName("clonesrc")
+	dst := Keygroup{Name: "clonedst", Mutable: true}
+
+	store := badgerdb.NewMemory()
+	t.Cleanup(func() { _ = store.Close() })
+
+	s := newStoreService(store, "X")
+	assert.NoError(t, s.createKeygroup(src))
+	assert.NoError(t, s.addKeygroupTrigger(src, Trigger{ID: "t", Host: "localhost:3333"}))
+
+	_, err := s.update(Item{Keygroup: src, ID: "a", Val: "a"}, 0, nil)
+	assert.NoError(t, err)
+
+	// b has two concurrent versions
+	_, err = s.addVersion(Item{Keygroup: src, ID: "b", Val: "b1"}, vclock.VClock{"Y": 1}, 0, KeepSiblings)
+	assert.NoError(t, err)
+	_, err = s.addVersion(Item{Keygroup: src, ID: "b", Val: "b2"}, vclock.VClock{"Z": 1}, 0, KeepSiblings)
+	assert.NoError(t, err)
+
+	_, err = s.update(Item{Keygroup: src, ID: "c", Val: "c"}, 0, nil)
+	assert.NoError(t, err)
+
+	_, err = s.tombstone(Item{Keygroup: src, ID: "c"}, nil)
+	assert.NoError(t, err)
+
+	assert.NoError(t, s.cloneKeygroup(src, dst, KeepSiblings))
+
+	// cloning twice does not change anything
+	assert.NoError(t, s.cloneKeygroup(src, dst, KeepSiblings))
+
+	original, err := s.readAll(src)
+	assert.NoError(t, err)
+
+	clone, err := s.readAll(dst.Name)
+	assert.NoError(t, err)
+
+	assert.Len(t, clone, len(original))
+
+	for j := range original {
+		assert.Equal(t, original[j].ID, clone[j].ID)
+		assert.Equal(t, original[j].Val, clone[j].Val)
+		assert.Equal(t, original[j].Version, clone[j].Version)
+		assert.Equal(t, dst.Name, clone[j].Keygroup)
+	}
+
+	items, err := s.read(dst.Name, "b")
+	assert.NoError(t, err)
+	assert.Len(t, items, 2)
+
+	triggers, err := s.getKeygroupTrigger(dst.Name)
+	assert.NoError(t, err)
+	assert.Equal(t, []Trigger{{ID: "t", Host: "localhost:3333"}}, triggers)
+}
+
+// renameNaSe is a NameService that only knows whether keygroups are read-only.
+type renameNaSe struct {
+	NameService
+	readOnly map[KeygroupName]bool
+}
+
+func (n *renameNaSe) IsReadOnly(kg KeygroupName) (bool, error) {
+	return n.readOnly[kg], nil
+}
+
+func (n *renameNaSe) SetKeygroupReadOnly(kg KeygroupName, readOnly bool) error {
+	n.readOnly[kg] = readOnly
+	return nil
+}
+
+func TestRenameKeygroupReadOnly(t *testing.T) {
+	kg := KeygroupName("renamekg")
+
+	store := badgerdb.NewMemory()
+	t.Cleanup(func() { _ = store.Close() })
+
+	n := &renameNaSe{readOnly: map[KeygroupName]bool{}}
+	h := &ExtHandler{n: n}
+	r := newReplicationService(newStoreService(store, "X"), nil, n, nil, nil, false, nil, 0)
+
+	assert.NoError(t, h.checkWritable(kg))
+
+	// a keygroup that is being renamed cannot be written to or renamed again
+	n.readOnly[kg] = true
+	assert.Error(t, h.checkWritable(kg))
+	assert.Error(t, r.renameKeygroup(kg, "renamed"))
+	assert.True(t, n.readOnly[kg])
+
+	// the copy fails as the keygroup has no replica here, so it is writable again
+	n.readOnly[kg] = false
+	assert.Error(t, r.renameKeygroup(kg, "renamed"))
+	assert.False(t, n.readOnly[kg])
+	assert.NoError(t, h.checkWritable(kg))
+}
+
+// cloneNaSe is a NameService that keeps keygroups and their members in memory, keygroups have the default
+// configuration.
+type cloneNaSe struct {
+	NameService
+	members map[KeygroupName]map[NodeID]int
+}
+
+func (n *cloneNaSe) GetNodeID() NodeID {
+	return "X"
+}
+
+func (n *cloneNaSe) GetNodeAddress(nodeID NodeID) (string, error) {
+	return string(nodeID), nil
+}
+
+func (n *cloneNaSe) ExistsKeygroup(kg KeygroupName) (bool, error) {
+	_, ok := n.members[kg]
+	return ok, nil
+}
+
+func (n *cloneNaSe) CreateKeygroup(kg KeygroupName, _ bool, expiry int) error {
+	n.members[kg] = map[NodeID]int{"X": expiry}
+	return nil
+}
+
+func (n *cloneNaSe) DeleteKeygroup(kg KeygroupName) error {
+	delete(n.members, kg)
+	return nil
+}
+
+func (n *cloneNaSe) JoinNodeIntoKeygroup(kg KeygroupName, nodeID NodeID, expiry int) error {
+	n.members[kg][nodeID] = expiry
+	return nil
+}
+
+func (n *cloneNaSe) GetKeygroupMembers(kg KeygroupName, excludeSelf bool) (map[NodeID]int, error) {
+	members := make(map[NodeID]int)
+
+	for id, e := range n.members[kg] {
+		if !excludeSelf || id != "X" {
+			members[id] = e
+		}
+	}
+
+	return members, nil
+}
+
+func (n *cloneNaSe) IsMutable(_ KeygroupName) (bool, error) {
+	return true, nil
+}
+
+func (n *cloneNaSe) GetExpiry(_ KeygroupName) (int, error) {
+	return 0, nil
+}
+
+func (n *cloneNaSe) GetKeygroupIndexes(_ KeygroupName) (map[string]string, error) {
+	return nil, nil
+}
+
+func (n *cloneNaSe) GetConflictPolicy(_ KeygroupName) (ConflictPolicy, error) {
+	return KeepSiblings, nil
+}
+
+func (n *cloneNaSe) GetKeygroupType(_ KeygroupName) (KeygroupType, error) {
+	return Plain, nil
+}
+
+func (n *cloneNaSe) GetKeygroupHistory(_ KeygroupName) (HistoryRetention, error) {
+	return HistoryRetention{}, nil
+}
+
+func (n *cloneNaSe) GetKeygroupQuota(_ KeygroupName) (Quota, error) {
+	return Quota{}, nil
+}
+
+func (n *cloneNaSe) GetKeygroupCompression(_ KeygroupName) (Compression, error) {
+	return NoCompression, nil
+}
+
+func (n *cloneNaSe) GetKeygroupReplicationPolicy(_ KeygroupName) (ReplicationPolicy, error) {
+	return ReplicationPolicy{}, nil
+}
+
+func (n *cloneNaSe) GetKeygroupPermissions(_ KeygroupName) (map[string]map[Method]struct{}, error) {
+	return map[string]map[Method]struct{}{}, nil
+}
+
+func (n *cloneNaSe) SetKeygroupIndexes(_ KeygroupName, _ map[string]string) error {
+	return nil
+}
+
+func (n *cloneNaSe) SetConflictPolicy(_ KeygroupName, _ ConflictPolicy) error {
+	return nil
+}
+
+func (n *cloneNaSe) SetKeygroupType(_ KeygroupName, _ KeygroupType) error {
+	return nil
+}
+
+func (n *cloneNaSe) SetKeygroupHistory(_ KeygroupName, _ HistoryRetention) error {
+	return nil
+}
+
+func (n *cloneNaSe) SetKeygroupQuota(_ KeygroupName, _ Quota) error {
+	return nil
+}
+
+func (n *cloneNaSe) SetKeygroupCompression(_ KeygroupName, _ Compression) error {
+	return nil
+}
+
+func (n *cloneNaSe) SetKeygroupReplicationPolicy(_ KeygroupName, _ ReplicationPolicy) error {
+	return nil
+}
+
+// cloneClient fails to clone keygroups on other nodes if fail is set and remembers which nodes it told to delete a
+// keygroup.
+type cloneClient struct {
+	Client
+	fail    bool
+	deleted []string
+}
+
+func (c *cloneClient) SendCloneKeygroup(_ string, _ KeygroupName, _ KeygroupName) error {
+	if c.fail {
+		return errors.Errorf("cannot clone")
+	}
+
+	return nil
+}
+
+func (c *cloneClient) SendDeleteKeygroup(host string, _ KeygroupName) error {
+	c.deleted = append(c.deleted, host)
+	return nil
+}
+
+func TestCloneKeygroupRollback(t *testing.T) {
+	src := KeygroupName("rollbacksrc")
+	dst := KeygroupName("rollbackdst")
+
+	store := badgerdb.NewMemory()
+	t.Cleanup(func() { _ = store.Close() })
+
+	n := &cloneNaSe{members: map[KeygroupName]map[NodeID]int{src: {"X": 0, "P": 0}}}
+	c := &cloneClient{fail: true}
+	s := newStoreService(store, "X")
+	r := newReplicationService(s, c, n, nil, nil, false, nil, 0)
+
+	assert.NoError(t, s.createKeygroup(src))
+
+	_, err := s.update(Item{Keygroup: src, ID: "a", Val: "a"}, 0, nil)
+	assert.NoError(t, err)
+
+	// P cannot clone the keygroup, so the copy is deleted everywhere
+	assert.Error(t, r.cloneKeygroup(src, dst, nil))
+	assert.False(t, s.existsKeygroup(dst))
+	assert.NotContains(t, n.members, dst)
+	assert.Equal(t, []string{"P"}, c.deleted)
+
+	// which lets the clone be retried
+	c.fail = false
+	assert.NoError(t, r.cloneKeygroup(src, dst, nil))
+	assert.True(t, s.existsKeygroup(dst))
+	assert.Equal(t, map[NodeID]int{"X": 0, "P": 0}, n.members[dst])
+
+	items, err := s.read(dst, "a")
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+}
@@ -0,0 +1,24 @@
+package fred
+
+import "github.com/go-errors/errors"
+
+// Compression is the codec that the values of a keygroup are compressed with, both in the store and when they are sent
+// to other nodes. An empty compression means NoCompression.
+type Compression string
+
+// These are the supported codecs.
+const (
+	NoCompression Compression = "none"
+	Zstd          Compression = "zstd"
+	Snappy        Compression = "snappy"
+)
+
+// checkCompression checks that a compression is known.
+func checkCompression(c Compression) error {
+	switch c {
+	case "", NoCompression, Zstd, Snappy:
+		return nil
+	}
+
+	return errors.Errorf("unknown compression %s", c)
+}
@@ -0,0 +1,451 @@
+package fred
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/vector"
+	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/go-errors/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// ConflictPolicy decides what a replica does when it has concurrent versions of an item.
+type ConflictPolicy string
+
+const (
+	// KeepSiblings keeps all concurrent versions, clients have to resolve the conflict on their next update.
+	KeepSiblings ConflictPolicy = "siblings"
+	// LastWriterWins keeps the version with the latest hybrid timestamp, i.e., the one that was written last. Ties,
+	// e.g., between versions whose timestamps a replica does not know, are broken by NodeTiebreak.
+	LastWriterWins ConflictPolicy = "lww"
+	// NodeTiebreak keeps the version that wins a deterministic comparison of the version vectors: the version with the
+	// larger counter for the greatest node ID in which they differ wins.
+	NodeTiebreak ConflictPolicy = "node"
+	// mergePrefix is the prefix of policies that merge concurrent values with a registered MergeFunc.
+	mergePrefix = "merge:"
+)
+
+// MergeFunc merges two concurrent values of an item. It has to be deterministic, commutative, and associative so that
+// all replicas end up with the same value, no matter in which order they see the versions.
+type MergeFunc func(a string, b string) (string, error)
+
+var (
+	mergeFuncs = map[string]MergeFunc{
+		"union": unionMerge,
+	}
+	mergeFuncsLock sync.RWMutex
+)
+
+// RegisterMergeFunc registers a merge function under a name so that keygroups can use it with MergePolicy. The
+// function has to be registered on all nodes that replicate such a keygroup.
+func RegisterMergeFunc(name string, f MergeFunc) {
+	mergeFuncsLock.Lock()
+	defer mergeFuncsLock.Unlock()
+
+	mergeFuncs[name] = f
+}
+
+// MergePolicy returns the conflict policy that merges concurrent values with the merge function of that name.
+func MergePolicy(name string) ConflictPolicy {
+	return ConflictPolicy(mergePrefix + name)
+}
+
+// mergeFunc returns the merge function of a policy, if it is a merge policy and the function is registered.
+func (p ConflictPolicy) mergeFunc() (MergeFunc, bool) {
+	name, ok := strings.CutPrefix(string(p), mergePrefix)
+
+	if !ok {
+		return nil, false
+	}
+
+	mergeFuncsLock.RLock()
+	defer mergeFuncsLock.RUnlock()
+
+	f, ok := mergeFuncs[name]
+
+	return f, ok
+}
+
+// checkConflictPolicy checks that a conflict policy is known. An empty policy means KeepSiblings.
+func checkConflictPolicy(p ConflictPolicy) error {
+	switch p {
+	case "", KeepSiblings, LastWriterWins, NodeTiebreak:
+		return nil
+	}
+
+	if !strings.HasPrefix(string(p), mergePrefix) {
+		return errors.Errorf("unknown conflict policy %s", p)
+	}
+
+	if _, ok := p.mergeFunc(); !ok {
+		return errors.Errorf("no merge function registered for conflict policy %s", p)
+	}
+
+	return nil
+}
+
+// unionMerge merges two JSON arrays into a sorted array of the distinct elements of both.
+func unionMerge(a string, b string) (string, error) {
+	set := make(map[string]json.RawMessage)
+
+	for _, v := range []string{a, b} {
+		var elems []json.RawMessage
+
+		if err := json.Unmarshal([]byte(v), &elems); err != nil {
+			return "", errors.Errorf("%s is not a JSON array: %s", v, err.Error())
+		}
+
+		for _, e := range elems {
+			k, err := canonicalJSON(string(e))
+
+			if err != nil {
+				return "", err
+			}
+
+			set[k] = json.RawMessage(k)
+		}
+	}
+
+	keys := make([]string, 0, len(set))
+
+	for k := range set {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	union := make([]json.RawMessage, len(keys))
+
+	for i, k := range keys {
+		union[i] = set[k]
+	}
+
+	res, err := json.Marshal(union)
+
+	if err != nil {
+		return "", errors.New(err)
+	}
+
+	return string(res), nil
+}
+
+// hybridClock is a hybrid logical clock. Its timestamps hold the physical time in milliseconds in the upper 48 bits and
+// a logical counter in the lower 16 bits, so they are close to the wall clock but never go backwards and are always
+// larger than any timestamp the node has seen.
+type hybridClock struct {
+	last uint64
+	sync.Mutex
+}
+
+// now returns a new timestamp.
+func (c *hybridClock) now() uint64 {
+	c.Lock()
+	defer c.Unlock()
+
+	t := uint64(time.Now().UnixMilli()) << 16
+
+	if t <= c.last {
+		t = c.last + 1
+	}
+
+	c.last = t
+
+	return t
+}
+
+// observe advances the clock past a timestamp that we have received from another node.
+func (c *hybridClock) observe(t uint64) {
+	c.Lock()
+	defer c.Unlock()
+
+	if t > c.last {
+		c.last = t
+	}
+}
+
+// tiebreak returns whether version a wins over version b when neither is newer: the version with the larger counter
+// for the greatest node ID in which they differ wins.
+func tiebreak(a vclock.VClock, b vclock.VClock) bool {
+	ids := make([]string, 0, len(a)+len(b))
+
+	for id := range a {
+		ids = append(ids, id)
+	}
+
+	for id := range b {
+		if _, ok := a[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+
+	sort.Sort(sort.Reverse(sort.StringSlice(ids)))
+
+	for _, id := range ids {
+		if a[id] != b[id] {
+			return a[id] > b[id]
+		}
+	}
+
+	return false
+}
+
+// timestamp returns the hybrid timestamp of a current version of an item, 0 if we don't know it, e.g., because the
+// version was read from the store after a restart. The lock of the cached clock has to be held.
+func (c *cachedClock) timestamp(v vclock.VClock) uint64 {
+	return c.timestamps[vector.SortedVCString(v)]
+}
+
+// setTimestamp records the hybrid timestamp of a version and forgets the timestamps of versions that are no longer
+// current. The lock of the cached clock has to be held.
+func (c *cachedClock) setTimestamp(v vclock.VClock, t uint64) {
+	timestamps := make(map[string]uint64, len(c.clocks))
+
+	for _, known := range c.clocks {
+		k := vector.SortedVCString(known)
+
+		if old, ok := c.timestamps[k]; ok {
+			timestamps[k] = old
+		}
+	}
+
+	if t > 0 {
+		timestamps[vector.SortedVCString(v)] = t
+	} else {
+		delete(timestamps, vector.SortedVCString(v))
+	}
+
+	c.timestamps = timestamps
+}
+
+// sibling is one of several concurrent versions of an item.
+type sibling struct {
+	val        string
+	tombstoned bool
+	version    vclock.VClock
+	timestamp  uint64
+}
+
+// wins returns whether sibling a wins over sibling b with LastWriterWins or NodeTiebreak. With LastWriterWins, the
+// sibling with the later hybrid timestamp wins, and only siblings with the same timestamp are compared by their
+// versions. Siblings with equal versions are left when replicas resolved the same conflict in different steps, between
+// those the larger value wins and a tombstone loses against any value.
+func wins(p ConflictPolicy, a sibling, b sibling) bool {
+	if p == LastWriterWins && a.timestamp != b.timestamp {
+		return a.timestamp > b.timestamp
+	}
+
+	if tiebreak(a.version, b.version) {
+		return true
+	}
+
+	if tiebreak(b.version, a.version) {
+		return false
+	}
+
+	if a.tombstoned != b.tombstoned {
+		return b.tombstoned
+	}
+
+	return a.val > b.val
+}
+
+// resolveSiblings applies a conflict policy to concurrent versions of an item. It returns the version that replaces
+// all of them, without a version vector, or false if they should all be kept.
+func resolveSiblings(p ConflictPolicy, siblings []sibling) (sibling, bool) {
+	if len(siblings) < 2 {
+		return sibling{}, false
+	}
+
+	switch p {
+	case LastWriterWins, NodeTiebreak:
+		w := siblings[0]
+
+		for _, s := range siblings[1:] {
+			if wins(p, s, w) {
+				w = s
+			}
+		}
+
+		return w, true
+	}
+
+	f, ok := p.mergeFunc()
+
+	if !ok {
+		return sibling{}, false
+	}
+
+	// we merge in a fixed order in case the merge function is not quite commutative
+	sort.Slice(siblings, func(i, j int) bool {
+		vi, vj := vector.SortedVCString(siblings[i].version), vector.SortedVCString(siblings[j].version)
+
+		if vi != vj {
+			return vi < vj
+		}
+
+		return siblings[i].val < siblings[j].val
+	})
+
+	w := sibling{tombstoned: true}
+
+	for _, s := range siblings {
+		// tombstones do not take part in merges, so an update wins over a concurrent delete
+		if s.tombstoned {
+			continue
+		}
+
+		if w.tombstoned {
+			w.val = s.val
+			w.tombstoned = false
+			continue
+		}
+
+		merged, err := f(w.val, s.val)
+
+		if err != nil {
+			log.Err(err).Msgf("could not merge concurrent values with policy %s, keeping siblings", p)
+			return sibling{}, false
+		}
+
+		w.val = merged
+	}
+
+	return w, true
+}
+
+// resolve replaces the concurrent versions of an item with a single version according to the conflict policy of the
+// keygroup and returns the item that replaces them, if any. The new version is the merge of all concurrent versions and
+// has the latest timestamp of them, so every replica that resolves the same versions ends up with the same version and
+// value without having to tell the others. The merged version is not ticked: the replicas resolve the conflict on their
+// own, and ticking would make their results concurrent again. Replicas that resolved in different steps can end up
+// with different values for the same version, reconcileEqual settles that when they exchange them. The lock of the
+// cached clock has to be held.
+func (s *storeService) resolve(kg KeygroupName, id string, c *cachedClock, expiry int, p ConflictPolicy) (Item, bool, error) {
+	if p == "" || p == KeepSiblings || len(c.clocks) < 2 {
+		return Item{}, false, nil
+	}
+
+	vals, tombstones, versions, _, err := s.iS.Read(string(kg), id)
+
+	if err != nil {
+		return Item{}, false, err
+	}
+
+	siblings := make([]sibling, len(vals))
+	merged := vclock.VClock{}
+	var latest uint64
+
+	for j := range vals {
+		siblings[j] = sibling{
+			val:        vals[j],
+			tombstoned: tombstones[j],
+			version:    versions[j],
+			timestamp:  c.timestamp(versions[j]),
+		}
+
+		merged.Merge(versions[j])
+		latest = max(latest, siblings[j].timestamp)
+	}
+
+	w, ok := resolveSiblings(p, siblings)
+
+	if !ok {
+		return Item{}, false, nil
+	}
+
+	if w.tombstoned {
+		expiry = 0
+	}
+
+	log.Debug().Msgf("resolve: replacing %d versions of %s in keygroup %s with %s", len(versions), id, kg, vector.SortedVCString(merged))
+
+	err = s.iS.Update(string(kg), id, w.val, w.tombstoned, expiry, merged)
+
+	if err != nil {
+		return Item{}, false, err
+	}
+
+	s.prune(string(kg), id, versions)
+
+	c.clocks = []vclock.VClock{merged}
+	c.setTimestamp(merged, latest)
+
+	return Item{
+		Keygroup:   kg,
+		ID:         id,
+		Val:        w.val,
+		Version:    merged.Copy(),
+		Tombstoned: w.tombstoned,
+		Timestamp:  latest,
+	}, true, nil
+}
+
+// reconcileEqual handles a version from another replica that we already have, but with a different value. The conflict
+// policy of the keygroup decides which value all replicas keep for that version, and the version keeps the later of
+// both timestamps. Returns the item with its new value if our value changed. The lock of the cached clock has to be
+// held.
+func (s *storeService) reconcileEqual(i Item, remoteVersion vclock.VClock, c *cachedClock, expiry int, p ConflictPolicy) (Item, bool, error) {
+	if p == "" || p == KeepSiblings {
+		return Item{}, false, nil
+	}
+
+	vals, tombstones, versions, _, err := s.iS.Read(string(i.Keygroup), i.ID)
+
+	if err != nil {
+		return Item{}, false, err
+	}
+
+	for j := range versions {
+		if !versions[j].Compare(remoteVersion, vclock.Equal) {
+			continue
+		}
+
+		local := c.timestamp(versions[j])
+		latest := max(local, i.Timestamp)
+
+		if vals[j] == i.Val && tombstones[j] == i.Tombstoned {
+			c.setTimestamp(versions[j], latest)
+			return Item{}, false, nil
+		}
+
+		w, ok := resolveSiblings(p, []sibling{
+			{val: vals[j], tombstoned: tombstones[j], version: versions[j], timestamp: local},
+			{val: i.Val, tombstoned: i.Tombstoned, version: remoteVersion, timestamp: i.Timestamp},
+		})
+
+		if !ok {
+			return Item{}, false, nil
+		}
+
+		c.setTimestamp(versions[j], latest)
+
+		if w.val == vals[j] && w.tombstoned == tombstones[j] {
+			return Item{}, false, nil
+		}
+
+		if w.tombstoned {
+			expiry = 0
+		}
+
+		log.Debug().Msgf("reconcileEqual: replacing value of %s in keygroup %s with version %s", i.ID, i.Keygroup, vector.SortedVCString(remoteVersion))
+
+		if err := s.iS.Update(string(i.Keygroup), i.ID, w.val, w.tombstoned, expiry, versions[j]); err != nil {
+			return Item{}, false, err
+		}
+
+		return Item{
+			Keygroup:   i.Keygroup,
+			ID:         i.ID,
+			Val:        w.val,
+			Version:    versions[j],
+			Tombstoned: w.tombstoned,
+			Timestamp:  latest,
+		}, true, nil
+	}
+
+	return Item{}, false, nil
+}
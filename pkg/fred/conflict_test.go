@@ -0,0 +1,245 @@
+package fred
+
+import (
+	"testing"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/badgerdb"
+	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTiebreak(t *testing.T) {
+	a := vclock.VClock{"A": 2, "B": 1}
+	b := vclock.VClock{"A": 1, "B": 2}
+
+	assert.True(t, tiebreak(b, a))
+	assert.False(t, tiebreak(a, b))
+	assert.True(t, tiebreak(vclock.VClock{"C": 1}, a))
+	assert.False(t, tiebreak(a, a))
+}
+
+func TestWins(t *testing.T) {
+	// x follows more writes, but y was written later
+	x := sibling{val: `"x"`, version: vclock.VClock{"X": 2}, timestamp: 1}
+	y := sibling{val: `"y"`, version: vclock.VClock{"A": 1}, timestamp: 2}
+
+	assert.True(t, wins(LastWriterWins, y, x))
+	assert.False(t, wins(LastWriterWins, x, y))
+	assert.True(t, wins(NodeTiebreak, x, y))
+
+	// with the same timestamp, the greatest node ID wins
+	y.timestamp = 1
+	assert.True(t, wins(LastWriterWins, x, y))
+	assert.False(t, wins(LastWriterWins, y, x))
+
+	// equal versions are ordered by their values, and any value wins over a tombstone
+	a := sibling{val: `"a"`, version: vclock.VClock{"X": 1}}
+	b := sibling{val: `"b"`, version: vclock.VClock{"X": 1}}
+	d := sibling{tombstoned: true, version: vclock.VClock{"X": 1}}
+
+	assert.True(t, wins(LastWriterWins, b, a))
+	assert.False(t, wins(NodeTiebreak, a, b))
+	assert.True(t, wins(NodeTiebreak, a, d))
+	assert.False(t, wins(NodeTiebreak, d, a))
+}
+
+func TestUnionMerge(t *testing.T) {
+	res, err := unionMerge(`["b", 1, "a"]`, `[1.0, "c"]`)
+	assert.NoError(t, err)
+	assert.Equal(t, `["a","b","c",1]`, res)
+
+	_, err = unionMerge(`["a"]`, `{"a":1}`)
+	assert.Error(t, err)
+}
+
+func TestCheckConflictPolicy(t *testing.T) {
+	assert.NoError(t, checkConflictPolicy(""))
+	assert.NoError(t, checkConflictPolicy(LastWriterWins))
+	assert.NoError(t, checkConflictPolicy(MergePolicy("union")))
+	assert.Error(t, checkConflictPolicy("newest"))
+	assert.Error(t, checkConflictPolicy(MergePolicy("nope")))
+
+	RegisterMergeFunc("first", func(a string, b string) (string, error) {
+		if a < b {
+			return a, nil
+		}
+		return b, nil
+	})
+
+	assert.NoError(t, checkConflictPolicy(MergePolicy("first")))
+}
+
+// writeConcurrently writes a value on each of two replicas, first on Y and then on X, and then sends each write to the
+// other replica.
+func writeConcurrently(t *testing.T, p ConflictPolicy, valX string, valY string) (*storeService, *storeService) {
+	kg := KeygroupName("conflictkg")
+	replicas := make([]*storeService, 2)
+
+	for j, id := range []NodeID{"X", "Y"} {
+		store := badgerdb.NewMemory()
+		t.Cleanup(func() { _ = store.Close() })
+
+		replicas[j] = newStoreService(store, id)
+		assert.NoError(t, replicas[j].createKeygroup(kg))
+	}
+
+	writes := make([]Item, 2)
+	vals := []string{valX, valY}
+
+	for _, j := range []int{1, 0} {
+		if j == 0 {
+			// X writes later, so its clock is ahead of the one of Y
+			replicas[0].clock.observe(writes[1].Timestamp)
+		}
+
+		val := vals[j]
+		i := Item{Keygroup: kg, ID: "a", Val: val, Timestamp: replicas[j].clock.now()}
+		var err error
+
+		if val == "" {
+			i.Tombstoned = true
+			i.Version, err = replicas[j].tombstone(i, nil)
+		} else {
+			i.Version, err = replicas[j].update(i, 0, nil)
+		}
+
+		assert.NoError(t, err)
+
+		writes[j] = i
+	}
+
+	_, err := replicas[0].addVersion(writes[1], writes[1].Version, 0, p)
+	assert.NoError(t, err)
+	_, err = replicas[1].addVersion(writes[0], writes[0].Version, 0, p)
+	assert.NoError(t, err)
+
+	return replicas[0], replicas[1]
+}
+
+func TestResolve(t *testing.T) {
+	read := func(s *storeService) []Item {
+		items, err := s.read("conflictkg", "a")
+		assert.NoError(t, err)
+		return items
+	}
+
+	// siblings are kept by default
+	x, y := writeConcurrently(t, KeepSiblings, `"x"`, `"y"`)
+	assert.Len(t, read(x), 2)
+	assert.Len(t, read(y), 2)
+
+	// X wrote last, so it wins even though Y is the greatest node ID
+	x, y = writeConcurrently(t, LastWriterWins, `"x"`, `"y"`)
+	assert.Len(t, read(x), 1)
+	assert.Equal(t, read(x), read(y))
+	assert.Equal(t, `"x"`, read(x)[0].Val)
+	assert.Equal(t, vclock.VClock{"X": 1, "Y": 1}, read(x)[0].Version)
+
+	// Y is the greatest node ID
+	x, y = writeConcurrently(t, NodeTiebreak, `"y"`, `"x"`)
+	assert.Len(t, read(x), 1)
+	assert.Equal(t, read(x), read(y))
+	assert.Equal(t, `"x"`, read(x)[0].Val)
+
+	x, y = writeConcurrently(t, MergePolicy("union"), `["a","b"]`, `["c","a"]`)
+	assert.Len(t, read(x), 1)
+	assert.Equal(t, read(x), read(y))
+	assert.Equal(t, `["a","b","c"]`, read(x)[0].Val)
+
+	// updates win over concurrent deletes when merging
+	x, y = writeConcurrently(t, MergePolicy("union"), "", `["c"]`)
+	assert.Equal(t, read(x), read(y))
+	assert.Equal(t, `["c"]`, read(x)[0].Val)
+
+	// values that cannot be merged are kept as siblings
+	x, y = writeConcurrently(t, MergePolicy("union"), `["a"]`, `"c"`)
+	assert.Len(t, read(x), 2)
+	assert.Len(t, read(y), 2)
+}
+
+func TestResolveInSteps(t *testing.T) {
+	kg := KeygroupName("conflictkg")
+
+	// x was written last, although y follows the most writes and z has the greatest node ID
+	writes := map[string]Item{
+		`"x"`: {Keygroup: kg, ID: "a", Val: `"x"`, Version: vclock.VClock{"X": 1}, Timestamp: 3},
+		`"y"`: {Keygroup: kg, ID: "a", Val: `"y"`, Version: vclock.VClock{"Y": 3}, Timestamp: 2},
+		`"z"`: {Keygroup: kg, ID: "a", Val: `"z"`, Version: vclock.VClock{"Z": 2}, Timestamp: 1},
+	}
+
+	replicas := make([]*storeService, 2)
+
+	// A sees x and y first, B sees y and z first and then gets x without its timestamp, e.g., from a replica that was
+	// restarted
+	for j, order := range [][]string{{`"x"`, `"y"`, `"z"`}, {`"y"`, `"z"`, `"x"`}} {
+		store := badgerdb.NewMemory()
+		t.Cleanup(func() { _ = store.Close() })
+
+		replicas[j] = newStoreService(store, NodeID([]string{"A", "B"}[j]))
+		assert.NoError(t, replicas[j].createKeygroup(kg))
+
+		for _, val := range order {
+			i := writes[val]
+
+			if j == 1 && val == `"x"` {
+				i.Timestamp = 0
+			}
+
+			_, err := replicas[j].addVersion(i, i.Version, 0, LastWriterWins)
+			assert.NoError(t, err)
+		}
+	}
+
+	a, err := replicas[0].read(kg, "a")
+	assert.NoError(t, err)
+	assert.Len(t, a, 1)
+
+	b, err := replicas[1].read(kg, "a")
+	assert.NoError(t, err)
+	assert.Len(t, b, 1)
+
+	// both have the same version but picked different winners
+	assert.Equal(t, vclock.VClock{"X": 1, "Y": 3, "Z": 2}, a[0].Version)
+	assert.Equal(t, a[0].Version, b[0].Version)
+	assert.Equal(t, `"x"`, a[0].Val)
+	assert.Equal(t, `"y"`, b[0].Val)
+
+	// exchanging the equal versions with their timestamps, as anti-entropy does, settles on the latest value on both
+	replicas[0].addTimestamps(kg, a)
+	replicas[1].addTimestamps(kg, b)
+	assert.Equal(t, uint64(3), a[0].Timestamp)
+	assert.Equal(t, uint64(2), b[0].Timestamp)
+
+	_, err = replicas[0].addVersion(b[0], b[0].Version, 0, LastWriterWins)
+	assert.NoError(t, err)
+	_, err = replicas[1].addVersion(a[0], a[0].Version, 0, LastWriterWins)
+	assert.NoError(t, err)
+
+	a, err = replicas[0].read(kg, "a")
+	assert.NoError(t, err)
+
+	b, err = replicas[1].read(kg, "a")
+	assert.NoError(t, err)
+
+	assert.Equal(t, a, b)
+	assert.Equal(t, `"x"`, a[0].Val)
+
+	replicas[1].addTimestamps(kg, b)
+	assert.Equal(t, uint64(3), b[0].Timestamp)
+
+	// replicas that keep siblings do not change their value
+	store := badgerdb.NewMemory()
+	t.Cleanup(func() { _ = store.Close() })
+
+	s := newStoreService(store, "C")
+	assert.NoError(t, s.createKeygroup(kg))
+	_, err = s.addVersion(Item{Keygroup: kg, ID: "a", Val: `"x"`}, vclock.VClock{"X": 1}, 0, KeepSiblings)
+	assert.NoError(t, err)
+	_, err = s.addVersion(Item{Keygroup: kg, ID: "a", Val: `"y"`}, vclock.VClock{"X": 1}, 0, KeepSiblings)
+	assert.NoError(t, err)
+
+	c, err := s.read(kg, "a")
+	assert.NoError(t, err)
+	assert.Len(t, c, 1)
+	assert.Equal(t, `"x"`, c[0].Val)
+}
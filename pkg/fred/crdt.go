@@ -0,0 +1,421 @@
+package fred
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/go-errors/errors"
+)
+
+// KeygroupType is the type of the items in a keygroup. Items in a keygroup of a CRDT type can only be changed with the
+// operations of that type, and replicas merge concurrent versions of them automatically.
+type KeygroupType string
+
+const (
+	// Plain keygroups store arbitrary string values.
+	Plain KeygroupType = ""
+	// PNCounter keygroups store counters that can be incremented and decremented.
+	PNCounter KeygroupType = "pncounter"
+	// ORSet keygroups store sets of strings in which an add wins over a concurrent remove of the same element.
+	ORSet KeygroupType = "orset"
+	// LWWMap keygroups store maps of strings in which the last write to a key wins.
+	LWWMap KeygroupType = "lwwmap"
+)
+
+func init() {
+	RegisterMergeFunc(string(PNCounter), mergePNCounter)
+	RegisterMergeFunc(string(ORSet), mergeORSet)
+	RegisterMergeFunc(string(LWWMap), mergeLWWMap)
+}
+
+// checkKeygroupType checks that a keygroup type is known.
+func checkKeygroupType(t KeygroupType) error {
+	switch t {
+	case Plain, PNCounter, ORSet, LWWMap:
+		return nil
+	}
+
+	return errors.Errorf("unknown keygroup type %s", t)
+}
+
+// conflictPolicy returns the conflict policy that merges concurrent versions of items of a CRDT type.
+func (t KeygroupType) conflictPolicy() ConflictPolicy {
+	return MergePolicy(string(t))
+}
+
+// pnCounter is the state of a PN-counter: the sums of all increments and decrements on each node.
+type pnCounter struct {
+	P map[string]uint64 `json:"p"`
+	N map[string]uint64 `json:"n"`
+}
+
+// orSet is the state of an observed-remove set: the unique tags of all adds of each element and the tags of all adds
+// that have been removed. An element is in the set if it has an add that has not been removed.
+type orSet struct {
+	Adds    map[string][]string `json:"adds"`
+	Removes map[string][]string `json:"removes"`
+}
+
+// lwwEntry is the last write to a key of a LWW-map.
+type lwwEntry struct {
+	Val     string `json:"v"`
+	Time    uint64 `json:"t"`
+	Node    string `json:"n"`
+	Deleted bool   `json:"d,omitempty"`
+}
+
+// lwwMap is the state of a last-writer-wins map.
+type lwwMap map[string]lwwEntry
+
+// newer returns whether an entry was written after another one, ties are broken by node ID.
+func (e lwwEntry) newer(o lwwEntry) bool {
+	if e.Time != o.Time {
+		return e.Time > o.Time
+	}
+
+	return e.Node > o.Node
+}
+
+// decodeState decodes the state of a CRDT, an empty string is an empty state.
+func decodeState(state string, v interface{}) error {
+	if state == "" {
+		return nil
+	}
+
+	if err := json.Unmarshal([]byte(state), v); err != nil {
+		return errors.Errorf("invalid CRDT state %s: %s", state, err.Error())
+	}
+
+	return nil
+}
+
+// encodeState encodes the state of a CRDT. Maps are encoded with sorted keys, so the encoding is deterministic.
+func encodeState(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return "", errors.New(err)
+	}
+
+	return string(b), nil
+}
+
+// unionTags merges two sorted lists of tags.
+func unionTags(a []string, b []string) []string {
+	set := make(map[string]struct{}, len(a)+len(b))
+
+	for _, t := range a {
+		set[t] = struct{}{}
+	}
+
+	for _, t := range b {
+		set[t] = struct{}{}
+	}
+
+	tags := make([]string, 0, len(set))
+
+	for t := range set {
+		tags = append(tags, t)
+	}
+
+	sort.Strings(tags)
+
+	return tags
+}
+
+func mergePNCounter(a string, b string) (string, error) {
+	c := pnCounter{P: make(map[string]uint64), N: make(map[string]uint64)}
+
+	for _, s := range []string{a, b} {
+		var o pnCounter
+
+		if err := decodeState(s, &o); err != nil {
+			return "", err
+		}
+
+		for n, v := range o.P {
+			if v > c.P[n] {
+				c.P[n] = v
+			}
+		}
+
+		for n, v := range o.N {
+			if v > c.N[n] {
+				c.N[n] = v
+			}
+		}
+	}
+
+	return encodeState(c)
+}
+
+func mergeORSet(a string, b string) (string, error) {
+	set := orSet{Adds: make(map[string][]string), Removes: make(map[string][]string)}
+
+	for _, s := range []string{a, b} {
+		var o orSet
+
+		if err := decodeState(s, &o); err != nil {
+			return "", err
+		}
+
+		for e, tags := range o.Adds {
+			set.Adds[e] = unionTags(set.Adds[e], tags)
+		}
+
+		for e, tags := range o.Removes {
+			set.Removes[e] = unionTags(set.Removes[e], tags)
+		}
+	}
+
+	return encodeState(set)
+}
+
+func mergeLWWMap(a string, b string) (string, error) {
+	m := make(lwwMap)
+
+	for _, s := range []string{a, b} {
+		var o lwwMap
+
+		if err := decodeState(s, &o); err != nil {
+			return "", err
+		}
+
+		for k, e := range o {
+			if old, ok := m[k]; !ok || e.newer(old) {
+				m[k] = e
+			}
+		}
+	}
+
+	return encodeState(m)
+}
+
+// mergeStates merges all versions of an item of a CRDT type into one state. Tombstones have to be left out, an empty
+// value is an empty state.
+func mergeStates(t KeygroupType, vals []string) (string, error) {
+	f, ok := t.conflictPolicy().mergeFunc()
+
+	if !ok {
+		return "", errors.Errorf("keygroup type %s has no merge function", t)
+	}
+
+	state := ""
+
+	for _, v := range vals {
+		if v == "" {
+			continue
+		}
+
+		if state == "" {
+			state = v
+			continue
+		}
+
+		var err error
+		state, err = f(state, v)
+
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return state, nil
+}
+
+// increment adds delta to a PN-counter on a node.
+func increment(state string, node string, delta int64) (string, error) {
+	c := pnCounter{P: make(map[string]uint64), N: make(map[string]uint64)}
+
+	if err := decodeState(state, &c); err != nil {
+		return "", err
+	}
+
+	if c.P == nil {
+		c.P = make(map[string]uint64)
+	}
+
+	if c.N == nil {
+		c.N = make(map[string]uint64)
+	}
+
+	if delta >= 0 {
+		c.P[node] += uint64(delta)
+	} else {
+		c.N[node] += uint64(-delta)
+	}
+
+	return encodeState(c)
+}
+
+// setAdd adds elements to an OR-set, tagging the adds with a node and a timestamp of that node.
+func setAdd(state string, node string, timestamp uint64, elements []string) (string, error) {
+	set := orSet{Adds: make(map[string][]string), Removes: make(map[string][]string)}
+
+	if err := decodeState(state, &set); err != nil {
+		return "", err
+	}
+
+	if set.Adds == nil {
+		set.Adds = make(map[string][]string)
+	}
+
+	tag := fmt.Sprintf("%s:%d", node, timestamp)
+
+	for _, e := range elements {
+		set.Adds[e] = unionTags(set.Adds[e], []string{tag})
+	}
+
+	return encodeState(set)
+}
+
+// setRemove removes elements from an OR-set by removing all adds of these elements that we have seen.
+func setRemove(state string, elements []string) (string, error) {
+	set := orSet{Adds: make(map[string][]string), Removes: make(map[string][]string)}
+
+	if err := decodeState(state, &set); err != nil {
+		return "", err
+	}
+
+	if set.Removes == nil {
+		set.Removes = make(map[string][]string)
+	}
+
+	for _, e := range elements {
+		if len(set.Adds[e]) == 0 {
+			continue
+		}
+
+		set.Removes[e] = unionTags(set.Removes[e], set.Adds[e])
+	}
+
+	return encodeState(set)
+}
+
+// mapSet sets or deletes keys of a LWW-map.
+func mapSet(state string, node string, timestamp uint64, entries map[string]string, deleted []string) (string, error) {
+	m := make(lwwMap)
+
+	if err := decodeState(state, &m); err != nil {
+		return "", err
+	}
+
+	if m == nil {
+		m = make(lwwMap)
+	}
+
+	for k, v := range entries {
+		m[k] = lwwEntry{Val: v, Time: timestamp, Node: node}
+	}
+
+	for _, k := range deleted {
+		m[k] = lwwEntry{Time: timestamp, Node: node, Deleted: true}
+	}
+
+	return encodeState(m)
+}
+
+// counterValue returns the value of a PN-counter.
+func counterValue(state string) (int64, error) {
+	var c pnCounter
+
+	if err := decodeState(state, &c); err != nil {
+		return 0, err
+	}
+
+	var v int64
+
+	for _, p := range c.P {
+		v += int64(p)
+	}
+
+	for _, n := range c.N {
+		v -= int64(n)
+	}
+
+	return v, nil
+}
+
+// setElements returns the sorted elements of an OR-set.
+func setElements(state string) ([]string, error) {
+	var set orSet
+
+	if err := decodeState(state, &set); err != nil {
+		return nil, err
+	}
+
+	elements := make([]string, 0, len(set.Adds))
+
+	for e, tags := range set.Adds {
+		removed := make(map[string]struct{}, len(set.Removes[e]))
+
+		for _, t := range set.Removes[e] {
+			removed[t] = struct{}{}
+		}
+
+		for _, t := range tags {
+			if _, ok := removed[t]; !ok {
+				elements = append(elements, e)
+				break
+			}
+		}
+	}
+
+	sort.Strings(elements)
+
+	return elements, nil
+}
+
+// mapEntries returns the keys and values of a LWW-map that have not been deleted.
+func mapEntries(state string) (map[string]string, error) {
+	var m lwwMap
+
+	if err := decodeState(state, &m); err != nil {
+		return nil, err
+	}
+
+	entries := make(map[string]string, len(m))
+
+	for k, e := range m {
+		if !e.Deleted {
+			entries[k] = e.Val
+		}
+	}
+
+	return entries, nil
+}
+
+// materialize turns the state of a CRDT into the value that clients read: a number for counters, a JSON array for
+// sets, and a JSON object for maps.
+func materialize(t KeygroupType, state string) (string, error) {
+	switch t {
+	case PNCounter:
+		v, err := counterValue(state)
+
+		if err != nil {
+			return "", err
+		}
+
+		return strconv.FormatInt(v, 10), nil
+	case ORSet:
+		e, err := setElements(state)
+
+		if err != nil {
+			return "", err
+		}
+
+		return encodeState(e)
+	case LWWMap:
+		e, err := mapEntries(state)
+
+		if err != nil {
+			return "", err
+		}
+
+		return encodeState(e)
+	}
+
+	return state, nil
+}
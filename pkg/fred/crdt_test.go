@@ -0,0 +1,145 @@
+package fred
+
+import (
+	"testing"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/badgerdb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPNCounter(t *testing.T) {
+	a, err := increment("", "X", 5)
+	assert.NoError(t, err)
+
+	a, err = increment(a, "X", -2)
+	assert.NoError(t, err)
+
+	b, err := increment("", "Y", 4)
+	assert.NoError(t, err)
+
+	ab, err := mergePNCounter(a, b)
+	assert.NoError(t, err)
+
+	ba, err := mergePNCounter(b, a)
+	assert.NoError(t, err)
+	assert.Equal(t, ab, ba)
+
+	// merging is idempotent
+	aab, err := mergePNCounter(a, ab)
+	assert.NoError(t, err)
+	assert.Equal(t, ab, aab)
+
+	v, err := counterValue(ab)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), v)
+
+	v, err = counterValue("")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), v)
+}
+
+func TestORSet(t *testing.T) {
+	a, err := setAdd("", "X", 1, []string{"apple", "pear"})
+	assert.NoError(t, err)
+
+	// Y sees the adds of X and removes apple while X concurrently adds apple again
+	b, err := setRemove(a, []string{"apple", "plum"})
+	assert.NoError(t, err)
+
+	e, err := setElements(b)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"pear"}, e)
+
+	a, err = setAdd(a, "X", 2, []string{"apple"})
+	assert.NoError(t, err)
+
+	ab, err := mergeORSet(a, b)
+	assert.NoError(t, err)
+
+	ba, err := mergeORSet(b, a)
+	assert.NoError(t, err)
+	assert.Equal(t, ab, ba)
+
+	e, err = setElements(ab)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"apple", "pear"}, e)
+}
+
+func TestLWWMap(t *testing.T) {
+	a, err := mapSet("", "X", 1, map[string]string{"color": "red", "size": "L"}, nil)
+	assert.NoError(t, err)
+
+	b, err := mapSet(a, "Y", 2, map[string]string{"color": "blue"}, []string{"size"})
+	assert.NoError(t, err)
+
+	// concurrent write with the same timestamp on a smaller node ID loses
+	a, err = mapSet(a, "W", 2, map[string]string{"color": "green", "shape": "round"}, nil)
+	assert.NoError(t, err)
+
+	ab, err := mergeLWWMap(a, b)
+	assert.NoError(t, err)
+
+	ba, err := mergeLWWMap(b, a)
+	assert.NoError(t, err)
+	assert.Equal(t, ab, ba)
+
+	e, err := mapEntries(ab)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"color": "blue", "shape": "round"}, e)
+
+	v, err := materialize(LWWMap, ab)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"color":"blue","shape":"round"}`, v)
+}
+
+func TestCRDTReplicas(t *testing.T) {
+	kg := KeygroupName("counterkg")
+	replicas := make([]*storeService, 2)
+
+	for j, id := range []NodeID{"X", "Y"} {
+		store := badgerdb.NewMemory()
+		t.Cleanup(func() { _ = store.Close() })
+
+		replicas[j] = newStoreService(store, id)
+		assert.NoError(t, replicas[j].createKeygroup(kg))
+	}
+
+	// both replicas increment the same counter concurrently and then exchange their versions
+	writes := make([]Item, 2)
+
+	for j, s := range replicas {
+		var err error
+
+		for k := 0; k < j+2; k++ {
+			writes[j], err = s.modify(Item{Keygroup: kg, ID: "c"}, 0, func(vals []string) (string, error) {
+				state, err := mergeStates(PNCounter, vals)
+
+				if err != nil {
+					return "", err
+				}
+
+				return increment(state, s.id, 1)
+			})
+
+			assert.NoError(t, err)
+		}
+	}
+
+	_, err := replicas[0].addVersion(writes[1], writes[1].Version, 0, PNCounter.conflictPolicy())
+	assert.NoError(t, err)
+	_, err = replicas[1].addVersion(writes[0], writes[0].Version, 0, PNCounter.conflictPolicy())
+	assert.NoError(t, err)
+
+	for _, s := range replicas {
+		items, err := s.read(kg, "c")
+		assert.NoError(t, err)
+		assert.Len(t, items, 1)
+
+		v, err := materialize(PNCounter, items[0].Val)
+		assert.NoError(t, err)
+		assert.Equal(t, "5", v)
+	}
+
+	assert.NoError(t, checkKeygroupType(ORSet))
+	assert.Error(t, checkKeygroupType("gcounter"))
+}
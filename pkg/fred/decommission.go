@@ -0,0 +1,191 @@
+package fred
+
+import (
+	"sort"
+
+	"github.com/go-errors/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// DecommissionStep is a step of decommissioning a node.
+type DecommissionStep int
+
+// These are the steps that are reported while a node is decommissioned, in the order they happen.
+const (
+	// DecommissionStarted means that the keygroups of the node are known.
+	DecommissionStarted DecommissionStep = iota
+	// DecommissionReplicaAdded means that a replica was added to a keygroup and has all of its data.
+	DecommissionReplicaAdded
+	// DecommissionReplicaRemoved means that the node is no longer a replica of a keygroup.
+	DecommissionReplicaRemoved
+	// DecommissionDeregistered means that the node was removed from the NaSe.
+	DecommissionDeregistered
+)
+
+// DecommissionProgress tells a client what happened while a node is decommissioned.
+type DecommissionProgress struct {
+	Step DecommissionStep
+	// Keygroup is the keygroup that a replica was added to or removed from.
+	Keygroup KeygroupName
+	// Replica is the node that was added as a replica.
+	Replica NodeID
+	// Done is the number of keygroups that the node is no longer a replica of, out of Total.
+	Done  int
+	Total int
+}
+
+// nodeKeygroups returns the keygroups that a node is a replica of, ordered by their name.
+func (s *replicationService) nodeKeygroups(nodeID NodeID) ([]KeygroupName, error) {
+	all, err := s.n.GetAllKeygroups()
+
+	if err != nil {
+		return nil, err
+	}
+
+	kgs := make([]KeygroupName, 0)
+
+	for _, kg := range all {
+		members, err := s.n.GetKeygroupMembers(kg, false)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := members[nodeID]; ok {
+			kgs = append(kgs, kg)
+		}
+	}
+
+	sort.Slice(kgs, func(i, j int) bool {
+		return kgs[i] < kgs[j]
+	})
+
+	return kgs, nil
+}
+
+// decommissionNode moves a node out of the keygroups it is a replica of and then removes it from the NaSe. Before the
+// node leaves a keygroup, replicas are added until the keygroup keeps as many replicas alive as its replication policy
+// asks for, but at least one. If a keygroup cannot keep a replica, the node stays in it and decommissioning stops, so
+// that it can be run again once there is a node to move the data to.
+func (s *replicationService) decommissionNode(nodeID NodeID, kgs []KeygroupName, progress func(p DecommissionProgress) error) error {
+	if _, err := s.n.GetNodeAddress(nodeID); err != nil {
+		return err
+	}
+
+	log.Info().Msgf("decommission from replservice: decommissioning node %s, which is a replica of %d keygroups", nodeID, len(kgs))
+
+	if err := progress(DecommissionProgress{Step: DecommissionStarted, Total: len(kgs)}); err != nil {
+		return err
+	}
+
+	for i, kg := range kgs {
+		added, err := s.replaceReplica(kg, nodeID)
+
+		if err != nil {
+			return err
+		}
+
+		for _, id := range added {
+			if err := progress(DecommissionProgress{Step: DecommissionReplicaAdded, Keygroup: kg, Replica: id, Done: i, Total: len(kgs)}); err != nil {
+				return err
+			}
+		}
+
+		// a node that is dead cannot be told to delete its data, but it is still removed from the keygroup
+		if s.isAlive(nodeID) {
+			err = s.removeReplica(Keygroup{Name: kg}, Node{ID: nodeID})
+		} else {
+			log.Warn().Msgf("decommission from replservice: node %s is dead and keeps its copy of keygroup %s", nodeID, kg)
+			err = s.n.ExitOtherNodeFromKeygroup(kg, nodeID)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := progress(DecommissionProgress{Step: DecommissionReplicaRemoved, Keygroup: kg, Done: i + 1, Total: len(kgs)}); err != nil {
+			return err
+		}
+	}
+
+	if err := s.n.DeregisterNode(nodeID); err != nil {
+		return err
+	}
+
+	log.Info().Msgf("decommission from replservice: node %s is decommissioned", nodeID)
+
+	return progress(DecommissionProgress{Step: DecommissionDeregistered, Done: len(kgs), Total: len(kgs)})
+}
+
+// replaceReplica adds replicas to a keygroup so that it keeps enough replicas alive without a node. Returns the nodes
+// that were added.
+func (s *replicationService) replaceReplica(kg KeygroupName, nodeID NodeID) ([]NodeID, error) {
+	p, err := s.n.GetKeygroupReplicationPolicy(kg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.n.GetKeygroupMembers(kg, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	alive, _ := s.livePeers(members)
+
+	remaining := 0
+
+	for _, id := range alive {
+		if id != nodeID {
+			remaining++
+		}
+	}
+
+	needed := p.MinReplicas
+
+	if needed < 1 {
+		needed = 1
+	}
+
+	if remaining >= needed {
+		return nil, nil
+	}
+
+	if len(alive) == 0 {
+		return nil, errors.Errorf("keygroup %s has no replica left that is alive to copy its data from", kg)
+	}
+
+	candidates, err := s.placementCandidates(members, p)
+
+	if err != nil {
+		return nil, err
+	}
+
+	missing := needed - remaining
+
+	if len(candidates) < missing {
+		if remaining+len(candidates) == 0 {
+			return nil, errors.Errorf("keygroup %s has no other replica and there is no node that is alive and allowed by its policy to move it to", kg)
+		}
+
+		log.Warn().Msgf("decommission from replservice: keygroup %s needs %d more replicas but only %d nodes are alive and allowed by its policy", kg, missing, len(candidates))
+		missing = len(candidates)
+	}
+
+	source := s.replicaSource(members, alive)
+	added := make([]NodeID, 0, missing)
+
+	// the new replicas take over the expiry of the node they replace
+	for _, id := range candidates[:missing] {
+		log.Info().Msgf("decommission from replservice: adding a replica of keygroup %s on %s with data from %s to replace %s", kg, id, source, nodeID)
+
+		if err := s.addReplicaFrom(Keygroup{Name: kg, Expiry: members[nodeID]}, source, id); err != nil {
+			return nil, err
+		}
+
+		added = append(added, id)
+	}
+
+	return added, nil
+}
@@ -0,0 +1,219 @@
+package fred
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// decommissionNaSe is a NameService with several keygroups whose members change as replicas are added and removed.
+type decommissionNaSe struct {
+	NameService
+	members      map[KeygroupName]map[NodeID]int
+	policies     map[KeygroupName]ReplicationPolicy
+	nodes        map[NodeID]NodeInfo
+	deregistered []NodeID
+}
+
+func (n *decommissionNaSe) GetNodeID() NodeID {
+	return "self"
+}
+
+func (n *decommissionNaSe) GetAllKeygroups() ([]KeygroupName, error) {
+	kgs := make([]KeygroupName, 0, len(n.members))
+
+	for kg := range n.members {
+		kgs = append(kgs, kg)
+	}
+
+	return kgs, nil
+}
+
+func (n *decommissionNaSe) ExistsKeygroup(kg KeygroupName) (bool, error) {
+	_, ok := n.members[kg]
+	return ok, nil
+}
+
+func (n *decommissionNaSe) GetKeygroupMembers(kg KeygroupName, _ bool) (map[NodeID]int, error) {
+	members := make(map[NodeID]int)
+
+	for id, expiry := range n.members[kg] {
+		members[id] = expiry
+	}
+
+	return members, nil
+}
+
+func (n *decommissionNaSe) GetKeygroupReplicationPolicy(kg KeygroupName) (ReplicationPolicy, error) {
+	return n.policies[kg], nil
+}
+
+func (n *decommissionNaSe) GetAllNodes() ([]Node, error) {
+	nodes := make([]Node, 0, len(n.nodes))
+
+	for id := range n.nodes {
+		nodes = append(nodes, Node{ID: id, Host: string(id)})
+	}
+
+	return nodes, nil
+}
+
+func (n *decommissionNaSe) GetNodeInfo(nodeID NodeID) (NodeInfo, error) {
+	return n.nodes[nodeID], nil
+}
+
+func (n *decommissionNaSe) GetNodeAddress(nodeID NodeID) (string, error) {
+	return string(nodeID), nil
+}
+
+func (n *decommissionNaSe) ExitOtherNodeFromKeygroup(kg KeygroupName, nodeID NodeID) error {
+	delete(n.members[kg], nodeID)
+	return nil
+}
+
+func (n *decommissionNaSe) DeregisterNode(nodeID NodeID) error {
+	n.deregistered = append(n.deregistered, nodeID)
+	return nil
+}
+
+// decommissionClient adds replicas in its NaSe and remembers which nodes it asked to add and delete them.
+type decommissionClient struct {
+	Client
+	n       *decommissionNaSe
+	added   map[string][]NodeID
+	deleted map[string][]KeygroupName
+}
+
+func (c *decommissionClient) SendAddReplica(host string, kgname KeygroupName, nodeID NodeID, expiry int) error {
+	c.added[host] = append(c.added[host], nodeID)
+	c.n.members[kgname][nodeID] = expiry
+	return nil
+}
+
+func (c *decommissionClient) SendDeleteKeygroup(host string, kgname KeygroupName) error {
+	c.deleted[host] = append(c.deleted[host], kgname)
+	return nil
+}
+
+func TestDecommissionNode(t *testing.T) {
+	n := &decommissionNaSe{
+		members: map[KeygroupName]map[NodeID]int{
+			// a keeps a replica without X
+			"a": {"X": 10, "A": 10},
+			// b only has X, so it gets the first node that is alive
+			"b": {"X": 10},
+			// c keeps two replicas in zone eu
+			"c": {"X": 20, "B": 10},
+			// d does not have X
+			"d": {"A": 0},
+		},
+		policies: map[KeygroupName]ReplicationPolicy{
+			"c": {MinReplicas: 2, Labels: map[string]string{"zone": "eu"}},
+		},
+		nodes: map[NodeID]NodeInfo{
+			"self": {},
+			"A":    {},
+			"B":    {Labels: map[string]string{"zone": "eu"}},
+			"C":    {Labels: map[string]string{"zone": "eu"}},
+			"D":    {Labels: map[string]string{"zone": "eu"}},
+			"X":    {},
+		},
+	}
+	c := &decommissionClient{n: n, added: make(map[string][]NodeID), deleted: make(map[string][]KeygroupName)}
+
+	r := newReplicationService(nil, c, n, nil, nil, false, nil, 0)
+	r.setNodeStatus("D", NodeDead)
+
+	kgs, err := r.nodeKeygroups("X")
+	assert.NoError(t, err)
+	assert.Equal(t, []KeygroupName{"a", "b", "c"}, kgs)
+
+	progress := make([]DecommissionProgress, 0)
+
+	err = r.decommissionNode("X", kgs, func(p DecommissionProgress) error {
+		progress = append(progress, p)
+		return nil
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []DecommissionProgress{
+		{Step: DecommissionStarted, Total: 3},
+		{Step: DecommissionReplicaRemoved, Keygroup: "a", Done: 1, Total: 3},
+		{Step: DecommissionReplicaAdded, Keygroup: "b", Replica: "A", Done: 1, Total: 3},
+		{Step: DecommissionReplicaRemoved, Keygroup: "b", Done: 2, Total: 3},
+		{Step: DecommissionReplicaAdded, Keygroup: "c", Replica: "C", Done: 2, Total: 3},
+		{Step: DecommissionReplicaRemoved, Keygroup: "c", Done: 3, Total: 3},
+		{Step: DecommissionDeregistered, Done: 3, Total: 3},
+	}, progress)
+
+	// the data comes from a replica that is alive, and new replicas take over the expiry of X
+	assert.Equal(t, map[string][]NodeID{"X": {"A"}, "B": {"C"}}, c.added)
+	assert.Equal(t, map[NodeID]int{"A": 10}, n.members["b"])
+	assert.Equal(t, map[NodeID]int{"B": 10, "C": 20}, n.members["c"])
+	assert.Equal(t, map[string][]KeygroupName{"X": {"a", "b", "c"}}, c.deleted)
+	assert.Equal(t, []NodeID{"X"}, n.deregistered)
+}
+
+func TestDecommissionDeadNode(t *testing.T) {
+	n := &decommissionNaSe{
+		members: map[KeygroupName]map[NodeID]int{
+			"a": {"X": 0, "A": 0},
+			"b": {"X": 0},
+		},
+		nodes: map[NodeID]NodeInfo{
+			"self": {},
+			"A":    {},
+			"X":    {},
+		},
+	}
+	c := &decommissionClient{n: n, added: make(map[string][]NodeID), deleted: make(map[string][]KeygroupName)}
+
+	r := newReplicationService(nil, c, n, nil, nil, false, nil, 0)
+	r.setNodeStatus("X", NodeDead)
+
+	// X is removed from a without being asked to delete its data, but b would lose its only copy
+	err := r.decommissionNode("X", []KeygroupName{"a", "b"}, func(_ DecommissionProgress) error {
+		return nil
+	})
+	assert.Error(t, err)
+
+	assert.Equal(t, map[NodeID]int{"A": 0}, n.members["a"])
+	assert.Equal(t, map[NodeID]int{"X": 0}, n.members["b"])
+	assert.Empty(t, c.added)
+	assert.Empty(t, c.deleted)
+	assert.Empty(t, n.deregistered)
+}
+
+func TestDecommissionNodeNotAdmin(t *testing.T) {
+	// X is not a replica of any keygroup, so there are no keygroup permissions to check
+	n := &decommissionNaSe{
+		members: map[KeygroupName]map[NodeID]int{},
+		nodes: map[NodeID]NodeInfo{
+			"self": {},
+			"X":    {},
+		},
+	}
+	c := &decommissionClient{n: n, added: make(map[string][]NodeID), deleted: make(map[string][]KeygroupName)}
+
+	h := &ExtHandler{
+		r: newReplicationService(nil, c, n, nil, nil, false, nil, 0),
+		a: newAuthService(n, []string{"admin"}),
+	}
+
+	progress := make([]DecommissionProgress, 0)
+
+	err := h.HandleDecommissionNode("user", Node{ID: "X"}, func(p DecommissionProgress) error {
+		progress = append(progress, p)
+		return nil
+	})
+	assert.Error(t, err)
+	assert.Empty(t, progress)
+	assert.Empty(t, n.deregistered)
+
+	err = h.HandleDecommissionNode("admin", Node{ID: "X"}, func(p DecommissionProgress) error {
+		progress = append(progress, p)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []NodeID{"X"}, n.deregistered)
+}
@@ -1,6 +1,7 @@
 package fred
 
 import (
+	"git.tu-berlin.de/mcc-fred/fred/pkg/vector"
 	"git.tu-berlin.de/mcc-fred/vclock"
 	"github.com/go-errors/errors"
 	"github.com/rs/zerolog/log"
@@ -11,22 +12,68 @@ type ExtHandler struct {
 	r *replicationService
 	t *triggerService
 	a *authService
+	w *watchService
+	q *quotaService
+	c *changeLogService
 	n NameService
 }
 
 // newExthandler creates a new handler for client request (i.e. from clients).
-func newExthandler(s *storeService, r *replicationService, t *triggerService, a *authService, n NameService) *ExtHandler {
+func newExthandler(s *storeService, r *replicationService, t *triggerService, a *authService, w *watchService, q *quotaService, c *changeLogService, n NameService) *ExtHandler {
 	return &ExtHandler{
 		s: s,
 		r: r,
 		t: t,
 		a: a,
+		w: w,
+		q: q,
+		c: c,
 		n: n,
 	}
 }
 
 // HandleCreateKeygroup handles requests to the CreateKeygroup endpoint of the client interface.
 func (h *ExtHandler) HandleCreateKeygroup(user string, k Keygroup) error {
+	if err := checkIndexes(k.Indexes); err != nil {
+		return err
+	}
+
+	if err := checkConflictPolicy(k.ConflictPolicy); err != nil {
+		return err
+	}
+
+	if err := checkKeygroupType(k.Type); err != nil {
+		return err
+	}
+
+	if err := checkHistoryRetention(k.History); err != nil {
+		return err
+	}
+
+	if err := checkQuota(k.Quota); err != nil {
+		return err
+	}
+
+	if err := checkCompression(k.Compression); err != nil {
+		return err
+	}
+
+	if err := checkReplicationPolicy(k.ReplicationPolicy); err != nil {
+		return err
+	}
+
+	// replicas merge concurrent versions of CRDTs, so there is no other policy for them
+	if k.Type != Plain {
+		if !k.Mutable {
+			return errors.Errorf("keygroup %s of type %s has to be mutable", k.Name, k.Type)
+		}
+
+		if k.ConflictPolicy != "" && k.ConflictPolicy != k.Type.conflictPolicy() {
+			return errors.Errorf("keygroup %s of type %s cannot have conflict policy %s", k.Name, k.Type, k.ConflictPolicy)
+		}
+
+		k.ConflictPolicy = k.Type.conflictPolicy()
+	}
 
 	if err := h.r.createKeygroup(k); err != nil {
 		log.Debug().Msg(err.(*errors.Error).ErrorStack())
@@ -40,6 +87,18 @@ func (h *ExtHandler) HandleCreateKeygroup(user string, k Keygroup) error {
 		return errors.Errorf("error creating keygroup")
 	}
 
+	if err := h.s.setIndexes(k.Name, k.Indexes); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+
+		return errors.Errorf("error creating keygroup")
+	}
+
+	if err := h.s.setHistory(k.Name, k.History); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+
+		return errors.Errorf("error creating keygroup")
+	}
+
 	// when a user creates a keygroup, they should have all rights for that keygroup
 	err := h.a.addRoles(user, []Role{ReadKeygroup, WriteKeygroup, ConfigureReplica, ConfigureTrigger, ConfigureKeygroups}, k.Name)
 
@@ -110,33 +169,143 @@ func (h *ExtHandler) HandleRead(user string, i Item, versions []vclock.VClock) (
 		return nil, errors.Errorf("item %s not found in keygroup %s", i.ID, i.Keygroup)
 	}
 
-	return result, nil
+	return h.materializeItems(i.Keygroup, result)
+}
+
+// HandleReadHistory handles requests to the ReadHistory endpoint of the client interface. It returns the replaced
+// versions of an item that are kept, the oldest first, followed by its current versions.
+func (h *ExtHandler) HandleReadHistory(user string, i Item) ([]Revision, error) {
+	allowed, err := h.a.isAllowed(user, Read, i.Keygroup)
+
+	if err != nil || !allowed {
+		return nil, errors.Errorf("user %s cannot read from keygroup %s", user, i.Keygroup)
+	}
+
+	r, err := h.s.readHistory(i.Keygroup, i.ID)
+
+	if err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return nil, errors.Errorf("error reading history of item %s from keygroup %s", i.ID, i.Keygroup)
+	}
+
+	if len(r) == 0 {
+		return nil, errors.Errorf("item %s not found in keygroup %s", i.ID, i.Keygroup)
+	}
+
+	items := make([]Item, len(r))
+
+	for j := range r {
+		items[j] = r[j].Item
+	}
+
+	items, err = h.materializeItems(i.Keygroup, items)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for j := range r {
+		r[j].Item = items[j]
+	}
+
+	return r, nil
 }
 
-// HandleScan handles requests to the Scan endpoint of the client interface.
-func (h *ExtHandler) HandleScan(user string, i Item, count uint64) ([]Item, error) {
+// HandleReadAt handles requests to the ReadAt endpoint of the client interface. It returns the versions of an item
+// that a client that has seen the given version would have read, as far as the history of the item goes back.
+func (h *ExtHandler) HandleReadAt(user string, i Item, version vclock.VClock) ([]Item, error) {
 	allowed, err := h.a.isAllowed(user, Read, i.Keygroup)
 
 	if err != nil || !allowed {
 		return nil, errors.Errorf("user %s cannot read from keygroup %s", user, i.Keygroup)
 	}
 
+	r, err := h.s.readAt(i.Keygroup, i.ID, version)
+
+	if err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return nil, errors.Errorf("error reading item %s from keygroup %s", i.ID, i.Keygroup)
+	}
+
+	result := make([]Item, 0, len(r))
+	for _, it := range r {
+		if !it.Tombstoned {
+			result = append(result, it)
+		}
+	}
+
+	if len(result) == 0 {
+		return nil, errors.Errorf("item %s not found in keygroup %s at version %s", i.ID, i.Keygroup, vector.SortedVCString(version))
+	}
+
+	return h.materializeItems(i.Keygroup, result)
+}
+
+// HandleScan handles requests to the Scan endpoint of the client interface. It returns up to count items and a token
+// to continue the scan, which is empty if there are no more items.
+func (h *ExtHandler) HandleScan(user string, i Item, count uint64, o ScanOptions) ([]Item, string, error) {
+	allowed, err := h.a.isAllowed(user, Read, i.Keygroup)
+
+	if err != nil || !allowed {
+		return nil, "", errors.Errorf("user %s cannot read from keygroup %s", user, i.Keygroup)
+	}
+
 	if count <= 0 {
-		return nil, errors.Errorf("count must be at least 1, got %d", count)
+		return nil, "", errors.Errorf("count must be at least 1, got %d", count)
 	}
 
 	// always return all versions
-	result, err := h.s.scan(i.Keygroup, i.ID, count)
+	result, token, err := h.s.scan(i.Keygroup, i.ID, count, o)
 
 	if err != nil {
 		log.Error().Msgf("Error in Scan is: %+v", err)
 		// This prints the error stack whenever a item is not found, nobody cares about this...
 		// log.Err(err).Msg(err.(*errors.Error).ErrorStack())
-		return nil, errors.Errorf("error scanning %d items starting at %s from keygroup %s", count, i.ID, i.Keygroup)
+		return nil, "", errors.Errorf("error scanning %d items starting at %s from keygroup %s", count, i.ID, i.Keygroup)
 	}
 
 	log.Debug().Msgf("Scan of item=%s keygroup=%s count=%d resulted in %d items", i.ID, i.Keygroup, count, len(result))
 
+	result, err = h.materializeItems(i.Keygroup, result)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	return result, token, nil
+}
+
+// HandleQueryIndex handles requests to the QueryIndex endpoint of the client interface. It returns all items whose
+// value contains the given JSON value at the path of the index.
+func (h *ExtHandler) HandleQueryIndex(user string, k Keygroup, name string, value string) ([]Item, error) {
+	allowed, err := h.a.isAllowed(user, Read, k.Name)
+
+	if err != nil || !allowed {
+		return nil, errors.Errorf("user %s cannot read from keygroup %s", user, k.Name)
+	}
+
+	// indexes are only kept in memory and are built again when this node starts, if that failed we try again here
+	if !h.s.hasIndexes(k.Name) {
+		indexes, err := h.n.GetKeygroupIndexes(k.Name)
+
+		if err != nil {
+			log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+			return nil, errors.Errorf("error querying index %s of keygroup %s", name, k.Name)
+		}
+
+		if err := h.s.setIndexes(k.Name, indexes); err != nil {
+			log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+			return nil, errors.Errorf("error querying index %s of keygroup %s", name, k.Name)
+		}
+	}
+
+	result, err := h.s.queryIndex(k.Name, name, value)
+
+	if err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return nil, errors.Errorf("error querying index %s of keygroup %s", name, k.Name)
+	}
+
 	return result, nil
 }
 
@@ -188,20 +357,43 @@ func (h *ExtHandler) HandleAppend(user string, i Item) (Item, error) {
 
 	log.Debug().Msgf("...keygroup %s is immutable", i.Keygroup)
 
+	if err := h.checkWritable(i.Keygroup); err != nil {
+		return i, err
+	}
+
+	if i.TTL < 0 {
+		return i, errors.Errorf("cannot append %s with negative TTL", i.ID)
+	}
+
 	expiry, err := h.n.GetExpiry(i.Keygroup)
 
 	if err != nil {
 		return i, err
 	}
 
-	err = h.s.append(i, expiry)
+	d, err := h.admit(i.Keygroup, []Item{i})
+
+	if err != nil {
+		return i, err
+	}
+
+	err = h.s.append(i, expiryFor(i, expiry))
 
 	if err != nil {
+		h.q.release(i.Keygroup, d)
 		log.Printf("%+v", err)
 		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
 		return i, errors.Errorf("error updating item")
 	}
 
+	h.q.commit(i.Keygroup, d)
+
+	h.w.notify(i)
+
+	if err := h.c.record(i, true, h.c.id); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+	}
+
 	if err := h.r.relayAppend(i); err != nil {
 		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
 		return i, errors.Errorf("error updating item")
@@ -215,8 +407,10 @@ func (h *ExtHandler) HandleAppend(user string, i Item) (Item, error) {
 	return i, nil
 }
 
-// HandleUpdate handles requests to the Update endpoint of the client interface.
-func (h *ExtHandler) HandleUpdate(user string, i Item, versions []vclock.VClock) (Item, error) {
+// HandleUpdate handles requests to the Update endpoint of the client interface. If a precondition is given, the item
+// is only updated if it holds, otherwise a *PreconditionError is returned. If the update would exceed the quota of the
+// keygroup, a *QuotaError is returned.
+func (h *ExtHandler) HandleUpdate(user string, i Item, versions []vclock.VClock, p *Precondition) (Item, error) {
 	allowed, err := h.a.isAllowed(user, Update, i.Keygroup)
 
 	if err != nil || !allowed {
@@ -236,32 +430,70 @@ func (h *ExtHandler) HandleUpdate(user string, i Item, versions []vclock.VClock)
 
 	log.Debug().Msgf("...keygroup %s is mutable", i.Keygroup)
 
+	if err := h.checkWritable(i.Keygroup); err != nil {
+		return i, err
+	}
+
+	if err := h.checkPlain(i.Keygroup); err != nil {
+		return i, err
+	}
+
 	expiry, err := h.n.GetExpiry(i.Keygroup)
 
 	if err != nil {
 		return i, err
 	}
 
+	d, err := h.admit(i.Keygroup, []Item{i})
+
+	if err != nil {
+		return i, err
+	}
+
+	i.Timestamp = h.s.clock.now()
+
 	// if update request has a list of versions, all versions that are equal or less than those versions will be overwritten
 	// else only the local counter will be incremented
 	if versions == nil {
-		i.Version, err = h.s.update(i, expiry)
+		i.Version, err = h.s.update(i, expiryFor(i, expiry), p)
 
 		if err != nil {
+			h.q.release(i.Keygroup, d)
+
+			var perr *PreconditionError
+			if errors.As(err, &perr) {
+				return i, perr
+			}
+
 			log.Printf("%+v", err)
 			log.Err(err).Msg(err.(*errors.Error).ErrorStack())
 			return i, errors.Errorf("error updating item")
 		}
 	} else {
-		i.Version, err = h.s.updateVersions(i, versions, expiry)
+		i.Version, err = h.s.updateVersions(i, versions, expiryFor(i, expiry), p)
 
 		if err != nil {
+			h.q.release(i.Keygroup, d)
+
+			var perr *PreconditionError
+			if errors.As(err, &perr) {
+				return i, perr
+			}
+
 			log.Printf("%+v", err)
 			log.Err(err).Msg(err.(*errors.Error).ErrorStack())
 			return i, errors.Errorf("error updating item")
 		}
 	}
 
+	h.q.commit(i.Keygroup, d)
+
+	h.w.notify(i)
+
+	if err := h.c.record(i, false, h.c.id); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+	}
+
 	if err := h.r.relayUpdate(i); err != nil {
 		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
 		return i, errors.Errorf("error updating item")
@@ -275,8 +507,9 @@ func (h *ExtHandler) HandleUpdate(user string, i Item, versions []vclock.VClock)
 	return i, nil
 }
 
-// HandleDelete handles requests to the Delete endpoint of the client interface.
-func (h *ExtHandler) HandleDelete(user string, i Item, versions []vclock.VClock) (Item, error) {
+// HandleDelete handles requests to the Delete endpoint of the client interface. If a precondition is given, the item
+// is only deleted if it holds, otherwise a *PreconditionError is returned.
+func (h *ExtHandler) HandleDelete(user string, i Item, versions []vclock.VClock, p *Precondition) (Item, error) {
 	allowed, err := h.a.isAllowed(user, Delete, i.Keygroup)
 
 	if err != nil || !allowed {
@@ -293,32 +526,63 @@ func (h *ExtHandler) HandleDelete(user string, i Item, versions []vclock.VClock)
 		return i, errors.Errorf("cannot update item %s because keygroup is immutable", i.ID)
 	}
 
+	if err := h.checkWritable(i.Keygroup); err != nil {
+		return i, err
+	}
+
 	if !h.s.exists(i) {
 		return i, errors.Errorf("item does not exist so it cannot be deleted")
 	}
 
 	i.Tombstoned = true
+	i.Timestamp = h.s.clock.now()
+
+	// deletes are never rejected by the quota, but they change the usage
+	d, err := h.q.change(i.Keygroup, []Item{i}, false)
+
+	if err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return i, errors.Errorf("error deleting item")
+	}
 
 	// if delete request has a list of versions, all versions that are equal or less than those versions will be overwritten with tombstone
 	// else only the local counter will be incremented
 	if versions == nil {
-		i.Version, err = h.s.tombstone(i)
+		i.Version, err = h.s.tombstone(i, p)
 
 		if err != nil {
+			var perr *PreconditionError
+			if errors.As(err, &perr) {
+				return i, perr
+			}
+
 			log.Printf("%+v", err)
 			log.Err(err).Msg(err.(*errors.Error).ErrorStack())
 			return i, errors.Errorf("error updating item")
 		}
 	} else {
-		i.Version, err = h.s.tombstoneVersions(i, versions)
+		i.Version, err = h.s.tombstoneVersions(i, versions, p)
 
 		if err != nil {
+			var perr *PreconditionError
+			if errors.As(err, &perr) {
+				return i, perr
+			}
+
 			log.Printf("%+v", err)
 			log.Err(err).Msg(err.(*errors.Error).ErrorStack())
 			return i, errors.Errorf("error updating item")
 		}
 	}
 
+	h.q.record(i.Keygroup, d)
+
+	h.w.notify(i)
+
+	if err := h.c.record(i, false, h.c.id); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+	}
+
 	if err := h.r.relayUpdate(i); err != nil {
 		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
 		return i, errors.Errorf("error deleting item")
@@ -373,6 +637,104 @@ func (h *ExtHandler) HandleGetKeygroupInfo(user string, k Keygroup) (bool, []Nod
 	return m, n, e, nil
 }
 
+// HandleGetKeygroupUsage returns the quota of a keygroup and what it uses on this node.
+func (h *ExtHandler) HandleGetKeygroupUsage(user string, k Keygroup) (Quota, Usage, error) {
+	allowed, err := h.a.isAllowed(user, GetReplica, k.Name)
+
+	if err != nil || !allowed {
+		return Quota{}, Usage{}, errors.Errorf("user %s cannot get usage of keygroup %s", user, k.Name)
+	}
+
+	q, err := h.n.GetKeygroupQuota(k.Name)
+
+	if err != nil {
+		return Quota{}, Usage{}, err
+	}
+
+	u, err := h.q.usage(k.Name)
+
+	if err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return Quota{}, Usage{}, errors.Errorf("error counting usage of keygroup %s", k.Name)
+	}
+
+	return q, u, nil
+}
+
+// HandleSetKeygroupQuota handles requests to the SetKeygroupQuota endpoint of the client interface. The new quota
+// applies to all replicas right away but does not remove anything that already exceeds it.
+func (h *ExtHandler) HandleSetKeygroupQuota(user string, k Keygroup) error {
+	allowed, err := h.a.isAllowed(user, SetQuota, k.Name)
+
+	if err != nil || !allowed {
+		return errors.Errorf("user %s cannot set quota of keygroup %s", user, k.Name)
+	}
+
+	if err := checkQuota(k.Quota); err != nil {
+		return err
+	}
+
+	exists, err := h.n.ExistsKeygroup(k.Name)
+
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return errors.Errorf("keygroup %s does not exist", k.Name)
+	}
+
+	if err := h.n.SetKeygroupQuota(k.Name, k.Quota); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return errors.Errorf("error setting quota")
+	}
+
+	return nil
+}
+
+// HandleGetKeygroupReplicationPolicy returns the replication policy of a keygroup.
+func (h *ExtHandler) HandleGetKeygroupReplicationPolicy(user string, k Keygroup) (ReplicationPolicy, error) {
+	allowed, err := h.a.isAllowed(user, GetReplica, k.Name)
+
+	if err != nil || !allowed {
+		return ReplicationPolicy{}, errors.Errorf("user %s cannot get replication policy of keygroup %s", user, k.Name)
+	}
+
+	return h.n.GetKeygroupReplicationPolicy(k.Name)
+}
+
+// HandleSetKeygroupReplicationPolicy handles requests to the SetKeygroupReplicationPolicy endpoint of the client
+// interface. Missing replicas are added the next time the reconciler runs, replicas that the new policy would not
+// allow are not removed.
+func (h *ExtHandler) HandleSetKeygroupReplicationPolicy(user string, k Keygroup) error {
+	allowed, err := h.a.isAllowed(user, SetReplicationPolicy, k.Name)
+
+	if err != nil || !allowed {
+		return errors.Errorf("user %s cannot set replication policy of keygroup %s", user, k.Name)
+	}
+
+	if err := checkReplicationPolicy(k.ReplicationPolicy); err != nil {
+		return err
+	}
+
+	exists, err := h.n.ExistsKeygroup(k.Name)
+
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return errors.Errorf("keygroup %s does not exist", k.Name)
+	}
+
+	if err := h.n.SetKeygroupReplicationPolicy(k.Name, k.ReplicationPolicy); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return errors.Errorf("error setting replication policy")
+	}
+
+	return nil
+}
+
 // HandleRemoveReplica handles requests to the RemoveKeygroupReplica endpoint of the client interface.
 func (h *ExtHandler) HandleRemoveReplica(user string, k Keygroup, n Node) error {
 	allowed, err := h.a.isAllowed(user, RemoveReplica, k.Name)
@@ -389,6 +751,39 @@ func (h *ExtHandler) HandleRemoveReplica(user string, k Keygroup, n Node) error
 	return nil
 }
 
+// HandleDecommissionNode handles requests to the DecommissionNode endpoint of the client interface. The user needs to
+// be an admin of the cluster and allowed to add and remove replicas of all keygroups that the node is a replica of. Progress is reported with
+// progress, and if that fails, decommissioning stops.
+func (h *ExtHandler) HandleDecommissionNode(user string, n Node, progress func(p DecommissionProgress) error) error {
+	if !h.a.isAdmin(user) {
+		return errors.Errorf("user %s cannot decommission node %s as it is not an admin of the cluster", user, n.ID)
+	}
+
+	kgs, err := h.r.nodeKeygroups(n.ID)
+
+	if err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return errors.Errorf("error getting keygroups of node %s", n.ID)
+	}
+
+	for _, kg := range kgs {
+		for _, m := range []Method{AddReplica, RemoveReplica} {
+			allowed, err := h.a.isAllowed(user, m, kg)
+
+			if err != nil || !allowed {
+				return errors.Errorf("user %s cannot decommission node %s as it cannot add and remove replicas of keygroup %s", user, n.ID, kg)
+			}
+		}
+	}
+
+	if err := h.r.decommissionNode(n.ID, kgs, progress); err != nil {
+		log.Err(err).Msgf("error decommissioning node %s", n.ID)
+		return errors.Errorf("error decommissioning node %s, decommission it again to continue: %s", n.ID, err.Error())
+	}
+
+	return nil
+}
+
 // HandleAddTrigger handles requests to the AddKeygroupTrigger endpoint of the client interface.
 func (h *ExtHandler) HandleAddTrigger(user string, k Keygroup, t Trigger) error {
 	allowed, err := h.a.isAllowed(user, AddTrigger, k.Name)
@@ -444,24 +839,763 @@ func (h *ExtHandler) HandleGetAllReplica(_ string) ([]Node, error) {
 	return h.r.getNodesExternalAdress()
 }
 
-// HandleAddUser adds permissions to a keygroup to a new user.
-func (h *ExtHandler) HandleAddUser(user string, newuser string, k Keygroup, r Role) error {
-	allowed, err := h.a.isAllowed(user, AddUser, k.Name)
+// HandleGetNearestReplicas handles requests to the GetNearestReplicas endpoint of the client interface. Returns the n
+// replicas of a keygroup that are closest to a location, or all of them if n is 0.
+func (h *ExtHandler) HandleGetNearestReplicas(user string, k Keygroup, l Location, n int) ([]ReplicaDistance, error) {
+	allowed, err := h.a.isAllowed(user, GetReplica, k.Name)
 
 	if err != nil || !allowed {
-		return errors.Errorf("user %s cannot add user permissions to keygroup %s", user, k.Name)
+		return nil, errors.Errorf("user %s cannot get replica for keygroup %s", user, k.Name)
 	}
 
-	return h.a.addRoles(newuser, []Role{r}, k.Name)
-}
-
-// HandleRemoveUser removes permissions to a keygroup to a user.
-func (h *ExtHandler) HandleRemoveUser(user string, newuser string, k Keygroup, r Role) error {
-	allowed, err := h.a.isAllowed(user, RemoveUser, k.Name)
+	if err := checkLocation(l); err != nil {
+		return nil, err
+	}
 
-	if err != nil || !allowed {
-		return errors.Errorf("user %s cannot remove user permissions to keygroup %s", user, k.Name)
+	if n < 0 {
+		return nil, errors.Errorf("number of replicas must not be negative, got %d", n)
 	}
 
-	return h.a.revokeRoles(newuser, []Role{r}, k.Name)
+	r, err := h.r.nearestReplicas(k.Name, l, n)
+
+	if err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return nil, errors.Errorf("error getting nearest replicas")
+	}
+
+	return r, nil
+}
+
+// HandleProposeReplicas handles requests to the ProposeReplicas endpoint of the client interface. Returns the n nodes
+// that would serve clients at the given locations best as replicas of a keygroup and the mean distance of the clients
+// to them in kilometers.
+func (h *ExtHandler) HandleProposeReplicas(user string, k Keygroup, clients []Location, n int) ([]Node, float64, error) {
+	allowed, err := h.a.isAllowed(user, GetReplica, k.Name)
+
+	if err != nil || !allowed {
+		return nil, 0, errors.Errorf("user %s cannot get replica for keygroup %s", user, k.Name)
+	}
+
+	if len(clients) == 0 {
+		return nil, 0, errors.Errorf("no client locations given")
+	}
+
+	for _, l := range clients {
+		if err := checkLocation(l); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	if n < 0 {
+		return nil, 0, errors.Errorf("number of replicas must not be negative, got %d", n)
+	}
+
+	nodes, mean, err := h.r.proposeReplicaSet(k.Name, clients, n)
+
+	if err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return nil, 0, errors.Errorf("error proposing replicas")
+	}
+
+	return nodes, mean, nil
+}
+
+// HandleAddUser adds permissions to a keygroup to a new user.
+func (h *ExtHandler) HandleAddUser(user string, newuser string, k Keygroup, r Role) error {
+	allowed, err := h.a.isAllowed(user, AddUser, k.Name)
+
+	if err != nil || !allowed {
+		return errors.Errorf("user %s cannot add user permissions to keygroup %s", user, k.Name)
+	}
+
+	return h.a.addRoles(newuser, []Role{r}, k.Name)
+}
+
+// HandleRemoveUser removes permissions to a keygroup to a user.
+func (h *ExtHandler) HandleRemoveUser(user string, newuser string, k Keygroup, r Role) error {
+	allowed, err := h.a.isAllowed(user, RemoveUser, k.Name)
+
+	if err != nil || !allowed {
+		return errors.Errorf("user %s cannot remove user permissions to keygroup %s", user, k.Name)
+	}
+
+	return h.a.revokeRoles(newuser, []Role{r}, k.Name)
+}
+
+// HandleCloneKeygroup handles requests to the CloneKeygroup endpoint of the client interface. replicas maps the
+// replicas of the copy to their expiry, if it is empty, the copy has the same replicas as the keygroup.
+func (h *ExtHandler) HandleCloneKeygroup(user string, src Keygroup, dst Keygroup, replicas map[NodeID]int) error {
+	allowed, err := h.a.isAllowed(user, Read, src.Name)
+
+	if err != nil || !allowed {
+		return errors.Errorf("user %s cannot clone keygroup %s", user, src.Name)
+	}
+
+	if err := checkKeygroup(dst.Name); err != nil {
+		return err
+	}
+
+	if err := h.r.cloneKeygroup(src.Name, dst.Name, replicas); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return errors.Errorf("error cloning keygroup")
+	}
+
+	// like for a new keygroup, the user that clones a keygroup gets all rights for the copy
+	return h.a.addRoles(user, []Role{ReadKeygroup, WriteKeygroup, ConfigureReplica, ConfigureTrigger, ConfigureKeygroups}, dst.Name)
+}
+
+// HandleRenameKeygroup handles requests to the RenameKeygroup endpoint of the client interface.
+func (h *ExtHandler) HandleRenameKeygroup(user string, src Keygroup, dst Keygroup) error {
+	allowed, err := h.a.isAllowed(user, DeleteKeygroup, src.Name)
+
+	if err != nil || !allowed {
+		return errors.Errorf("user %s cannot rename keygroup %s", user, src.Name)
+	}
+
+	if err := checkKeygroup(dst.Name); err != nil {
+		return err
+	}
+
+	if err := h.r.renameKeygroup(src.Name, dst.Name); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return errors.Errorf("error renaming keygroup")
+	}
+
+	return nil
+}
+
+// HandleExportKeygroup handles requests to the ExportKeygroup endpoint of the client interface. It passes the
+// configuration of the keygroup to header first and then all versions of all items to send, page by page.
+func (h *ExtHandler) HandleExportKeygroup(user string, k Keygroup, header func(a KeygroupArchive) error, send func(items []Item) error) error {
+	allowed, err := h.a.isAllowed(user, ExportKeygroup, k.Name)
+
+	if err != nil || !allowed {
+		return errors.Errorf("user %s cannot export keygroup %s", user, k.Name)
+	}
+
+	if !h.s.existsKeygroup(k.Name) {
+		return errors.Errorf("keygroup %s has no replica on this node", k.Name)
+	}
+
+	a, err := h.archiveKeygroup(k)
+
+	if err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return errors.Errorf("error exporting keygroup")
+	}
+
+	if err := header(a); err != nil {
+		return err
+	}
+
+	after := ""
+
+	for {
+		items, err := h.s.readPage(k.Name, after, transferPageSize)
+
+		if err != nil {
+			log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+			return errors.Errorf("error exporting keygroup")
+		}
+
+		if len(items) == 0 {
+			return nil
+		}
+
+		if err := send(items); err != nil {
+			return err
+		}
+
+		after = items[len(items)-1].ID
+	}
+}
+
+// HandleImportKeygroup handles requests to the ImportKeygroup endpoint of the client interface. If the keygroup of the
+// archive does not exist, it is created on this node with the configuration of the archive. Otherwise, its items are
+// replaced if replace is set: imported versions are rebased onto the existing versions so that they replace them, and
+// items that are not in the archive are deleted. next returns the items of the archive page by page and an empty page
+// at the end. Every page counts against the quota of the keygroup, if it is exceeded, the import stops with a
+// *QuotaError. Afterwards, the triggers, replicas, and user permissions of the archive are restored.
+func (h *ExtHandler) HandleImportKeygroup(user string, a KeygroupArchive, replace bool, next func() ([]Item, error)) (ImportResult, error) {
+	var result ImportResult
+
+	exists, err := h.n.ExistsKeygroup(a.Name)
+
+	if err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return result, errors.Errorf("error importing keygroup")
+	}
+
+	if exists {
+		if !replace {
+			return result, errors.Errorf("keygroup %s already exists, its data can only be replaced", a.Name)
+		}
+
+		allowed, err := h.a.isAllowed(user, ImportKeygroup, a.Name)
+
+		if err != nil || !allowed {
+			return result, errors.Errorf("user %s cannot import keygroup %s", user, a.Name)
+		}
+
+		if !h.s.existsKeygroup(a.Name) {
+			return result, errors.Errorf("keygroup %s has no replica on this node", a.Name)
+		}
+
+		mutable, err := h.n.IsMutable(a.Name)
+
+		if err != nil {
+			return result, err
+		}
+
+		if !mutable {
+			return result, errors.Errorf("cannot replace data of keygroup %s because keygroup is immutable", a.Name)
+		}
+
+		t, err := h.n.GetKeygroupType(a.Name)
+
+		if err != nil {
+			return result, err
+		}
+
+		if !a.Mutable || t != a.Type {
+			return result, errors.Errorf("keygroup %s does not have the same mutability and type as the archive", a.Name)
+		}
+
+		if err := h.checkWritable(a.Name); err != nil {
+			return result, err
+		}
+	} else {
+		k := a.Keygroup
+
+		// this node keeps its expiry if it was a member of the keygroup, otherwise it uses the one of the source
+		if e, ok := a.Expiries[h.n.GetNodeID()]; ok {
+			k.Expiry = e
+		}
+
+		if err := h.HandleCreateKeygroup(user, k); err != nil {
+			return result, err
+		}
+	}
+
+	imported := make(map[string]vclock.VClock)
+
+	for {
+		items, err := next()
+
+		if err != nil {
+			return result, err
+		}
+
+		if len(items) == 0 {
+			break
+		}
+
+		for j := range items {
+			items[j].Keygroup = a.Name
+		}
+
+		d, err := h.admit(a.Name, items)
+
+		if err != nil {
+			return result, err
+		}
+
+		stored, err := h.r.importItems(a.Name, items, imported)
+
+		if err != nil {
+			h.q.release(a.Name, d)
+			log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+			return result, errors.Errorf("error importing items")
+		}
+
+		h.q.commit(a.Name, d)
+
+		for _, i := range stored {
+			h.w.notify(i)
+
+			if err := h.c.record(i, false, h.c.id); err != nil {
+				log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+			}
+
+			if err := h.t.triggerUpdate(i); err != nil {
+				log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+				return result, errors.Errorf("error importing items")
+			}
+		}
+
+		result.Versions += len(stored)
+	}
+
+	if exists {
+		result.Deleted, err = h.deleteMissing(a.Name, imported)
+
+		if err != nil {
+			log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+			return result, errors.Errorf("error deleting items that are not in the archive")
+		}
+	}
+
+	for _, t := range a.Triggers {
+		if err := h.t.addTrigger(a.Keygroup, t); err != nil {
+			log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+			return result, errors.Errorf("error restoring triggers")
+		}
+	}
+
+	// replicas are restored before the permissions of the archive so that those cannot allow the user to add them
+	if err := h.restoreReplicas(user, a, &result); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return result, errors.Errorf("error restoring replicas")
+	}
+
+	for u, methods := range a.Permissions {
+		for _, m := range methods {
+			if err := h.n.AddUserPermissions(u, m, a.Name); err != nil {
+				log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+				return result, errors.Errorf("error restoring user permissions")
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// HandleWatch handles requests to the Watch endpoint of the client interface.
+// It sends all changes to items in the keygroup whose ids start with i.ID to send until done is closed.
+func (h *ExtHandler) HandleWatch(user string, i Item, from vclock.VClock, done <-chan struct{}, send func(i Item) error) error {
+	allowed, err := h.a.isAllowed(user, Read, i.Keygroup)
+
+	if err != nil || !allowed {
+		return errors.Errorf("user %s cannot read from keygroup %s", user, i.Keygroup)
+	}
+
+	if !h.s.existsKeygroup(i.Keygroup) {
+		return errors.Errorf("no such keygroup in store: %+v", i.Keygroup)
+	}
+
+	if err := h.w.watch(h.s, i.Keygroup, i.ID, from, done, send); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return errors.Errorf("error watching keygroup")
+	}
+
+	return nil
+}
+
+// HandleReadChanges handles requests to the ReadChanges endpoint of the client interface. It sends all changes to the
+// keygroup from the given sequence number to send until done is closed. If no sequence number is given, a consumer
+// continues after its stored cursor.
+func (h *ExtHandler) HandleReadChanges(user string, k Keygroup, from uint64, consumer string, done <-chan struct{}, send func(c Change) error) error {
+	allowed, err := h.a.isAllowed(user, Read, k.Name)
+
+	if err != nil || !allowed {
+		return errors.Errorf("user %s cannot read from keygroup %s", user, k.Name)
+	}
+
+	if !h.c.enabled() {
+		return errors.Errorf("this node has no change log")
+	}
+
+	if from == 0 && consumer != "" {
+		c, err := h.c.cursor(k.Name, consumer)
+
+		if err != nil {
+			log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+			return errors.Errorf("error reading cursor of consumer %s", consumer)
+		}
+
+		if c > 0 {
+			from = c + 1
+		}
+	}
+
+	if err := h.c.read(k.Name, from, done, send); err != nil {
+		var terr *TruncatedError
+		if errors.As(err, &terr) {
+			return err
+		}
+
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return errors.Errorf("error reading changes of keygroup %s", k.Name)
+	}
+
+	return nil
+}
+
+// HandleSetChangeCursor handles requests to the SetChangeCursor endpoint of the client interface. It stores the
+// sequence number of the last change that a consumer has processed.
+func (h *ExtHandler) HandleSetChangeCursor(user string, k Keygroup, consumer string, seq uint64) error {
+	allowed, err := h.a.isAllowed(user, Read, k.Name)
+
+	if err != nil || !allowed {
+		return errors.Errorf("user %s cannot read from keygroup %s", user, k.Name)
+	}
+
+	if !h.c.enabled() {
+		return errors.Errorf("this node has no change log")
+	}
+
+	if consumer == "" {
+		return errors.Errorf("a consumer is required to store a cursor")
+	}
+
+	if err := h.c.setCursor(k.Name, consumer, seq); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return errors.Errorf("error storing cursor of consumer %s", consumer)
+	}
+
+	return nil
+}
+
+// HandleBatch handles requests to the Batch endpoint of the client interface.
+// All items are updated or deleted atomically, both on this node and on all other replicas.
+func (h *ExtHandler) HandleBatch(user string, k Keygroup, items []Item) ([]Item, error) {
+	for _, i := range items {
+		m := Update
+		if i.Tombstoned {
+			m = Delete
+		}
+
+		allowed, err := h.a.isAllowed(user, m, k.Name)
+
+		if err != nil || !allowed {
+			return nil, errors.Errorf("user %s cannot write batch to keygroup %s", user, k.Name)
+		}
+	}
+
+	m, err := h.n.IsMutable(k.Name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !m {
+		return nil, errors.Errorf("cannot write batch because keygroup %s is immutable", k.Name)
+	}
+
+	if err := h.checkWritable(k.Name); err != nil {
+		return nil, err
+	}
+
+	if err := h.checkPlain(k.Name); err != nil {
+		return nil, err
+	}
+
+	for _, i := range items {
+		if i.Tombstoned && !h.s.exists(i) {
+			return nil, errors.Errorf("item %s does not exist so it cannot be deleted", i.ID)
+		}
+	}
+
+	expiry, err := h.n.GetExpiry(k.Name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := h.admit(k.Name, items)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// all writes of a batch happen at the same time
+	t := h.s.clock.now()
+
+	for j := range items {
+		items[j].Timestamp = t
+	}
+
+	versions, err := h.s.batch(k.Name, items, expiry)
+
+	if err != nil {
+		h.q.release(k.Name, d)
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return nil, errors.Errorf("error writing batch")
+	}
+
+	h.q.commit(k.Name, d)
+
+	for j := range items {
+		items[j].Version = versions[j]
+
+		if items[j].Tombstoned {
+			items[j].Val = ""
+		}
+
+		h.w.notify(items[j])
+
+		if err := h.c.record(items[j], false, h.c.id); err != nil {
+			log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		}
+	}
+
+	if err := h.r.relayBatch(k.Name, items); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return nil, errors.Errorf("error writing batch")
+	}
+
+	for _, i := range items {
+		trigger := h.t.triggerUpdate
+		if i.Tombstoned {
+			trigger = h.t.triggerDelete
+		}
+
+		if err := trigger(i); err != nil {
+			log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+			return nil, errors.Errorf("error writing batch")
+		}
+	}
+
+	return items, nil
+}
+
+// checkPlain checks that the items of a keygroup can be written directly, i.e., that they are not CRDTs.
+func (h *ExtHandler) checkPlain(kg KeygroupName) error {
+	t, err := h.n.GetKeygroupType(kg)
+
+	if err != nil {
+		return err
+	}
+
+	if t != Plain {
+		return errors.Errorf("items of keygroup %s are of type %s and can only be changed with its operations", kg, t)
+	}
+
+	return nil
+}
+
+// checkWritable checks that clients may write to a keygroup, i.e., that it is not read-only while it is renamed.
+func (h *ExtHandler) checkWritable(kg KeygroupName) error {
+	r, err := h.n.IsReadOnly(kg)
+
+	if err != nil {
+		return err
+	}
+
+	if r {
+		return errors.Errorf("keygroup %s is read-only while it is renamed", kg)
+	}
+
+	return nil
+}
+
+// admit checks whether writing some items stays within the quota of their keygroup and returns how the write changes
+// the usage of the keygroup, which has to be committed or released afterwards. If it does not, a *QuotaError is
+// returned.
+func (h *ExtHandler) admit(kg KeygroupName, items []Item) (Usage, error) {
+	d, err := h.q.admit(kg, items)
+
+	if err != nil {
+		var qerr *QuotaError
+		if errors.As(err, &qerr) {
+			return d, qerr
+		}
+
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return d, errors.Errorf("error checking quota of keygroup %s", kg)
+	}
+
+	return d, nil
+}
+
+// materializeItems replaces the states of CRDT items with their values.
+func (h *ExtHandler) materializeItems(kg KeygroupName, items []Item) ([]Item, error) {
+	t, err := h.n.GetKeygroupType(kg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if t == Plain {
+		return items, nil
+	}
+
+	for j := range items {
+		if items[j].Tombstoned {
+			continue
+		}
+
+		items[j].Val, err = materialize(t, items[j].Val)
+
+		if err != nil {
+			log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+			return nil, errors.Errorf("error reading item %s from keygroup %s", items[j].ID, kg)
+		}
+	}
+
+	return items, nil
+}
+
+// modifyCRDT applies an operation to the state of a CRDT item and replicates the new state. Concurrent versions that
+// the item has are merged first.
+func (h *ExtHandler) modifyCRDT(user string, i Item, t KeygroupType, op func(state string, timestamp uint64) (string, error)) (Item, error) {
+	allowed, err := h.a.isAllowed(user, Update, i.Keygroup)
+
+	if err != nil || !allowed {
+		return i, errors.Errorf("user %s cannot update in keygroup %s", user, i.Keygroup)
+	}
+
+	kt, err := h.n.GetKeygroupType(i.Keygroup)
+
+	if err != nil {
+		return i, err
+	}
+
+	if kt != t {
+		return i, errors.Errorf("items of keygroup %s are of type %s, not %s", i.Keygroup, kt, t)
+	}
+
+	if err := h.checkWritable(i.Keygroup); err != nil {
+		return i, err
+	}
+
+	expiry, err := h.n.GetExpiry(i.Keygroup)
+
+	if err != nil {
+		return i, err
+	}
+
+	i.Timestamp = h.s.clock.now()
+
+	// the new state is only known once the operation is applied, so it is admitted right before it is written
+	var d Usage
+	admitted := false
+
+	i, err = h.s.modify(i, expiryFor(i, expiry), func(vals []string) (string, error) {
+		state, err := mergeStates(t, vals)
+
+		if err != nil {
+			return "", err
+		}
+
+		state, err = op(state, i.Timestamp)
+
+		if err != nil {
+			return "", err
+		}
+
+		d, err = h.admit(i.Keygroup, []Item{{Keygroup: i.Keygroup, ID: i.ID, Val: state}})
+
+		if err != nil {
+			return "", err
+		}
+
+		admitted = true
+
+		return state, nil
+	})
+
+	if err != nil {
+		if admitted {
+			h.q.release(i.Keygroup, d)
+		}
+
+		var qerr *QuotaError
+		if errors.As(err, &qerr) {
+			return i, qerr
+		}
+
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return i, errors.Errorf("error updating item")
+	}
+
+	h.q.commit(i.Keygroup, d)
+
+	h.w.notify(i)
+
+	if err := h.c.record(i, false, h.c.id); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+	}
+
+	if err := h.r.relayUpdate(i); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return i, errors.Errorf("error updating item")
+	}
+
+	if err := h.t.triggerUpdate(i); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return i, errors.Errorf("error updating item")
+	}
+
+	return i, nil
+}
+
+// HandleIncrement handles requests to the Increment endpoint of the client interface. It adds delta, which may be
+// negative, to a counter and returns the new value of the counter.
+func (h *ExtHandler) HandleIncrement(user string, i Item, delta int64) (Item, int64, error) {
+	i, err := h.modifyCRDT(user, i, PNCounter, func(state string, _ uint64) (string, error) {
+		return increment(state, h.s.id, delta)
+	})
+
+	if err != nil {
+		return i, 0, err
+	}
+
+	v, err := counterValue(i.Val)
+
+	if err != nil {
+		return i, 0, err
+	}
+
+	return i, v, nil
+}
+
+// HandleSetAdd handles requests to the SetAdd endpoint of the client interface. It adds elements to a set and returns
+// the new elements of the set.
+func (h *ExtHandler) HandleSetAdd(user string, i Item, elements []string) (Item, []string, error) {
+	i, err := h.modifyCRDT(user, i, ORSet, func(state string, timestamp uint64) (string, error) {
+		return setAdd(state, h.s.id, timestamp, elements)
+	})
+
+	if err != nil {
+		return i, nil, err
+	}
+
+	e, err := setElements(i.Val)
+
+	if err != nil {
+		return i, nil, err
+	}
+
+	return i, e, nil
+}
+
+// HandleSetRemove handles requests to the SetRemove endpoint of the client interface. It removes elements from a set
+// and returns the new elements of the set. Concurrent adds of the same elements on other replicas win.
+func (h *ExtHandler) HandleSetRemove(user string, i Item, elements []string) (Item, []string, error) {
+	i, err := h.modifyCRDT(user, i, ORSet, func(state string, _ uint64) (string, error) {
+		return setRemove(state, elements)
+	})
+
+	if err != nil {
+		return i, nil, err
+	}
+
+	e, err := setElements(i.Val)
+
+	if err != nil {
+		return i, nil, err
+	}
+
+	return i, e, nil
+}
+
+// HandleMapUpdate handles requests to the MapPut and MapRemove endpoints of the client interface. It sets and removes
+// keys of a map and returns the new entries of the map.
+func (h *ExtHandler) HandleMapUpdate(user string, i Item, entries map[string]string, removed []string) (Item, map[string]string, error) {
+	i, err := h.modifyCRDT(user, i, LWWMap, func(state string, timestamp uint64) (string, error) {
+		return mapSet(state, h.s.id, timestamp, entries, removed)
+	})
+
+	if err != nil {
+		return i, nil, err
+	}
+
+	e, err := mapEntries(i.Val)
+
+	if err != nil {
+		return i, nil, err
+	}
+
+	return i, e, nil
 }
@@ -1,6 +1,8 @@
 package fred
 
 import (
+	"time"
+
 	"github.com/go-errors/errors"
 	"github.com/rs/zerolog/log"
 )
@@ -13,12 +15,22 @@ type Config struct {
 	PeeringHost             string
 	PeeringHostProxy        string
 	PeeringAsyncReplication bool
+	AntiEntropyInterval     int
+	TombstoneGCInterval     int
+	ReconcileInterval       int
+	Outbox                  Outbox
+	OutboxSize              int
+	ChangeLog               ChangeLog
+	ChangeLogEntries        int
+	ChangeLogWindow         int
 	ExternalHost            string
 	ExternalHostProxy       string
 	NodeID                  string
+	NodeInfo                NodeInfo
 	TriggerCert             string
 	TriggerKey              string
 	TriggerCA               []string
+	Admins                  []string
 }
 
 // Fred is an instance of FReD.
@@ -48,13 +60,50 @@ func New(config *Config) (f Fred) {
 		}
 	}
 
+	if err := config.NaSe.SetNodeInfo(config.NodeInfo); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		panic(err)
+	}
+
 	s := newStoreService(config.Store, config.NaSe.GetNodeID())
 
-	r := newReplicationService(s, config.Client, config.NaSe, config.PeeringAsyncReplication)
+	// if building the indexes fails, they are built again on the first query
+	if err := s.rebuildIndexes(config.NaSe); err != nil {
+		log.Warn().Msgf("could not build indexes: %s", err.Error())
+	}
+
+	w := newWatchService()
+
+	c := newChangeLogService(config.ChangeLog, config.NaSe.GetNodeID(), uint64(config.ChangeLogEntries), time.Duration(config.ChangeLogWindow)*time.Second)
+
+	r := newReplicationService(s, config.Client, config.NaSe, w, c, config.PeeringAsyncReplication, config.Outbox, uint64(config.OutboxSize))
+
+	// without knowing which nodes are alive, we still replicate to all of them and find out when sending fails
+	if err := r.watchLiveness(); err != nil {
+		log.Warn().Msgf("could not watch which nodes are alive: %s", err.Error())
+	}
+
+	if config.PeeringAsyncReplication && config.Outbox != nil {
+		// deliver whatever was left in the outbox when we were last stopped
+		err := r.startOutbox()
+
+		if err != nil {
+			log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+			panic(err)
+		}
+	}
 
 	t := newTriggerService(s, config.TriggerCert, config.TriggerKey, config.TriggerCA)
 
-	a := newAuthService(config.NaSe)
+	a := newAuthService(config.NaSe, config.Admins)
+
+	q := newQuotaService(s, config.NaSe)
+
+	go q.runRecount(usageRefresh)
+
+	if config.ChangeLog != nil && (config.ChangeLogEntries > 0 || config.ChangeLogWindow > 0) {
+		go c.runRetention(changeLogRetentionInterval)
+	}
 
 	// TODO this code should live somewhere where it is called every n seconds, but for testing purposes the easiest way
 	// TODO to simulate an internet shutdown is via killing a node, so testing once at startup should be enough
@@ -75,7 +124,7 @@ func New(config *Config) (f Fred) {
 			expiry, _ := config.NaSe.GetExpiry(item.Keygroup)
 
 			for _, x := range items {
-				_, err = s.update(x, expiry)
+				_, err = s.update(x, expiry, nil)
 				if err != nil {
 					log.Error().Msgf("Could not update missed item %s", x.ID)
 				}
@@ -86,8 +135,24 @@ func New(config *Config) (f Fred) {
 		log.Debug().Msg("NodeStatus: No updates were missed by this node.")
 	}
 
+	// the failed node log only covers failures we know about, periodically comparing our data with other replicas
+	// also catches updates that were lost otherwise
+	if config.AntiEntropyInterval > 0 {
+		go r.runAntiEntropy(time.Duration(config.AntiEntropyInterval) * time.Second)
+	}
+
+	// deleted items are kept as tombstones until every replica has seen the delete, then they can be removed
+	if config.TombstoneGCInterval > 0 {
+		go r.runTombstoneGC(time.Duration(config.TombstoneGCInterval) * time.Second)
+	}
+
+	// one node at a time adds replicas to keygroups that have fewer replicas alive than their replication policy asks for
+	if config.ReconcileInterval > 0 {
+		go r.runReconciler(time.Duration(config.ReconcileInterval) * time.Second)
+	}
+
 	return Fred{
-		E: newExthandler(s, r, t, a, config.NaSe),
-		I: newInthandler(s, r, t, config.NaSe),
+		E: newExthandler(s, r, t, a, w, q, c, config.NaSe),
+		I: newInthandler(s, r, t, w, c, config.NaSe),
 	}
 }
@@ -135,7 +135,7 @@ func testPut(t *testing.T, user string, kg fred.KeygroupName, id string, value s
 		Keygroup: kg,
 		ID:       id,
 		Val:      value,
-	}, nil)
+	}, nil, nil)
 
 	assert.NoError(t, err)
 
@@ -173,14 +173,14 @@ func testDelete(t *testing.T, user string, kg fred.KeygroupName, id string, valu
 		Keygroup: kg,
 		ID:       id,
 		Val:      value,
-	}, nil)
+	}, nil, nil)
 
 	assert.NoError(t, err)
 
 	_, err = f.E.HandleDelete(user, fred.Item{
 		Keygroup: kg,
 		ID:       id,
-	}, nil)
+	}, nil, nil)
 
 	assert.NoError(t, err)
 
@@ -213,7 +213,7 @@ func testMisformedKVInput(t *testing.T, user string, kg fred.KeygroupName, id st
 		Keygroup: kg,
 		ID:       id,
 		Val:      value,
-	}, nil)
+	}, nil, nil)
 
 	assert.Error(t, err)
 }
@@ -221,7 +221,9 @@ func testMisformedKVInput(t *testing.T, user string, kg fred.KeygroupName, id st
 func TestMisformedKVInput(t *testing.T) {
 	testMisformedKVInput(t, "user", "misformed0", "id(", "value")
 	testMisformedKVInput(t, "user", "misformed1", "id(", "value=")
-	testMisformedKVInput(t, "user", "misformed2", "id", "")
+
+	// empty values are values like any other, only tombstones mark deleted items
+	testPut(t, "user", "misformed2", "id", "")
 }
 
 func testMisformedKeygroupInput(t *testing.T, user string, kg fred.KeygroupName, _ string, _ string) {
@@ -272,7 +274,7 @@ func testScan(t *testing.T, user string, kg fred.KeygroupName, mutable bool, upd
 				Keygroup: kg,
 				ID:       ids[i],
 				Val:      vals[i],
-			}, nil)
+			}, nil, nil)
 			assert.NoError(t, err)
 		} else {
 			ids[i] = strconv.Itoa(i)
@@ -299,10 +301,10 @@ func testScan(t *testing.T, user string, kg fred.KeygroupName, mutable bool, upd
 		startKey = strconv.Itoa(scanStart)
 	}
 
-	items, err := f.E.HandleScan(user, fred.Item{
+	items, _, err := f.E.HandleScan(user, fred.Item{
 		Keygroup: kg,
 		ID:       startKey,
-	}, uint64(scanRange))
+	}, uint64(scanRange), fred.ScanOptions{})
 
 	// if scanRange == 0 we expect an empty array
 	if scanRange == 0 {
@@ -373,7 +375,7 @@ func TestPermissions(t *testing.T) {
 		Keygroup: kg,
 		ID:       "id",
 		Val:      "value",
-	}, nil)
+	}, nil, nil)
 
 	assert.NoError(t, err)
 
@@ -381,7 +383,7 @@ func TestPermissions(t *testing.T) {
 		Keygroup: kg,
 		ID:       "id2",
 		Val:      "value2",
-	}, nil)
+	}, nil, nil)
 
 	assert.Error(t, err)
 
@@ -423,7 +425,7 @@ func TestPermissions(t *testing.T) {
 		Keygroup: kg,
 		ID:       "id2",
 		Val:      "value2",
-	}, nil)
+	}, nil, nil)
 
 	assert.Error(t, err)
 
@@ -455,7 +457,7 @@ func TestVersioning(t *testing.T) {
 			Keygroup: kg,
 			ID:       "item",
 			Val:      "val" + strconv.Itoa(i),
-		}, nil)
+		}, nil, nil)
 
 		assert.NoError(t, err)
 	}
@@ -476,7 +478,7 @@ func TestVersioning(t *testing.T) {
 		Keygroup: kg,
 		ID:       "item",
 		Val:      "val10",
-	}, []vclock.VClock{items[0].Version})
+	}, []vclock.VClock{items[0].Version}, nil)
 
 	assert.NoError(t, err)
 	assert.Equal(t, uint64(11), v.Version[string(nodeID)])
@@ -488,7 +490,7 @@ func TestVersioning(t *testing.T) {
 		Keygroup: kg,
 		ID:       "item",
 		Val:      "val10",
-	}, []vclock.VClock{old})
+	}, []vclock.VClock{old}, nil)
 
 	assert.Error(t, err)
 
@@ -498,7 +500,7 @@ func TestVersioning(t *testing.T) {
 		Keygroup: kg,
 		ID:       "item",
 		Val:      "val10",
-	}, []vclock.VClock{v.Version})
+	}, []vclock.VClock{v.Version}, nil)
 
 	assert.NoError(t, err)
 	assert.Equal(t, uint64(12), v.Version[string(nodeID)])
@@ -524,7 +526,7 @@ func testConcurrentVersioning(t *testing.T, user string, kg fred.KeygroupName, n
 					Keygroup: kg,
 					ID:       "item",
 					Val:      val,
-				}, nil)
+				}, nil, nil)
 
 				// TODO: this assumes that the channel updates are done in the same order as the updates
 				// this is not guaranteed
@@ -628,7 +630,7 @@ func TestDistributedVersioning(t *testing.T) {
 		Keygroup: kg,
 		ID:       "Item1",
 		Val:      "val1",
-	}, nil)
+	}, nil, nil)
 
 	assert.NoError(t, err)
 	expectedVX := vclock.VClock{}
@@ -641,7 +643,7 @@ func TestDistributedVersioning(t *testing.T) {
 	vY := vclock.VClock{}
 	vY.Tick(othernode)
 
-	err = f.I.HandleUpdate(fred.Item{
+	err = f.I.HandleUpdate(fred.NodeID(othernode), fred.Item{
 		Keygroup: kg,
 		ID:       "Item1",
 		Val:      "val2",
@@ -666,7 +668,7 @@ func TestDistributedVersioning(t *testing.T) {
 	vY.Merge(expectedVX)
 	vY.Tick(othernode)
 
-	err = f.I.HandleUpdate(fred.Item{
+	err = f.I.HandleUpdate(fred.NodeID(othernode), fred.Item{
 		Keygroup: kg,
 		ID:       "Item1",
 		Val:      "val3",
@@ -692,7 +694,7 @@ func TestDistributedVersioning(t *testing.T) {
 	_, err = f.E.HandleDelete(user, fred.Item{
 		Keygroup: kg,
 		ID:       "Item1",
-	}, []vclock.VClock{expectedVX.Copy()})
+	}, []vclock.VClock{expectedVX.Copy()}, nil)
 
 	assert.Error(t, err)
 
@@ -703,7 +705,7 @@ func TestDistributedVersioning(t *testing.T) {
 	item, err := f.E.HandleDelete(user, fred.Item{
 		Keygroup: kg,
 		ID:       "Item1",
-	}, []vclock.VClock{vY.Copy()})
+	}, []vclock.VClock{vY.Copy()}, nil)
 
 	expectedVX = vY.Copy()
 	expectedVX.Tick(string(nodeID))
@@ -782,7 +784,7 @@ func TestInternalPut(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	err = f.I.HandleUpdate(fred.Item{
+	err = f.I.HandleUpdate("Y", fred.Item{
 		Keygroup: kg,
 		ID:       id,
 		Val:      val,
@@ -828,7 +830,7 @@ func TestInternalDelete(t *testing.T) {
 		Keygroup: kg,
 		ID:       id,
 		Val:      val,
-	}, nil)
+	}, nil, nil)
 
 	assert.NoError(t, err)
 
@@ -841,7 +843,7 @@ func TestInternalDelete(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, items, 1)
 
-	err = f.I.HandleUpdate(fred.Item{
+	err = f.I.HandleUpdate("Y", fred.Item{
 		Keygroup:   kg,
 		ID:         id,
 		Version:    version,
@@ -874,7 +876,7 @@ func TestInternalAppend(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	err = f.I.HandleAppend(fred.Item{
+	err = f.I.HandleAppend("Y", fred.Item{
 		Keygroup: kg,
 		ID:       id,
 		Val:      val,
@@ -934,7 +936,7 @@ func BenchmarkPut(b *testing.B) {
 			Keygroup: kg,
 			ID:       id,
 			Val:      value,
-		}, nil)
+		}, nil, nil)
 
 		assert.NoError(b, err)
 	}
@@ -964,7 +966,7 @@ func BenchmarkGet(b *testing.B) {
 		Keygroup: kg,
 		ID:       id,
 		Val:      value,
-	}, nil)
+	}, nil, nil)
 
 	assert.NoError(b, err)
 
@@ -0,0 +1,187 @@
+package fred
+
+import (
+	"sort"
+
+	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/go-errors/errors"
+)
+
+// HistoryRetention decides which replaced versions of the items of a keygroup are kept so that earlier states of an item
+// can still be read. Versions is the number of replaced versions that are kept for each item and Window is the number
+// of seconds for which a replaced version is kept, 0 means that there is no limit. If both are 0, replaced versions are
+// removed right away. Replaced versions never outlive the expiry of the keygroup.
+type HistoryRetention struct {
+	Versions int
+	Window   int
+}
+
+// Revision is a version of an item in its history.
+type Revision struct {
+	Item
+	// Replaced is the time (in unix seconds) at which the version was replaced, 0 if it is a current version.
+	Replaced int64
+}
+
+// checkHistoryRetention checks that a history retention is valid.
+func checkHistoryRetention(h HistoryRetention) error {
+	if h.Versions < 0 {
+		return errors.Errorf("number of history versions must not be negative, got %d", h.Versions)
+	}
+
+	if h.Window < 0 {
+		return errors.Errorf("history window must not be negative, got %d", h.Window)
+	}
+
+	return nil
+}
+
+// setHistory sets which replaced versions of the items of a keygroup the store keeps.
+func (s *storeService) setHistory(kg KeygroupName, h HistoryRetention) error {
+	if err := checkKeygroup(kg); err != nil {
+		return err
+	}
+
+	if err := checkHistoryRetention(h); err != nil {
+		return err
+	}
+
+	if !s.iS.ExistsKeygroup(string(kg)) {
+		return errors.Errorf("no such keygroup in store: %+v", kg)
+	}
+
+	return s.iS.SetKeygroupHistory(string(kg), h.Versions, h.Window)
+}
+
+// lockItem locks the cached clock of an item if there is one, so that no versions of the item are replaced while we
+// read it. It returns a function to release the locks.
+func (s *storeService) lockItem(kg KeygroupName, id string) func() {
+	s.vCacheLock.RLock()
+
+	kc, ok := s.vCache[kg]
+
+	if !ok {
+		return s.vCacheLock.RUnlock
+	}
+
+	kc.RLock()
+
+	c, ok := kc.clocks[id]
+
+	if !ok {
+		return func() {
+			kc.RUnlock()
+			s.vCacheLock.RUnlock()
+		}
+	}
+
+	c.Lock()
+
+	return func() {
+		c.Unlock()
+		kc.RUnlock()
+		s.vCacheLock.RUnlock()
+	}
+}
+
+// readHistory returns all versions of an item that the store still has: first the replaced versions that are kept,
+// the oldest first, and then the current versions.
+func (s *storeService) readHistory(kg KeygroupName, id string) ([]Revision, error) {
+	if err := checkKGandID(kg, id); err != nil {
+		return nil, err
+	}
+
+	if !s.iS.ExistsKeygroup(string(kg)) {
+		return nil, errors.Errorf("no such keygroup in store: %+v", kg)
+	}
+
+	unlock := s.lockItem(kg, id)
+	defer unlock()
+
+	vals, tombstones, versions, replaced, err := s.iS.ReadHistory(string(kg), id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]Revision, len(vals))
+
+	for j := range vals {
+		revisions[j] = Revision{
+			Item: Item{
+				Keygroup:   kg,
+				ID:         id,
+				Val:        vals[j],
+				Version:    versions[j],
+				Tombstoned: tombstones[j],
+			},
+			Replaced: replaced[j],
+		}
+	}
+
+	// the order of replaced versions with the same time does not matter, but it should be the same every time
+	sort.SliceStable(revisions, func(a, b int) bool {
+		return revisions[a].Replaced < revisions[b].Replaced
+	})
+
+	vals, tombstones, versions, found, err := s.iS.Read(string(kg), id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return revisions, nil
+	}
+
+	for j := range vals {
+		revisions = append(revisions, Revision{
+			Item: Item{
+				Keygroup:   kg,
+				ID:         id,
+				Val:        vals[j],
+				Version:    versions[j],
+				Tombstoned: tombstones[j],
+			},
+		})
+	}
+
+	return revisions, nil
+}
+
+// readAt returns the versions of an item that a client that has seen the given version would have read: all versions
+// that are equal to or older than the given version and that are not older than another one of those versions.
+func (s *storeService) readAt(kg KeygroupName, id string, version vclock.VClock) ([]Item, error) {
+	revisions, err := s.readHistory(kg, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var seen []Item
+
+	for _, r := range revisions {
+		if version.Compare(r.Version, vclock.Equal|vclock.Ancestor) {
+			seen = append(seen, r.Item)
+		}
+	}
+
+	items := make([]Item, 0, len(seen))
+
+	for _, i := range seen {
+		newest := true
+
+		for _, o := range seen {
+			if i.Version.Compare(o.Version, vclock.Descendant) {
+				newest = false
+				break
+			}
+		}
+
+		if newest {
+			items = append(items, i)
+		}
+	}
+
+	return items, nil
+}
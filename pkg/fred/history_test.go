@@ -0,0 +1,64 @@
+package fred
+
+import (
+	"testing"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/badgerdb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadAt(t *testing.T) {
+	kg := KeygroupName("historykg")
+
+	store := badgerdb.NewMemory()
+	t.Cleanup(func() { _ = store.Close() })
+
+	s := newStoreService(store, "X")
+	assert.NoError(t, s.createKeygroup(kg))
+	assert.NoError(t, s.setHistory(kg, HistoryRetention{Versions: 2}))
+
+	assert.Error(t, s.setHistory(kg, HistoryRetention{Window: -1}))
+
+	v1, err := s.update(Item{Keygroup: kg, ID: "a", Val: "1"}, 0, nil)
+	assert.NoError(t, err)
+
+	v2, err := s.update(Item{Keygroup: kg, ID: "a", Val: "2"}, 0, nil)
+	assert.NoError(t, err)
+
+	v3, err := s.update(Item{Keygroup: kg, ID: "a", Val: "3"}, 0, nil)
+	assert.NoError(t, err)
+
+	v4, err := s.update(Item{Keygroup: kg, ID: "a", Val: "4"}, 0, nil)
+	assert.NoError(t, err)
+
+	revisions, err := s.readHistory(kg, "a")
+	assert.NoError(t, err)
+
+	// only the two newest replaced versions are kept
+	assert.Len(t, revisions, 3)
+	assert.Equal(t, "2", revisions[0].Val)
+	assert.Equal(t, "3", revisions[1].Val)
+	assert.Equal(t, "4", revisions[2].Val)
+	assert.NotZero(t, revisions[0].Replaced)
+	assert.Zero(t, revisions[2].Replaced)
+
+	items, err := s.readAt(kg, "a", v3)
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "3", items[0].Val)
+
+	items, err = s.readAt(kg, "a", v4)
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "4", items[0].Val)
+
+	items, err = s.readAt(kg, "a", v2)
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "2", items[0].Val)
+
+	// the first version is no longer kept
+	items, err = s.readAt(kg, "a", v1)
+	assert.NoError(t, err)
+	assert.Empty(t, items)
+}
@@ -0,0 +1,390 @@
+package fred
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/go-errors/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// jsonPath is a compiled JSON path expression such as $.room or $.readings[0].value. Each segment is either the name of
+// a field of an object or, in brackets, the index of an element of an array.
+type jsonPath []string
+
+// index maps the values that a JSON path selects in the items of a keygroup to the ids of those items.
+type index struct {
+	path jsonPath
+	ids  map[string]map[string]struct{}
+	keys map[string][]string
+}
+
+// keygroupIndexes are all indexes of a keygroup.
+type keygroupIndexes struct {
+	indexes map[string]*index
+	sync.RWMutex
+}
+
+// compileJSONPath parses a JSON path expression. The leading $ is optional.
+func compileJSONPath(expr string) (jsonPath, error) {
+	rest := strings.TrimPrefix(expr, "$")
+
+	path := make(jsonPath, 0)
+
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[")
+
+			if end == -1 {
+				end = len(rest) - 1
+			}
+
+			if end == 0 {
+				return nil, errors.Errorf("empty field name in JSON path %s", expr)
+			}
+
+			path = append(path, rest[1:end+1])
+			rest = rest[end+1:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+
+			if end == -1 {
+				return nil, errors.Errorf("unclosed bracket in JSON path %s", expr)
+			}
+
+			if _, err := strconv.ParseUint(rest[1:end], 10, 32); err != nil {
+				return nil, errors.Errorf("invalid array index %s in JSON path %s", rest[1:end], expr)
+			}
+
+			path = append(path, rest[:end+1])
+			rest = rest[end+1:]
+		default:
+			return nil, errors.Errorf("JSON path %s must start with $. or $[", expr)
+		}
+	}
+
+	if len(path) == 0 {
+		return nil, errors.Errorf("JSON path %s selects nothing", expr)
+	}
+
+	return path, nil
+}
+
+// checkIndexes checks that all index definitions of a keygroup have valid names and JSON paths.
+func checkIndexes(defs map[string]string) error {
+	for name, path := range defs {
+		if !reg.MatchString(name) {
+			return errors.Errorf("index name %s does not match %s", name, expr)
+		}
+
+		if _, err := compileJSONPath(path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// eval returns the value that the path selects in a JSON document, encoded as JSON. If the document is not valid JSON
+// or the path selects nothing, it returns false.
+func (p jsonPath) eval(doc string) (string, bool) {
+	var v interface{}
+
+	if err := json.Unmarshal([]byte(doc), &v); err != nil {
+		return "", false
+	}
+
+	for _, s := range p {
+		if s[0] == '[' {
+			a, ok := v.([]interface{})
+
+			if !ok {
+				return "", false
+			}
+
+			i, _ := strconv.Atoi(s[1 : len(s)-1])
+
+			if i >= len(a) {
+				return "", false
+			}
+
+			v = a[i]
+			continue
+		}
+
+		o, ok := v.(map[string]interface{})
+
+		if !ok {
+			return "", false
+		}
+
+		if v, ok = o[s]; !ok {
+			return "", false
+		}
+	}
+
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return "", false
+	}
+
+	return string(b), true
+}
+
+// canonicalJSON encodes a JSON value the same way that values in an index are encoded, e.g., 1.0 becomes 1.
+func canonicalJSON(value string) (string, error) {
+	var v interface{}
+
+	if err := json.Unmarshal([]byte(value), &v); err != nil {
+		return "", errors.Errorf("%s is not a JSON value: %s", value, err.Error())
+	}
+
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return "", errors.New(err)
+	}
+
+	return string(b), nil
+}
+
+// set indexes an item under the values that the path selects in its versions, replacing earlier entries.
+func (x *index) set(id string, vals []string, tombstones []bool) {
+	for _, k := range x.keys[id] {
+		delete(x.ids[k], id)
+
+		if len(x.ids[k]) == 0 {
+			delete(x.ids, k)
+		}
+	}
+
+	delete(x.keys, id)
+
+	for j, v := range vals {
+		// tombstones are not indexed
+		if tombstones[j] {
+			continue
+		}
+
+		k, ok := x.path.eval(v)
+
+		if !ok {
+			continue
+		}
+
+		if _, ok := x.ids[k]; !ok {
+			x.ids[k] = make(map[string]struct{})
+		}
+
+		x.ids[k][id] = struct{}{}
+		x.keys[id] = append(x.keys[id], k)
+	}
+}
+
+// setIndexes sets the index definitions (index name -> JSON path) of a keygroup and builds these indexes from the items
+// that are currently in the store. Existing indexes of that keygroup are replaced.
+func (s *storeService) setIndexes(kg KeygroupName, defs map[string]string) error {
+	k := &keygroupIndexes{
+		indexes: make(map[string]*index, len(defs)),
+	}
+
+	for name, expr := range defs {
+		p, err := compileJSONPath(expr)
+
+		if err != nil {
+			return err
+		}
+
+		k.indexes[name] = &index{
+			path: p,
+			ids:  make(map[string]map[string]struct{}),
+			keys: make(map[string][]string),
+		}
+	}
+
+	// we register the indexes before reading the items so that no update can slip through in between, indexing an
+	// item twice does not hurt
+	k.Lock()
+
+	s.idxLock.Lock()
+	s.idx[kg] = k
+	s.idxLock.Unlock()
+
+	defer k.Unlock()
+
+	after := ""
+
+	for {
+		ids, vals, tombstones, _, err := s.iS.ReadPage(string(kg), after, transferPageSize)
+
+		if err != nil {
+			return err
+		}
+
+		if len(ids) == 0 {
+			break
+		}
+
+		for i := 0; i < len(ids); {
+			j := i
+			for j < len(ids) && ids[j] == ids[i] {
+				j++
+			}
+
+			for _, x := range k.indexes {
+				x.set(ids[i], vals[i:j], tombstones[i:j])
+			}
+
+			i = j
+		}
+
+		after = ids[len(ids)-1]
+	}
+
+	log.Debug().Msgf("setIndexes from storeservice: built %d indexes for keygroup %s", len(defs), kg)
+
+	return nil
+}
+
+// rebuildIndexes builds the indexes of every keygroup that is in the store from their definitions in the NaSe. Indexes
+// are only kept in memory, so this has to be done whenever this node starts.
+func (s *storeService) rebuildIndexes(n NameService) error {
+	kgs, err := n.GetAllKeygroups()
+
+	if err != nil {
+		return err
+	}
+
+	for _, kg := range kgs {
+		if !s.existsKeygroup(kg) {
+			continue
+		}
+
+		defs, err := n.GetKeygroupIndexes(kg)
+
+		if err != nil {
+			return err
+		}
+
+		if len(defs) == 0 {
+			continue
+		}
+
+		if err := s.setIndexes(kg, defs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hasIndexes returns whether the indexes of a keygroup have been built since this node was started.
+func (s *storeService) hasIndexes(kg KeygroupName) bool {
+	s.idxLock.RLock()
+	defer s.idxLock.RUnlock()
+
+	_, ok := s.idx[kg]
+
+	return ok
+}
+
+// deleteIndexes removes all indexes of a keygroup.
+func (s *storeService) deleteIndexes(kg KeygroupName) {
+	s.idxLock.Lock()
+	defer s.idxLock.Unlock()
+
+	delete(s.idx, kg)
+}
+
+// reindex updates the indexes of a keygroup after an item has changed. The lock of the cached clock of that item has to
+// be held so that changes are indexed in order.
+func (s *storeService) reindex(kg KeygroupName, id string) {
+	s.idxLock.RLock()
+	k, ok := s.idx[kg]
+	s.idxLock.RUnlock()
+
+	if !ok {
+		return
+	}
+
+	vals, tombstones, _, _, err := s.iS.Read(string(kg), id)
+
+	if err != nil {
+		log.Err(err).Msgf("reindex from storeservice: could not read %s in keygroup %s, index may be outdated", id, kg)
+		return
+	}
+
+	k.Lock()
+	defer k.Unlock()
+
+	for _, x := range k.indexes {
+		x.set(id, vals, tombstones)
+	}
+}
+
+// queryIndex returns all items of a keygroup with a version in which the JSON path of an index selects the given
+// value, ordered by their ids. The value is compared as JSON, e.g., a string has to be given in quotes.
+func (s *storeService) queryIndex(kg KeygroupName, name string, value string) ([]Item, error) {
+	key, err := canonicalJSON(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.idxLock.RLock()
+	k, ok := s.idx[kg]
+	s.idxLock.RUnlock()
+
+	if !ok {
+		return nil, errors.Errorf("no indexes for keygroup %s", kg)
+	}
+
+	k.RLock()
+
+	x, ok := k.indexes[name]
+
+	if !ok {
+		k.RUnlock()
+		return nil, errors.Errorf("no index %s for keygroup %s", name, kg)
+	}
+
+	ids := make([]string, 0, len(x.ids[key]))
+
+	for id := range x.ids[key] {
+		ids = append(ids, id)
+	}
+
+	path := x.path
+
+	k.RUnlock()
+
+	sort.Strings(ids)
+
+	result := make([]Item, 0, len(ids))
+
+	for _, id := range ids {
+		items, err := s.read(kg, id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// the index may be outdated if an item has expired, so we check again
+		for _, i := range items {
+			if i.Tombstoned {
+				continue
+			}
+
+			if v, ok := path.eval(i.Val); ok && v == key {
+				result = append(result, i)
+			}
+		}
+	}
+
+	return result, nil
+}
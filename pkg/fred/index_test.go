@@ -0,0 +1,150 @@
+package fred
+
+import (
+	"testing"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/badgerdb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompileJSONPath(t *testing.T) {
+	p, err := compileJSONPath("$.readings[1].value")
+	assert.NoError(t, err)
+	assert.Equal(t, jsonPath{"readings", "[1]", "value"}, p)
+
+	p, err = compileJSONPath(".room")
+	assert.NoError(t, err)
+	assert.Equal(t, jsonPath{"room"}, p)
+
+	for _, expr := range []string{"", "$", "room", "$..room", "$.a[", "$.a[x]", "$.a[-1]"} {
+		_, err = compileJSONPath(expr)
+		assert.Error(t, err, expr)
+	}
+
+	v, ok := jsonPath{"readings", "[1]", "value"}.eval(`{"readings":[{"value":1},{"value":2.0}]}`)
+	assert.True(t, ok)
+	assert.Equal(t, "2", v)
+
+	_, ok = jsonPath{"readings", "[2]"}.eval(`{"readings":[1,2]}`)
+	assert.False(t, ok)
+
+	_, ok = jsonPath{"room"}.eval("not json")
+	assert.False(t, ok)
+}
+
+func TestQueryIndex(t *testing.T) {
+	store := badgerdb.NewMemory()
+	defer store.Close()
+
+	s := newStoreService(store, "X")
+
+	kg := KeygroupName("indexkg")
+
+	assert.NoError(t, s.createKeygroup(kg))
+
+	_, err := s.update(Item{Keygroup: kg, ID: "a", Val: `{"room":"kitchen","temp":21}`}, 0, nil)
+	assert.NoError(t, err)
+
+	// indexes are built from the items that already exist
+	assert.NoError(t, s.setIndexes(kg, map[string]string{"room": "$.room", "temp": "$.temp"}))
+
+	_, err = s.update(Item{Keygroup: kg, ID: "b", Val: `{"room":"kitchen","temp":19}`}, 0, nil)
+	assert.NoError(t, err)
+
+	_, err = s.update(Item{Keygroup: kg, ID: "c", Val: "no json"}, 0, nil)
+	assert.NoError(t, err)
+
+	query := func(name string, value string) []string {
+		items, err := s.queryIndex(kg, name, value)
+		assert.NoError(t, err)
+
+		ids := make([]string, len(items))
+		for i, item := range items {
+			ids[i] = item.ID
+		}
+
+		return ids
+	}
+
+	assert.Equal(t, []string{"a", "b"}, query("room", `"kitchen"`))
+	assert.Equal(t, []string{"a"}, query("temp", "21.0"))
+
+	// updates move an item to its new value
+	_, err = s.update(Item{Keygroup: kg, ID: "a", Val: `{"room":"hall","temp":21}`}, 0, nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"b"}, query("room", `"kitchen"`))
+	assert.Equal(t, []string{"a"}, query("room", `"hall"`))
+
+	// tombstoned items are removed from the index
+	_, err = s.tombstone(Item{Keygroup: kg, ID: "b"}, nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{}, query("room", `"kitchen"`))
+
+	_, err = s.queryIndex(kg, "nope", `"kitchen"`)
+	assert.Error(t, err)
+
+	_, err = s.queryIndex(kg, "room", "kitchen")
+	assert.Error(t, err)
+
+	assert.NoError(t, s.deleteKeygroup(kg))
+	assert.False(t, s.hasIndexes(kg))
+}
+
+// indexNaSe is a NameService that knows the index definitions of keygroups.
+type indexNaSe struct {
+	NameService
+	indexes map[KeygroupName]map[string]string
+}
+
+func (n *indexNaSe) GetAllKeygroups() ([]KeygroupName, error) {
+	kgs := make([]KeygroupName, 0, len(n.indexes))
+
+	for kg := range n.indexes {
+		kgs = append(kgs, kg)
+	}
+
+	return kgs, nil
+}
+
+func (n *indexNaSe) GetKeygroupIndexes(kg KeygroupName) (map[string]string, error) {
+	return n.indexes[kg], nil
+}
+
+func TestRebuildIndexes(t *testing.T) {
+	path := t.TempDir()
+	kg := KeygroupName("indexkg")
+	n := &indexNaSe{indexes: map[KeygroupName]map[string]string{
+		kg: {"room": "$.room"},
+		// this keygroup is not on this node
+		"otherkg": {"room": "$.room"},
+	}}
+
+	store := badgerdb.New(path)
+	s := newStoreService(store, "X")
+
+	assert.NoError(t, s.createKeygroup(kg))
+	assert.NoError(t, s.setIndexes(kg, n.indexes[kg]))
+
+	_, err := s.update(Item{Keygroup: kg, ID: "a", Val: `{"room":"kitchen"}`}, 0, nil)
+	assert.NoError(t, err)
+
+	assert.NoError(t, store.Close())
+
+	// after a restart, the indexes are built again from the items in the store
+	store = badgerdb.New(path)
+	defer store.Close()
+
+	s = newStoreService(store, "X")
+	assert.False(t, s.hasIndexes(kg))
+
+	assert.NoError(t, s.rebuildIndexes(n))
+	assert.True(t, s.hasIndexes(kg))
+	assert.False(t, s.hasIndexes("otherkg"))
+
+	items, err := s.queryIndex(kg, "room", `"kitchen"`)
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "a", items[0].ID)
+}
@@ -9,15 +9,19 @@ type IntHandler struct {
 	s *storeService
 	r *replicationService
 	t *triggerService
+	w *watchService
+	c *changeLogService
 	n NameService
 }
 
 // newInthandler creates a new handler for internal request (i.e. from peer nodes or the naming service).
-func newInthandler(s *storeService, r *replicationService, t *triggerService, n NameService) *IntHandler {
+func newInthandler(s *storeService, r *replicationService, t *triggerService, w *watchService, c *changeLogService, n NameService) *IntHandler {
 	return &IntHandler{
 		s: s,
 		r: r,
 		t: t,
+		w: w,
+		c: c,
 		n: n,
 	}
 }
@@ -46,6 +50,97 @@ func (h *IntHandler) HandleGetAllItems(k Keygroup) ([]Item, error) {
 	return data, nil
 }
 
+// HandleGetItemPage handles requests to the TransferKeygroup endpoint of the internal interface.
+func (h *IntHandler) HandleGetItemPage(k Keygroup, after string, count uint64) ([]Item, error) {
+	if count == 0 {
+		return nil, errors.Errorf("page size has to be greater than 0")
+	}
+
+	data, err := h.s.readPage(k.Name, after, count)
+
+	if err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return nil, errors.Errorf("error reading keygroup items")
+	}
+
+	h.s.addTimestamps(k.Name, data)
+
+	return data, nil
+}
+
+// HandleReceiveItemPage handles requests to the PushKeygroup endpoint of the internal interface.
+func (h *IntHandler) HandleReceiveItemPage(k Keygroup, source NodeID, items []Item) error {
+	if err := h.r.receiveItemPage(k.Name, source, items); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return errors.Errorf("error storing keygroup items")
+	}
+
+	return nil
+}
+
+// HandleGetMerkleTree handles requests to the GetMerkleTree endpoint of the internal interface.
+func (h *IntHandler) HandleGetMerkleTree(k Keygroup) ([]uint64, error) {
+	t, err := h.s.merkleTree(k.Name)
+
+	if err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return nil, errors.Errorf("error building merkle tree")
+	}
+
+	return t, nil
+}
+
+// HandleGetBucketItems handles requests to the GetBucketItems endpoint of the internal interface.
+func (h *IntHandler) HandleGetBucketItems(k Keygroup, buckets []int, send func(items []Item) error) error {
+	if err := h.s.readBuckets(k.Name, buckets, send); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return errors.Errorf("error reading keygroup items")
+	}
+
+	return nil
+}
+
+// HandleAcknowledgeTombstones handles requests to the AcknowledgeTombstones endpoint of the internal interface.
+func (h *IntHandler) HandleAcknowledgeTombstones(k Keygroup, items []Item) ([]string, []string, error) {
+	acked, missing, err := h.s.acknowledgeTombstones(k.Name, items)
+
+	if err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return nil, nil, errors.Errorf("error acknowledging tombstones")
+	}
+
+	return acked, missing, nil
+}
+
+// HandleCloneKeygroup handles requests to the CloneKeygroup endpoint of the internal interface: it copies our copy of
+// a keygroup into a new keygroup whose configuration is already in the NaSe.
+func (h *IntHandler) HandleCloneKeygroup(src Keygroup, dst Keygroup) error {
+	k, err := h.r.keygroupConfig(dst.Name)
+
+	if err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return errors.Errorf("error cloning keygroup")
+	}
+
+	if err := h.s.cloneKeygroup(src.Name, k, k.ConflictPolicy); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return errors.Errorf("error cloning keygroup")
+	}
+
+	return nil
+}
+
+// HandleAddReplica handles requests to the AddReplica endpoint of the internal interface: another node, e.g. the
+// reconciler, asks us to add a replica to a keygroup and to send it our data.
+func (h *IntHandler) HandleAddReplica(k Keygroup, n Node) error {
+	if err := h.r.addReplica(k, n); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return errors.Errorf("error adding replica")
+	}
+
+	return nil
+}
+
 // HandleCreateKeygroup handles requests to the CreateKeygroup endpoint of the internal interface.
 func (h *IntHandler) HandleCreateKeygroup(k Keygroup) error {
 	if err := h.s.createKeygroup(k.Name); err != nil {
@@ -53,6 +148,32 @@ func (h *IntHandler) HandleCreateKeygroup(k Keygroup) error {
 		return errors.Errorf("error creating keygroup")
 	}
 
+	// every replica keeps the history of its own copy of the keygroup
+	history, err := h.n.GetKeygroupHistory(k.Name)
+
+	if err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return errors.Errorf("error creating keygroup")
+	}
+
+	if err := h.s.setHistory(k.Name, history); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return errors.Errorf("error creating keygroup")
+	}
+
+	// each replica indexes its own copy of the keygroup, the items that are transferred to us are indexed as they arrive
+	indexes, err := h.n.GetKeygroupIndexes(k.Name)
+
+	if err != nil {
+		log.Err(err).Msgf("could not get indexes of keygroup %s, they will be built on the first query", k.Name)
+		return nil
+	}
+
+	if err := h.s.setIndexes(k.Name, indexes); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return errors.Errorf("error creating keygroup")
+	}
+
 	return nil
 }
 
@@ -73,8 +194,9 @@ func (h *IntHandler) HandleDeleteKeygroup(k Keygroup) error {
 	return nil
 }
 
-// HandleUpdate handles requests to the Update endpoint of the internal interface.
-func (h *IntHandler) HandleUpdate(i Item) error {
+// HandleUpdate handles requests to the Update endpoint of the internal interface. source is the node that the update
+// was made on, it is empty if that node does not tell.
+func (h *IntHandler) HandleUpdate(source NodeID, i Item) error {
 
 	expiry, err := h.n.GetExpiry(i.Keygroup)
 
@@ -82,11 +204,22 @@ func (h *IntHandler) HandleUpdate(i Item) error {
 		return err
 	}
 
-	if err := h.s.addVersion(i, i.Version, expiry); err != nil {
+	policy, err := h.n.GetConflictPolicy(i.Keygroup)
+
+	if err != nil {
+		return err
+	}
+
+	stored, err := h.s.addVersion(i, i.Version, expiryFor(i, expiry), policy)
+
+	if err != nil {
 		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
 		return errors.Errorf("error updating item")
 	}
 
+	// watchers see what we stored, which differs from the update if it was discarded or merged with other versions
+	h.r.publish(stored, false, source)
+
 	if err := h.t.triggerUpdate(i); err != nil {
 		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
 		return errors.Errorf("error updating item")
@@ -96,7 +229,7 @@ func (h *IntHandler) HandleUpdate(i Item) error {
 }
 
 // HandleAppend handles requests to the Append endpoint of the internal interface.
-func (h *IntHandler) HandleAppend(i Item) error {
+func (h *IntHandler) HandleAppend(source NodeID, i Item) error {
 
 	expiry, err := h.n.GetExpiry(i.Keygroup)
 
@@ -104,11 +237,17 @@ func (h *IntHandler) HandleAppend(i Item) error {
 		return err
 	}
 
-	if err := h.s.append(i, expiry); err != nil {
+	if err := h.s.append(i, expiryFor(i, expiry)); err != nil {
 		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
 		return errors.Errorf("error updating item")
 	}
 
+	h.w.notify(i)
+
+	if err := h.c.record(i, true, source); err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+	}
+
 	if err := h.t.triggerUpdate(i); err != nil {
 		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
 		return errors.Errorf("error updating item")
@@ -116,3 +255,102 @@ func (h *IntHandler) HandleAppend(i Item) error {
 
 	return nil
 }
+
+// HandleBatch handles requests to the PutBatch endpoint of the internal interface.
+// All items are stored atomically, versions that we already know are skipped.
+func (h *IntHandler) HandleBatch(k Keygroup, source NodeID, items []Item) error {
+	expiry, err := h.n.GetExpiry(k.Name)
+
+	if err != nil {
+		return err
+	}
+
+	policy, err := h.n.GetConflictPolicy(k.Name)
+
+	if err != nil {
+		return err
+	}
+
+	stored, err := h.s.addVersions(k.Name, items, expiry, policy)
+
+	if err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return errors.Errorf("error updating items")
+	}
+
+	h.r.publish(stored, false, source)
+
+	for _, i := range items {
+		if err := h.t.triggerUpdate(i); err != nil {
+			log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+			return errors.Errorf("error updating items")
+		}
+	}
+
+	return nil
+}
+
+// HandleReplicateBatch handles requests to the ReplicateBatch endpoint of the internal interface.
+// Messages are applied in order. As messages may be delivered more than once, appends of items that already exist are
+// skipped. Messages that can never be applied, e.g., because their keygroup no longer exists, are rejected so that they
+// do not hold up the others. If a message fails otherwise, the messages from that one on are not applied so that the
+// sender can send them again in order. Returns the number of messages that were applied or rejected and the indexes of
+// the rejected messages.
+func (h *IntHandler) HandleReplicateBatch(source NodeID, msgs []ReplicationMessage) (int, []int, error) {
+	rejected := make([]int, 0)
+
+	for j, m := range msgs {
+		if err := h.checkReplicationMessage(m); err != nil {
+			log.Warn().Msgf("HandleReplicateBatch: rejecting message for %s from %s: %s", m.Item.Keygroup, source, err.Error())
+			rejected = append(rejected, j)
+			continue
+		}
+
+		if err := h.applyReplicationMessage(source, m); err != nil {
+			return j, rejected, err
+		}
+	}
+
+	return len(msgs), rejected, nil
+}
+
+// checkReplicationMessage returns why a replication message can never be applied on this node. If we cannot tell, e.g.,
+// because the NaSe is not reachable, the message is not rejected.
+func (h *IntHandler) checkReplicationMessage(m ReplicationMessage) error {
+	if m.Batch != nil {
+		if err := checkBatch(m.Item.Keygroup, m.Batch); err != nil {
+			return err
+		}
+	} else if err := checkItem(m.Item); err != nil {
+		return err
+	}
+
+	exists, err := h.n.ExistsKeygroup(m.Item.Keygroup)
+
+	if err != nil {
+		return nil
+	}
+
+	if !exists {
+		return errors.Errorf("keygroup %s does not exist", m.Item.Keygroup)
+	}
+
+	return nil
+}
+
+// applyReplicationMessage applies a single replication message.
+func (h *IntHandler) applyReplicationMessage(source NodeID, m ReplicationMessage) error {
+	if m.Batch != nil {
+		return h.HandleBatch(Keygroup{Name: m.Item.Keygroup}, source, m.Batch)
+	}
+
+	if !m.Append {
+		return h.HandleUpdate(source, m.Item)
+	}
+
+	if h.s.exists(m.Item) {
+		return nil
+	}
+
+	return h.HandleAppend(source, m.Item)
+}
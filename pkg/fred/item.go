@@ -11,4 +11,23 @@ type Item struct {
 	Val        string
 	Version    vclock.VClock
 	Tombstoned bool
+	// TTL is the number of seconds after which the item expires, 0 means that only the expiry of the keygroup applies.
+	TTL int
+	// Timestamp is the hybrid logical time at which this version was written, 0 if unknown. It is used to resolve
+	// conflicts between concurrent versions and CRDT operations use it to order writes.
+	Timestamp uint64
+}
+
+// expiryFor returns the expiry for an item on this node: the TTL of the item, but never longer than the given expiry of
+// the keygroup on this node. An expiry or TTL of 0 means that there is no limit.
+func expiryFor(i Item, expiry int) int {
+	if i.TTL <= 0 {
+		return expiry
+	}
+
+	if expiry <= 0 || i.TTL < expiry {
+		return i.TTL
+	}
+
+	return expiry
 }
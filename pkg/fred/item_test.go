@@ -0,0 +1,63 @@
+package fred
+
+import (
+	"testing"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/badgerdb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpiryFor(t *testing.T) {
+	// no TTL: the keygroup expiry applies
+	assert.Equal(t, 0, expiryFor(Item{}, 0))
+	assert.Equal(t, 60, expiryFor(Item{}, 60))
+
+	// the shorter of the two applies
+	assert.Equal(t, 10, expiryFor(Item{TTL: 10}, 60))
+	assert.Equal(t, 60, expiryFor(Item{TTL: 100}, 60))
+
+	// keygroup without expiry
+	assert.Equal(t, 10, expiryFor(Item{TTL: 10}, 0))
+}
+
+func TestEmptyValue(t *testing.T) {
+	store := badgerdb.NewMemory()
+	defer store.Close()
+
+	s := newStoreService(store, "X")
+
+	kg := KeygroupName("emptykg")
+
+	assert.NoError(t, s.createKeygroup(kg))
+
+	_, err := s.update(Item{Keygroup: kg, ID: "empty", Val: ""}, 0, nil)
+	assert.NoError(t, err)
+
+	_, err = s.update(Item{Keygroup: kg, ID: "binary", Val: "\x00\xff"}, 0, nil)
+	assert.NoError(t, err)
+
+	items, err := s.read(kg, "empty")
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.False(t, items[0].Tombstoned)
+	assert.Equal(t, "", items[0].Val)
+
+	items, err = s.read(kg, "binary")
+	assert.NoError(t, err)
+	assert.Equal(t, "\x00\xff", items[0].Val)
+
+	// an empty value exists, a tombstone does not
+	_, err = s.update(Item{Keygroup: kg, ID: "empty", Val: "x"}, 0, &Precondition{IfAbsent: true})
+	assert.Error(t, err)
+
+	_, err = s.tombstone(Item{Keygroup: kg, ID: "empty"}, nil)
+	assert.NoError(t, err)
+
+	items, err = s.read(kg, "empty")
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.True(t, items[0].Tombstoned)
+
+	_, err = s.update(Item{Keygroup: kg, ID: "empty", Val: "x"}, 0, &Precondition{IfAbsent: true})
+	assert.NoError(t, err)
+}
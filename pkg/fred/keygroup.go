@@ -5,6 +5,20 @@ type Keygroup struct {
 	Name    KeygroupName
 	Mutable bool
 	Expiry  int
+	// Indexes maps the names of the secondary indexes of the keygroup to the JSON paths that they index.
+	Indexes map[string]string
+	// ConflictPolicy decides what replicas do with concurrent versions of an item, empty means KeepSiblings.
+	ConflictPolicy ConflictPolicy
+	// Type is the type of the items in the keygroup, empty means Plain.
+	Type KeygroupType
+	// History decides which replaced versions of items are kept, by default none are.
+	History HistoryRetention
+	// Quota limits what the keygroup can use on each replica, by default there are no limits.
+	Quota Quota
+	// Compression is the codec that values of the keygroup are compressed with, empty means NoCompression.
+	Compression Compression
+	// ReplicationPolicy decides how many replicas the keygroup is kept on and where, by default it is not enforced.
+	ReplicationPolicy ReplicationPolicy
 }
 
 // KeygroupName is a name of a keygroup.
@@ -0,0 +1,80 @@
+package fred
+
+import (
+	"github.com/rs/zerolog/log"
+)
+
+// NodeStatus tells whether a node is running, according to the NaSe.
+type NodeStatus string
+
+const (
+	// NodeAlive means that the node keeps renewing its lease with the NaSe.
+	NodeAlive NodeStatus = "alive"
+	// NodeDead means that the node has not renewed its lease in time, e.g. because it crashed, lost its connection, or
+	// was shut down.
+	NodeDead NodeStatus = "dead"
+)
+
+// watchLiveness keeps track of the status of all nodes so that replication can skip nodes that are known to be dead.
+func (s *replicationService) watchLiveness() error {
+	return s.n.WatchNodes(nil, s.setNodeStatus)
+}
+
+// setNodeStatus records the status of a node. If a peer has come back, its outbox is delivered right away.
+func (s *replicationService) setNodeStatus(nodeID NodeID, status NodeStatus) {
+	if nodeID == s.n.GetNodeID() {
+		return
+	}
+
+	s.statusLock.Lock()
+	old, ok := s.status[nodeID]
+	s.status[nodeID] = status
+	s.statusLock.Unlock()
+
+	if ok && old == status {
+		return
+	}
+
+	switch {
+	case !ok:
+		log.Debug().Msgf("liveness from replservice: node %s is %s", nodeID, status)
+	case status == NodeDead:
+		log.Warn().Msgf("liveness from replservice: node %s is no longer alive, updates for it are recorded in the NaSe until it is back", nodeID)
+	default:
+		log.Info().Msgf("liveness from replservice: node %s is alive again", nodeID)
+	}
+
+	if status != NodeAlive || s.o == nil {
+		return
+	}
+
+	s.workersLock.Lock()
+	w, ok := s.workers[nodeID]
+	s.workersLock.Unlock()
+
+	if ok {
+		w.wake()
+	}
+}
+
+// isAlive returns whether a node is alive. Nodes we have not heard about yet are assumed to be alive.
+func (s *replicationService) isAlive(nodeID NodeID) bool {
+	s.statusLock.RLock()
+	defer s.statusLock.RUnlock()
+
+	return s.status[nodeID] != NodeDead
+}
+
+// livePeers splits keygroup members into those that are alive and those that are known to be dead.
+func (s *replicationService) livePeers(ids map[NodeID]int) (alive []NodeID, dead []NodeID) {
+	for id := range ids {
+		if s.isAlive(id) {
+			alive = append(alive, id)
+			continue
+		}
+
+		dead = append(dead, id)
+	}
+
+	return alive, dead
+}
@@ -0,0 +1,101 @@
+package fred
+
+import (
+	"sync"
+	"testing"
+
+	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/stretchr/testify/assert"
+)
+
+// livenessNaSe is a NameService with a single keygroup whose members are alive or dead as the test says.
+type livenessNaSe struct {
+	NameService
+	members map[NodeID]int
+	status  map[NodeID]NodeStatus
+	watch   func(nodeID NodeID, status NodeStatus)
+
+	lock   sync.Mutex
+	failed []NodeID
+}
+
+func (n *livenessNaSe) GetNodeID() NodeID {
+	return "self"
+}
+
+func (n *livenessNaSe) ExistsKeygroup(_ KeygroupName) (bool, error) {
+	return true, nil
+}
+
+func (n *livenessNaSe) GetKeygroupMembers(_ KeygroupName, _ bool) (map[NodeID]int, error) {
+	return n.members, nil
+}
+
+func (n *livenessNaSe) GetNodeAddress(nodeID NodeID) (string, error) {
+	return string(nodeID), nil
+}
+
+func (n *livenessNaSe) WatchNodes(_ <-chan struct{}, f func(nodeID NodeID, status NodeStatus)) error {
+	for id, status := range n.status {
+		f(id, status)
+	}
+
+	n.watch = f
+
+	return nil
+}
+
+func (n *livenessNaSe) ReportFailedNode(nodeID NodeID, _ KeygroupName, _ string) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.failed = append(n.failed, nodeID)
+
+	return nil
+}
+
+// livenessClient remembers which nodes it has sent updates to.
+type livenessClient struct {
+	Client
+
+	lock sync.Mutex
+	sent []string
+}
+
+func (c *livenessClient) SendUpdate(host string, _ KeygroupName, _ NodeID, _ string, _ string, _ bool, _ vclock.VClock, _ int, _ uint64) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.sent = append(c.sent, host)
+
+	return nil
+}
+
+func TestLiveness(t *testing.T) {
+	n := &livenessNaSe{
+		members: map[NodeID]int{"A": 0, "B": 0},
+		status:  map[NodeID]NodeStatus{"self": NodeAlive, "A": NodeAlive, "B": NodeDead},
+	}
+	c := &livenessClient{}
+
+	r := newReplicationService(nil, c, n, nil, nil, false, nil, 0)
+	assert.NoError(t, r.watchLiveness())
+
+	assert.True(t, r.isAlive("A"))
+	assert.False(t, r.isAlive("B"))
+	assert.True(t, r.isAlive("C"))
+
+	// dead nodes are not sent to but recorded as having missed the update right away
+	assert.NoError(t, r.relayUpdate(Item{Keygroup: "kg", ID: "a", Val: "1"}))
+	assert.Equal(t, []string{"A"}, c.sent)
+	assert.Equal(t, []NodeID{"B"}, n.failed)
+
+	// once B is back, it gets updates again
+	n.watch("B", NodeAlive)
+	assert.True(t, r.isAlive("B"))
+
+	c.sent = nil
+	assert.NoError(t, r.relayUpdate(Item{Keygroup: "kg", ID: "a", Val: "2"}))
+	assert.ElementsMatch(t, []string{"A", "B"}, c.sent)
+	assert.Equal(t, []NodeID{"B"}, n.failed)
+}
@@ -5,29 +5,62 @@ type NameService interface {
 	// manage information about this node
 	GetNodeID() NodeID
 	RegisterSelf(host string, externalHost string) error
+	SetNodeInfo(info NodeInfo) error
 
 	// manage permissions
 	AddUserPermissions(user string, method Method, keygroup KeygroupName) error
 	RevokeUserPermissions(user string, method Method, keygroup KeygroupName) error
 	GetUserPermissions(user string, keygroup KeygroupName) (map[Method]struct{}, error)
+	GetKeygroupPermissions(keygroup KeygroupName) (map[string]map[Method]struct{}, error)
 
 	// get information about a keygroup
 	IsMutable(kg KeygroupName) (bool, error)
 	GetExpiry(kg KeygroupName) (int, error)
+	GetKeygroupIndexes(kg KeygroupName) (map[string]string, error)
+	GetConflictPolicy(kg KeygroupName) (ConflictPolicy, error)
+	GetKeygroupType(kg KeygroupName) (KeygroupType, error)
+	GetKeygroupHistory(kg KeygroupName) (HistoryRetention, error)
+	GetKeygroupQuota(kg KeygroupName) (Quota, error)
+	GetKeygroupCompression(kg KeygroupName) (Compression, error)
+	GetKeygroupReplicationPolicy(kg KeygroupName) (ReplicationPolicy, error)
+	IsReadOnly(kg KeygroupName) (bool, error)
 
 	// manage information about another node
 	GetNodeAddress(nodeID NodeID) (addr string, err error)
 	GetNodeAddressExternal(nodeID NodeID) (addr string, err error)
 	GetAllNodes() (nodes []Node, err error)
 	GetAllNodesExternal() (nodes []Node, err error)
+	GetNodeInfo(nodeID NodeID) (NodeInfo, error)
+	DeregisterNode(nodeID NodeID) error
 
 	// manage keygroups
 	ExistsKeygroup(kg KeygroupName) (bool, error)
 	JoinNodeIntoKeygroup(kg KeygroupName, nodeID NodeID, expiry int) error
 	ExitOtherNodeFromKeygroup(kg KeygroupName, nodeID NodeID) error
 	CreateKeygroup(kg KeygroupName, mutable bool, expiry int) error
+	SetKeygroupIndexes(kg KeygroupName, indexes map[string]string) error
+	SetConflictPolicy(kg KeygroupName, policy ConflictPolicy) error
+	SetKeygroupType(kg KeygroupName, t KeygroupType) error
+	SetKeygroupHistory(kg KeygroupName, h HistoryRetention) error
+	SetKeygroupQuota(kg KeygroupName, q Quota) error
+	SetKeygroupCompression(kg KeygroupName, c Compression) error
+	SetKeygroupReplicationPolicy(kg KeygroupName, p ReplicationPolicy) error
+	SetKeygroupReadOnly(kg KeygroupName, readOnly bool) error
 	DeleteKeygroup(kg KeygroupName) error
 	GetKeygroupMembers(kg KeygroupName, excludeSelf bool) (ids map[NodeID]int, err error)
+	GetAllKeygroups() ([]KeygroupName, error)
+
+	// manage transfers of keygroup data to new replicas
+	SetTransferCheckpoint(kg KeygroupName, nodeID NodeID, source NodeID, checkpoint string) error
+	GetTransferCheckpoint(kg KeygroupName, nodeID NodeID) (source NodeID, checkpoint string, err error)
+	DeleteTransferCheckpoint(kg KeygroupName, nodeID NodeID) error
+
+	// track which nodes are alive
+	GetNodeStatus(nodeID NodeID) (NodeStatus, error)
+	WatchNodes(done <-chan struct{}, f func(nodeID NodeID, status NodeStatus)) error
+
+	// elect a single node among all nodes
+	Campaign(election string, done <-chan struct{}) (lost <-chan struct{}, err error)
 
 	// handle node failures
 	ReportFailedNode(nodeID NodeID, kg KeygroupName, id string) error
@@ -14,3 +14,13 @@ type Node struct {
 	ID   NodeID
 	Host string
 }
+
+// NodeInfo describes a node so that replicas can be placed on it.
+type NodeInfo struct {
+	// Labels are arbitrary attributes of the node, e.g., its provider or hardware.
+	Labels map[string]string
+	// Geohash is the geohash of the location of the node.
+	Geohash string
+	// Location is where the node is, nil if that is not known.
+	Location *Location
+}
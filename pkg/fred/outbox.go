@@ -0,0 +1,258 @@
+package fred
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// Outbox is an interface for a persistent queue of replication messages per peer. It is used for asynchronous
+// replication so that messages survive restarts of this node and are delivered at least once.
+type Outbox interface {
+	// Enqueue Needs: peer node id, message; adds the message to the end of the queue for that peer
+	Enqueue(peer string, msg []byte) error
+	// Peek Needs: peer node id, maximum number of messages; Returns: sequence numbers and messages from the start of
+	// the queue, in order
+	Peek(peer string, count int) ([]uint64, [][]byte, error)
+	// Ack Needs: peer node id, sequence number; removes all messages up to and including that sequence number
+	Ack(peer string, seq uint64) error
+	// Len Needs: peer node id; Returns: number of messages in the queue for that peer
+	Len(peer string) (uint64, error)
+	// Peers Returns: all peers that have ever had messages queued
+	Peers() ([]string, error)
+	// Close indicates that the underlying outbox should be closed as it is no longer needed.
+	Close() error
+}
+
+// ReplicationMessage is an update or append that has to be relayed to another node.
+// If Batch is set, the message is a batch of updates to the keygroup of Item that has to be applied atomically.
+type ReplicationMessage struct {
+	Append bool
+	Item   Item
+	Batch  []Item
+}
+
+const (
+	// outboxBatchSize is the maximum number of messages that are sent to a peer at once.
+	outboxBatchSize = 100
+	// outboxMinBackoff is the time to wait before retrying after the first failed delivery to a peer.
+	outboxMinBackoff = 100 * time.Millisecond
+	// outboxMaxBackoff is the maximum time to wait between retries.
+	outboxMaxBackoff = 1 * time.Minute
+)
+
+// outboxWorker delivers the queued messages for a single peer.
+type outboxWorker struct {
+	peer   NodeID
+	notify chan struct{}
+	// lock makes sure that checking the size of the queue and enqueueing happen together
+	lock sync.Mutex
+}
+
+// startOutbox starts delivering messages that were left in the outbox when this node was last stopped.
+func (s *replicationService) startOutbox() error {
+	peers, err := s.o.Peers()
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	for _, p := range peers {
+		s.getOutboxWorker(NodeID(p)).wake()
+	}
+
+	return nil
+}
+
+// getOutboxWorker returns the worker for a peer, starting it if there is none yet.
+func (s *replicationService) getOutboxWorker(peer NodeID) *outboxWorker {
+	s.workersLock.Lock()
+	defer s.workersLock.Unlock()
+
+	w, ok := s.workers[peer]
+
+	if !ok {
+		w = &outboxWorker{
+			peer:   peer,
+			notify: make(chan struct{}, 1),
+		}
+
+		s.workers[peer] = w
+
+		go s.deliver(w)
+	}
+
+	return w
+}
+
+// wake tells the worker that there are new messages in the queue.
+func (w *outboxWorker) wake() {
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+}
+
+// enqueue adds a replication message to the outbox of a peer. If the outbox of that peer is full, the message is
+// dropped and the peer is reported as failed instead, so that it can catch up once it is back.
+func (s *replicationService) enqueue(peer NodeID, m ReplicationMessage) error {
+	w := s.getOutboxWorker(peer)
+
+	b, err := json.Marshal(m)
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	l, err := s.o.Len(string(peer))
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	if l >= s.outboxSize {
+		log.Warn().Msgf("enqueue from replservice: outbox for %s is full (%d messages), dropping message for %s", peer, l, m.Item.Keygroup)
+
+		if m.Batch != nil {
+			for _, i := range m.Batch {
+				if err := s.reportNodeFail(peer, i.Keygroup, i.ID); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+
+		return s.reportNodeFail(peer, m.Item.Keygroup, m.Item.ID)
+	}
+
+	err = s.o.Enqueue(string(peer), b)
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	w.wake()
+
+	return nil
+}
+
+// deliver sends the messages in the outbox of a peer in order and in batches. Messages are only removed from the
+// outbox once the peer has acknowledged them. Failed deliveries are retried with an exponential backoff, and nothing
+// is sent while the peer is known to be dead.
+func (s *replicationService) deliver(w *outboxWorker) {
+	backoff := outboxMinBackoff
+
+	for {
+		seqs, msgs, err := s.o.Peek(string(w.peer), outboxBatchSize)
+
+		if err != nil {
+			log.Err(err).Msgf("deliver from replservice: could not read outbox for %s", w.peer)
+			time.Sleep(backoff)
+			continue
+		}
+
+		// messages for a peer that is not alive stay queued until it is back, we are woken up then
+		if len(msgs) == 0 || !s.isAlive(w.peer) {
+			<-w.notify
+			continue
+		}
+
+		done, err := s.deliverBatch(w.peer, msgs)
+
+		if done > 0 {
+			if err := s.o.Ack(string(w.peer), seqs[done-1]); err != nil {
+				log.Err(err).Msgf("deliver from replservice: could not remove delivered messages from outbox for %s", w.peer)
+			}
+		}
+
+		if err != nil {
+			log.Warn().Err(err).Msgf("deliver from replservice: could not deliver %d messages to %s, retrying in %s", len(msgs)-done, w.peer, backoff)
+			time.Sleep(backoff)
+
+			backoff *= 2
+			if backoff > outboxMaxBackoff {
+				backoff = outboxMaxBackoff
+			}
+
+			continue
+		}
+
+		backoff = outboxMinBackoff
+	}
+}
+
+// deliverBatch sends a batch of encoded replication messages to a peer.
+// Messages for keygroups that the peer is no longer a member of are skipped, and messages that the peer rejects because
+// it can never apply them are dropped. Returns how many messages from the start of the batch are done and can be
+// removed from the outbox. If the peer could not apply a message, that message and the ones after it are not done.
+func (s *replicationService) deliverBatch(peer NodeID, msgs [][]byte) (int, error) {
+	members := make(map[KeygroupName]bool)
+
+	batch := make([]ReplicationMessage, 0, len(msgs))
+	// index is the position of each message of the batch in msgs
+	index := make([]int, 0, len(msgs))
+
+	for j, b := range msgs {
+		var m ReplicationMessage
+
+		if err := json.Unmarshal(b, &m); err != nil {
+			// there is no way this message can ever be delivered
+			log.Error().Msgf("deliverBatch from replservice: dropping malformed message for %s: %s", peer, err.Error())
+			continue
+		}
+
+		member, ok := members[m.Item.Keygroup]
+
+		if !ok {
+			ids, err := s.n.GetKeygroupMembers(m.Item.Keygroup, true)
+
+			if err != nil {
+				return 0, err
+			}
+
+			_, member = ids[peer]
+			members[m.Item.Keygroup] = member
+		}
+
+		if !member {
+			log.Debug().Msgf("deliverBatch from replservice: %s has left keygroup %s, skipping message", peer, m.Item.Keygroup)
+			continue
+		}
+
+		batch = append(batch, m)
+		index = append(index, j)
+	}
+
+	if len(batch) == 0 {
+		return len(msgs), nil
+	}
+
+	addr, err := s.n.GetNodeAddress(peer)
+
+	if err != nil {
+		return 0, err
+	}
+
+	applied, rejected, err := s.c.SendReplicateBatch(addr, s.n.GetNodeID(), batch)
+
+	if err != nil {
+		return 0, err
+	}
+
+	for _, j := range rejected {
+		log.Error().Msgf("deliverBatch from replservice: %s can never apply message for %s/%s, dropping it", peer, batch[j].Item.Keygroup, batch[j].Item.ID)
+	}
+
+	if applied < len(batch) {
+		return index[applied], errors.Errorf("%s applied only %d of %d messages", peer, applied, len(batch))
+	}
+
+	return len(msgs), nil
+}
@@ -0,0 +1,86 @@
+package fred
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// outboxNaSe is a NameService in which the peer P is a member of keygroup a only.
+type outboxNaSe struct {
+	NameService
+}
+
+func (n *outboxNaSe) GetNodeID() NodeID {
+	return "X"
+}
+
+func (n *outboxNaSe) GetKeygroupMembers(kg KeygroupName, _ bool) (map[NodeID]int, error) {
+	if kg == "a" {
+		return map[NodeID]int{"X": 0, "P": 0}, nil
+	}
+
+	return map[NodeID]int{"X": 0}, nil
+}
+
+func (n *outboxNaSe) GetNodeAddress(nodeID NodeID) (string, error) {
+	return string(nodeID), nil
+}
+
+// outboxClient answers batches with a fixed result and remembers the ids of the messages it was sent.
+type outboxClient struct {
+	Client
+	applied  int
+	rejected []int
+	sent     []string
+}
+
+func (c *outboxClient) SendReplicateBatch(_ string, _ NodeID, batch []ReplicationMessage) (int, []int, error) {
+	c.sent = make([]string, len(batch))
+
+	for i, m := range batch {
+		c.sent[i] = m.Item.ID
+	}
+
+	return c.applied, c.rejected, nil
+}
+
+func TestDeliverBatch(t *testing.T) {
+	msgs := make([][]byte, 0)
+
+	for _, i := range []Item{
+		{Keygroup: "a", ID: "0"},
+		// P has left keygroup b
+		{Keygroup: "b", ID: "1"},
+		{Keygroup: "a", ID: "2"},
+		{Keygroup: "a", ID: "3"},
+	} {
+		b, err := json.Marshal(ReplicationMessage{Item: i})
+		assert.NoError(t, err)
+		msgs = append(msgs, b)
+	}
+
+	c := &outboxClient{}
+	r := newReplicationService(nil, c, &outboxNaSe{}, nil, nil, true, nil, 0)
+
+	// P can never apply 0 and fails to apply 3, so 3 has to be sent again
+	c.applied, c.rejected = 2, []int{0}
+	done, err := r.deliverBatch("P", msgs)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"0", "2", "3"}, c.sent)
+	assert.Equal(t, 3, done)
+
+	c.applied, c.rejected = 1, nil
+	done, err = r.deliverBatch("P", msgs[done:])
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"3"}, c.sent)
+	assert.Equal(t, 1, done)
+
+	// messages for keygroups that P has left are done without sending anything
+	c.sent = nil
+	done, err = r.deliverBatch("P", msgs[1:2])
+	assert.NoError(t, err)
+	assert.Nil(t, c.sent)
+	assert.Equal(t, 1, done)
+}
@@ -0,0 +1,261 @@
+package fred
+
+import (
+	"math"
+	"sort"
+
+	"github.com/go-errors/errors"
+)
+
+// earthRadius is the mean radius of the earth in kilometers.
+const earthRadius = 6371.0
+
+// Location is a point on the earth, given as latitude and longitude in degrees.
+type Location struct {
+	Lat float64
+	Lng float64
+}
+
+// ReplicaDistance is a replica of a keygroup and how far it is from a location.
+type ReplicaDistance struct {
+	Node
+	Expiry int
+	// Distance is the great-circle distance in kilometers.
+	Distance float64
+}
+
+// checkLocation checks that a location is on the earth.
+func checkLocation(l Location) error {
+	if l.Lat < -90 || l.Lat > 90 {
+		return errors.Errorf("latitude must be between -90 and 90, got %f", l.Lat)
+	}
+
+	if l.Lng < -180 || l.Lng > 180 {
+		return errors.Errorf("longitude must be between -180 and 180, got %f", l.Lng)
+	}
+
+	return nil
+}
+
+// distance returns the great-circle distance between two locations in kilometers.
+func distance(a Location, b Location) float64 {
+	lat1 := a.Lat * math.Pi / 180
+	lat2 := b.Lat * math.Pi / 180
+	dLat := lat2 - lat1
+	dLng := (b.Lng - a.Lng) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// proposeReplicas chooses up to n of the given nodes so that the clients are as close as possible to their nearest
+// chosen node on average. Finding the best set is hard, so nodes are added one at a time, each time the one that
+// brings the clients closest. Returns the chosen nodes in the order they were chosen and the mean distance of the
+// clients to their nearest chosen node in kilometers.
+func proposeReplicas(nodes map[NodeID]Location, clients []Location, n int) ([]NodeID, float64) {
+	ids := make([]NodeID, 0, len(nodes))
+
+	for id := range nodes {
+		ids = append(ids, id)
+	}
+
+	// ties are broken by node ID so that the same input always gives the same proposal
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	if n > len(ids) {
+		n = len(ids)
+	}
+
+	if len(clients) == 0 || n <= 0 {
+		return ids[:0], 0
+	}
+
+	// nearest is the distance of each client to its nearest chosen node
+	nearest := make([]float64, len(clients))
+
+	for i := range nearest {
+		nearest[i] = math.Inf(1)
+	}
+
+	chosen := make([]NodeID, 0, n)
+	used := make(map[NodeID]struct{}, n)
+	total := 0.0
+
+	for len(chosen) < n {
+		var best NodeID
+		bestTotal := math.Inf(1)
+
+		for _, id := range ids {
+			if _, ok := used[id]; ok {
+				continue
+			}
+
+			t := 0.0
+
+			for i, c := range clients {
+				t += math.Min(nearest[i], distance(c, nodes[id]))
+			}
+
+			if t < bestTotal {
+				best, bestTotal = id, t
+			}
+		}
+
+		for i, c := range clients {
+			nearest[i] = math.Min(nearest[i], distance(c, nodes[best]))
+		}
+
+		chosen = append(chosen, best)
+		used[best] = struct{}{}
+		total = bestTotal
+	}
+
+	return chosen, total / float64(len(clients))
+}
+
+// nearestReplicas returns the replicas of a keygroup that are alive and have a known location, ordered by their
+// distance from a location. If n is greater than 0, at most n replicas are returned.
+func (s *replicationService) nearestReplicas(kg KeygroupName, l Location, n int) ([]ReplicaDistance, error) {
+	exists, err := s.n.ExistsKeygroup(kg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, errors.Errorf("keygroup %s does not exist", kg)
+	}
+
+	members, err := s.n.GetKeygroupMembers(kg, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	replicas := make([]ReplicaDistance, 0, len(members))
+
+	for id, expiry := range members {
+		if !s.isAlive(id) {
+			continue
+		}
+
+		info, err := s.n.GetNodeInfo(id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if info.Location == nil {
+			continue
+		}
+
+		addr, err := s.n.GetNodeAddressExternal(id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		replicas = append(replicas, ReplicaDistance{
+			Node:     Node{ID: id, Host: addr},
+			Expiry:   expiry,
+			Distance: distance(l, *info.Location),
+		})
+	}
+
+	sort.Slice(replicas, func(i, j int) bool {
+		if replicas[i].Distance != replicas[j].Distance {
+			return replicas[i].Distance < replicas[j].Distance
+		}
+
+		return replicas[i].ID < replicas[j].ID
+	})
+
+	if n > 0 && len(replicas) > n {
+		replicas = replicas[:n]
+	}
+
+	return replicas, nil
+}
+
+// proposeReplicaSet proposes n replicas for a keygroup that are closest to where its clients are. The proposal may
+// include current replicas and any other node that is alive, has a known location, and is allowed by the replication
+// policy of the keygroup. If n is 0, as many replicas as the keygroup has now are proposed. Nothing is changed, the
+// replicas can then be added and removed as usual. Returns the proposed nodes and the mean distance of the clients to
+// their nearest proposed node in kilometers.
+func (s *replicationService) proposeReplicaSet(kg KeygroupName, clients []Location, n int) ([]Node, float64, error) {
+	exists, err := s.n.ExistsKeygroup(kg)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if !exists {
+		return nil, 0, errors.Errorf("keygroup %s does not exist", kg)
+	}
+
+	members, err := s.n.GetKeygroupMembers(kg, false)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if n == 0 {
+		n = len(members)
+	}
+
+	p, err := s.n.GetKeygroupReplicationPolicy(kg)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	all, err := s.n.GetAllNodes()
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	locations := make(map[NodeID]Location)
+
+	for _, node := range all {
+		if !s.isAlive(node.ID) {
+			continue
+		}
+
+		info, err := s.n.GetNodeInfo(node.ID)
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if info.Location == nil {
+			continue
+		}
+
+		// current replicas are always an option, other nodes only if the policy allows them
+		if _, ok := members[node.ID]; !ok && !p.allows(info) {
+			continue
+		}
+
+		locations[node.ID] = *info.Location
+	}
+
+	ids, mean := proposeReplicas(locations, clients, n)
+
+	nodes := make([]Node, len(ids))
+
+	for i, id := range ids {
+		addr, err := s.n.GetNodeAddressExternal(id)
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		nodes[i] = Node{ID: id, Host: addr}
+	}
+
+	return nodes, mean, nil
+}
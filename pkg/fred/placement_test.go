@@ -0,0 +1,105 @@
+package fred
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	berlin  = Location{Lat: 52.5200, Lng: 13.4050}
+	hamburg = Location{Lat: 53.5511, Lng: 9.9937}
+	munich  = Location{Lat: 48.1351, Lng: 11.5820}
+	paris   = Location{Lat: 48.8566, Lng: 2.3522}
+	lisbon  = Location{Lat: 38.7223, Lng: -9.1393}
+)
+
+// placementNaSe is a reconcilerNaSe whose keygroup exists.
+type placementNaSe struct {
+	*reconcilerNaSe
+}
+
+func (n *placementNaSe) ExistsKeygroup(_ KeygroupName) (bool, error) {
+	return true, nil
+}
+
+func (n *placementNaSe) GetNodeAddressExternal(nodeID NodeID) (string, error) {
+	return string(nodeID) + ":9001", nil
+}
+
+func TestDistance(t *testing.T) {
+	assert.InDelta(t, 878, distance(berlin, paris), 5)
+	assert.InDelta(t, 878, distance(paris, berlin), 5)
+	assert.InDelta(t, 0, distance(munich, munich), 0.001)
+	assert.InDelta(t, 20015, distance(Location{Lat: 0, Lng: 0}, Location{Lat: 0, Lng: 180}), 5)
+
+	assert.NoError(t, checkLocation(berlin))
+	assert.Error(t, checkLocation(Location{Lat: 91}))
+	assert.Error(t, checkLocation(Location{Lng: -181}))
+}
+
+func TestProposeReplicas(t *testing.T) {
+	nodes := map[NodeID]Location{"B": berlin, "H": hamburg, "M": munich, "P": paris, "L": lisbon}
+
+	// a single replica is placed in the middle of the clients
+	ids, mean := proposeReplicas(nodes, []Location{berlin, hamburg, munich}, 1)
+	assert.Equal(t, []NodeID{"B"}, ids)
+	assert.InDelta(t, (distance(berlin, hamburg)+distance(berlin, munich))/3, mean, 0.001)
+
+	// clients that are far apart each get a replica close to them
+	ids, mean = proposeReplicas(nodes, []Location{berlin, lisbon}, 2)
+	assert.ElementsMatch(t, []NodeID{"B", "L"}, ids)
+	assert.InDelta(t, 0, mean, 0.001)
+
+	// there are not more replicas than nodes
+	ids, _ = proposeReplicas(nodes, []Location{paris}, 10)
+	assert.Len(t, ids, 5)
+	assert.Equal(t, NodeID("P"), ids[0])
+
+	ids, _ = proposeReplicas(nodes, nil, 2)
+	assert.Empty(t, ids)
+}
+
+func TestNearestReplicas(t *testing.T) {
+	n := &placementNaSe{&reconcilerNaSe{
+		policy:  ReplicationPolicy{Labels: map[string]string{"zone": "eu"}},
+		members: map[NodeID]int{"B": 0, "M": 10, "P": 0, "X": 0},
+		nodes: map[NodeID]NodeInfo{
+			"B": {Location: &berlin},
+			"H": {Location: &hamburg, Labels: map[string]string{"zone": "eu"}},
+			"M": {Location: &munich},
+			"P": {Location: &paris},
+			"L": {Location: &lisbon},
+			// X has not stored its location
+			"X": {},
+		},
+	}}
+
+	r := newReplicationService(nil, nil, n, nil, nil, false, nil, 0)
+	r.setNodeStatus("P", NodeDead)
+
+	res, err := r.nearestReplicas("kg", hamburg, 0)
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+	assert.Equal(t, NodeID("B"), res[0].ID)
+	assert.Equal(t, "B:9001", res[0].Host)
+	assert.InDelta(t, distance(hamburg, berlin), res[0].Distance, 0.001)
+	assert.Equal(t, NodeID("M"), res[1].ID)
+	assert.Equal(t, 10, res[1].Expiry)
+
+	res, err = r.nearestReplicas("kg", munich, 1)
+	assert.NoError(t, err)
+	assert.Len(t, res, 1)
+	assert.Equal(t, NodeID("M"), res[0].ID)
+
+	// members and nodes allowed by the policy can be proposed, L is not allowed and P is dead
+	nodes, _, err := r.proposeReplicaSet("kg", []Location{hamburg, paris, lisbon}, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []Node{{ID: "H", Host: "H:9001"}, {ID: "M", Host: "M:9001"}}, nodes)
+
+	// by default, as many replicas as the keygroup has now are proposed
+	nodes, _, err = r.proposeReplicaSet("kg", []Location{munich}, 0)
+	assert.NoError(t, err)
+	assert.Len(t, nodes, 3)
+	assert.Equal(t, NodeID("M"), nodes[0].ID)
+}
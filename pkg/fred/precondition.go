@@ -0,0 +1,101 @@
+package fred
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/vector"
+	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/go-errors/errors"
+)
+
+// Precondition is a condition on the current state of an item that has to hold for an update or delete to be applied.
+// All conditions that are set have to hold.
+type Precondition struct {
+	// IfAbsent requires that the item does not exist or is tombstoned.
+	IfAbsent bool
+	// IfVersion requires that the item has exactly this one version, if set.
+	IfVersion vclock.VClock
+	// IfValueHash requires that the item has exactly one value with this hex-encoded SHA-256 hash, if set.
+	IfValueHash string
+}
+
+// PreconditionError is returned when an update or delete is rejected because its precondition does not hold.
+type PreconditionError struct {
+	Keygroup KeygroupName
+	ID       string
+	Reason   string
+}
+
+func (e *PreconditionError) Error() string {
+	return fmt.Sprintf("precondition for item %s in keygroup %s failed: %s", e.ID, e.Keygroup, e.Reason)
+}
+
+// HashValue returns the hash of a value that can be used in a precondition.
+func HashValue(val string) string {
+	h := sha256.Sum256([]byte(val))
+	return hex.EncodeToString(h[:])
+}
+
+// checkPrecondition checks a precondition against the current values and versions of an item in the store.
+// The lock of the cached clock of that item has to be held so that nothing can change between the check and the write.
+func (s *storeService) checkPrecondition(i Item, p *Precondition) error {
+	if p == nil {
+		return nil
+	}
+
+	vals, tombstones, versions, found, err := s.iS.Read(string(i.Keygroup), i.ID)
+
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		vals = []string{}
+		tombstones = []bool{}
+		versions = []vclock.VClock{}
+	}
+
+	fail := func(format string, a ...interface{}) error {
+		return errors.New(&PreconditionError{
+			Keygroup: i.Keygroup,
+			ID:       i.ID,
+			Reason:   fmt.Sprintf(format, a...),
+		})
+	}
+
+	if p.IfAbsent {
+		for _, t := range tombstones {
+			if !t {
+				return fail("item exists")
+			}
+		}
+	}
+
+	if p.IfVersion != nil {
+		if len(versions) != 1 {
+			return fail("item has %d versions", len(versions))
+		}
+
+		if !versions[0].Compare(p.IfVersion, vclock.Equal) {
+			return fail("item has version %s", vector.SortedVCString(versions[0]))
+		}
+	}
+
+	if p.IfValueHash != "" {
+		if len(vals) != 1 {
+			return fail("item has %d values", len(vals))
+		}
+
+		if tombstones[0] {
+			return fail("item is deleted")
+		}
+
+		if HashValue(vals[0]) != p.IfValueHash {
+			return fail("value hash does not match")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package fred
+
+import (
+	"testing"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/badgerdb"
+	"github.com/go-errors/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrecondition(t *testing.T) {
+	store := badgerdb.NewMemory()
+	defer store.Close()
+
+	s := newStoreService(store, "X")
+
+	kg := KeygroupName("preconditionkg")
+
+	assert.NoError(t, s.createKeygroup(kg))
+
+	var perr *PreconditionError
+
+	// create only if absent
+	v, err := s.update(Item{Keygroup: kg, ID: "lease", Val: "a"}, 0, &Precondition{IfAbsent: true})
+	assert.NoError(t, err)
+
+	_, err = s.update(Item{Keygroup: kg, ID: "lease", Val: "b"}, 0, &Precondition{IfAbsent: true})
+	assert.True(t, errors.As(err, &perr))
+
+	// compare and set on the version
+	v2, err := s.update(Item{Keygroup: kg, ID: "lease", Val: "b"}, 0, &Precondition{IfVersion: v})
+	assert.NoError(t, err)
+
+	_, err = s.update(Item{Keygroup: kg, ID: "lease", Val: "c"}, 0, &Precondition{IfVersion: v})
+	assert.True(t, errors.As(err, &perr))
+
+	// compare and set on the value
+	_, err = s.update(Item{Keygroup: kg, ID: "lease", Val: "c"}, 0, &Precondition{IfValueHash: HashValue("a")})
+	assert.True(t, errors.As(err, &perr))
+
+	_, err = s.updateVersions(Item{Keygroup: kg, ID: "lease", Val: "c"}, nil, 0, &Precondition{IfValueHash: HashValue("b")})
+	assert.Error(t, err, "versions must still be checked")
+	assert.False(t, errors.As(err, &perr))
+
+	_, err = s.update(Item{Keygroup: kg, ID: "lease", Val: "c"}, 0, &Precondition{IfValueHash: HashValue("b"), IfVersion: v2})
+	assert.NoError(t, err)
+
+	items, err := s.read(kg, "lease")
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "c", items[0].Val)
+
+	// delete only if the value has not changed, after that it is absent again
+	_, err = s.tombstone(Item{Keygroup: kg, ID: "lease"}, &Precondition{IfValueHash: HashValue("b")})
+	assert.True(t, errors.As(err, &perr))
+
+	_, err = s.tombstone(Item{Keygroup: kg, ID: "lease"}, &Precondition{IfValueHash: HashValue("c")})
+	assert.NoError(t, err)
+
+	_, err = s.update(Item{Keygroup: kg, ID: "lease", Val: "d"}, 0, &Precondition{IfAbsent: true})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,428 @@
+package fred
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// usageRefresh is how often the usage of keygroups is counted again from the store in the background. This is how
+// updates from other replicas and expired items are noticed.
+const usageRefresh = 30 * time.Second
+
+// Quota limits the resources that a keygroup can use on each of its replicas. A limit of 0 means that there is no limit.
+type Quota struct {
+	// MaxBytes is the maximum total size of the ids and values of the current versions of all items, including
+	// tombstones. Replaced versions that are kept in the history are not counted.
+	MaxBytes int64
+	// MaxItems is the maximum number of items that are not deleted.
+	MaxItems int64
+	// MaxValueSize is the maximum size of a single value in bytes.
+	MaxValueSize int64
+	// WritesPerSecond is the maximum number of write requests per second that a replica accepts from clients.
+	WritesPerSecond int64
+}
+
+// Usage is what a keygroup uses of its quota on one replica.
+type Usage struct {
+	Bytes int64
+	Items int64
+}
+
+// QuotaError is returned when a write is rejected because it would exceed the quota of its keygroup.
+type QuotaError struct {
+	Keygroup KeygroupName
+	Reason   string
+}
+
+func (e *QuotaError) Error() string {
+	return fmt.Sprintf("quota of keygroup %s exceeded: %s", e.Keygroup, e.Reason)
+}
+
+// checkQuota checks that a quota is valid.
+func checkQuota(q Quota) error {
+	if q.MaxBytes < 0 || q.MaxItems < 0 || q.MaxValueSize < 0 || q.WritesPerSecond < 0 {
+		return errors.Errorf("quota limits must not be negative, got %+v", q)
+	}
+
+	return nil
+}
+
+// size is the number of bytes that a version of an item uses.
+func size(id string, val string) int64 {
+	return int64(len(id) + len(val))
+}
+
+// change returns how the usage of a keygroup changes when an item is written, assuming that the write replaces all
+// current versions of the item.
+func (s *storeService) change(i Item) (Usage, error) {
+	d := Usage{Bytes: size(i.ID, i.Val)}
+
+	if !i.Tombstoned {
+		d.Items = 1
+	}
+
+	vals, tombstones, _, found, err := s.iS.Read(string(i.Keygroup), i.ID)
+
+	if err != nil {
+		return d, err
+	}
+
+	if !found {
+		return d, nil
+	}
+
+	live := false
+
+	for j := range vals {
+		d.Bytes -= size(i.ID, vals[j])
+
+		if !tombstones[j] {
+			live = true
+		}
+	}
+
+	if live {
+		d.Items--
+	}
+
+	return d, nil
+}
+
+// usage counts what a keygroup uses in the store.
+func (s *storeService) usage(kg KeygroupName) (Usage, error) {
+	var u Usage
+
+	after := ""
+
+	for {
+		items, err := s.readPage(kg, after, transferPageSize)
+
+		if err != nil {
+			return u, err
+		}
+
+		if len(items) == 0 {
+			return u, nil
+		}
+
+		// readPage returns all versions of an item, an item counts if any version is not a tombstone
+		live := make(map[string]struct{})
+
+		for _, i := range items {
+			u.Bytes += size(i.ID, i.Val)
+
+			if !i.Tombstoned {
+				live[i.ID] = struct{}{}
+			}
+		}
+
+		u.Items += int64(len(live))
+
+		after = items[len(items)-1].ID
+	}
+}
+
+// kgQuota is the state that a node keeps to enforce the quota of a keygroup.
+type kgQuota struct {
+	usage   Usage
+	counted time.Time
+	// pending is the change of the writes that were admitted but have not finished yet.
+	pending Usage
+	// counting is set while the keygroup is counted, recorded collects the writes that finish meanwhile.
+	counting bool
+	recorded Usage
+	// tokens and last make up a token bucket that limits the write rate.
+	tokens float64
+	last   time.Time
+}
+
+// quotaService keeps track of the usage of the keygroups on this node and rejects writes that exceed their quotas.
+// Usage is counted from the store once and then updated with the writes of clients to this node, writes that are
+// admitted but have not finished yet count against the quota as well. The usage is counted again in the background
+// every usageRefresh, so it is only approximate for updates from other replicas and expired items.
+type quotaService struct {
+	s *storeService
+	n NameService
+
+	sync.Mutex
+	kgs map[KeygroupName]*kgQuota
+
+	// countLock makes sure that a keygroup is not counted twice at the same time.
+	countLock sync.Mutex
+}
+
+// newQuotaService creates a new quotaService.
+func newQuotaService(s *storeService, n NameService) *quotaService {
+	return &quotaService{
+		s:   s,
+		n:   n,
+		kgs: make(map[KeygroupName]*kgQuota),
+	}
+}
+
+// get returns the state of a keygroup, the lock has to be held.
+func (q *quotaService) get(kg KeygroupName) *kgQuota {
+	k, ok := q.kgs[kg]
+
+	if !ok {
+		k = &kgQuota{}
+		q.kgs[kg] = k
+	}
+
+	return k
+}
+
+// count counts the usage of a keygroup in the store. Unless again is set, a keygroup is only counted once. Writes that
+// finish while the keygroup is counted are added to the result, so they may be counted twice until the next count.
+func (q *quotaService) count(kg KeygroupName, again bool) error {
+	q.countLock.Lock()
+	defer q.countLock.Unlock()
+
+	q.Lock()
+	k := q.get(kg)
+
+	if !again && !k.counted.IsZero() {
+		q.Unlock()
+		return nil
+	}
+
+	k.counting = true
+	k.recorded = Usage{}
+	q.Unlock()
+
+	exists := q.s.existsKeygroup(kg)
+
+	var u Usage
+	var err error
+
+	// counting can take a while, so we don't hold the lock
+	if exists {
+		u, err = q.s.usage(kg)
+	}
+
+	q.Lock()
+	defer q.Unlock()
+
+	k.counting = false
+
+	if err != nil {
+		return err
+	}
+
+	// a keygroup that has no replica on this node uses nothing
+	if !exists {
+		k.usage = Usage{}
+		k.counted = time.Time{}
+		return nil
+	}
+
+	k.usage = Usage{Bytes: u.Bytes + k.recorded.Bytes, Items: u.Items + k.recorded.Items}
+	k.counted = time.Now()
+
+	return nil
+}
+
+// runRecount counts the usage of all keygroups that have been counted again every interval. This is how updates from
+// other replicas and expired items are noticed.
+func (q *quotaService) runRecount(interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for range t.C {
+		q.Lock()
+		kgs := make([]KeygroupName, 0, len(q.kgs))
+
+		for kg, k := range q.kgs {
+			if !k.counted.IsZero() {
+				kgs = append(kgs, kg)
+			}
+		}
+		q.Unlock()
+
+		for _, kg := range kgs {
+			if err := q.count(kg, true); err != nil {
+				log.Err(err).Msgf("runRecount from quota: could not count usage of keygroup %s", kg)
+			}
+		}
+	}
+}
+
+// usage returns the current usage of a keygroup on this node, which is zero if this node has no replica of it.
+func (q *quotaService) usage(kg KeygroupName) (Usage, error) {
+	if err := q.count(kg, false); err != nil {
+		return Usage{}, err
+	}
+
+	q.Lock()
+	defer q.Unlock()
+
+	return q.get(kg).usage, nil
+}
+
+// admit checks whether a write request of some items to a keygroup stays within the quota of the keygroup. If it does
+// not, a *QuotaError is returned. Otherwise, it returns how the write changes the usage of the keygroup, which counts
+// against the quota until it is passed to commit once the write has succeeded or to release if it has failed.
+func (q *quotaService) admit(kg KeygroupName, items []Item) (Usage, error) {
+	var d Usage
+
+	quota, err := q.n.GetKeygroupQuota(kg)
+
+	if err != nil {
+		return d, err
+	}
+
+	fail := func(format string, a ...interface{}) error {
+		return errors.New(&QuotaError{
+			Keygroup: kg,
+			Reason:   fmt.Sprintf(format, a...),
+		})
+	}
+
+	if quota.MaxValueSize > 0 {
+		for _, i := range items {
+			if int64(len(i.Val)) > quota.MaxValueSize {
+				return d, fail("value of item %s has %d bytes, at most %d are allowed", i.ID, len(i.Val), quota.MaxValueSize)
+			}
+		}
+	}
+
+	limited := quota.MaxBytes > 0 || quota.MaxItems > 0
+
+	if d, err = q.change(kg, items, limited); err != nil {
+		return d, err
+	}
+
+	if limited {
+		if err := q.count(kg, false); err != nil {
+			return d, err
+		}
+	}
+
+	// checking the usage and adding the write to it happens at once, so concurrent writes count against each other
+	q.Lock()
+	defer q.Unlock()
+
+	k := q.get(kg)
+
+	if limited {
+		u := Usage{Bytes: k.usage.Bytes + k.pending.Bytes, Items: k.usage.Items + k.pending.Items}
+
+		// writes that don't make a keygroup bigger are always allowed so that an exceeded quota can be fixed
+		if quota.MaxBytes > 0 && d.Bytes > 0 && u.Bytes+d.Bytes > quota.MaxBytes {
+			return d, fail("keygroup would use %d bytes, at most %d are allowed", u.Bytes+d.Bytes, quota.MaxBytes)
+		}
+
+		if quota.MaxItems > 0 && d.Items > 0 && u.Items+d.Items > quota.MaxItems {
+			return d, fail("keygroup would have %d items, at most %d are allowed", u.Items+d.Items, quota.MaxItems)
+		}
+	}
+
+	if quota.WritesPerSecond > 0 && !k.take(quota.WritesPerSecond) {
+		return d, fail("more than %d writes per second", quota.WritesPerSecond)
+	}
+
+	k.pending.Bytes += d.Bytes
+	k.pending.Items += d.Items
+
+	return d, nil
+}
+
+// change returns how writing some items changes the usage of a keygroup. It is only computed if the usage is limited or
+// has been counted before, as it means reading the items.
+func (q *quotaService) change(kg KeygroupName, items []Item, limited bool) (Usage, error) {
+	var d Usage
+
+	if !limited && !q.counted(kg) {
+		return d, nil
+	}
+
+	for _, i := range items {
+		c, err := q.s.change(i)
+
+		if err != nil {
+			return d, err
+		}
+
+		d.Bytes += c.Bytes
+		d.Items += c.Items
+	}
+
+	return d, nil
+}
+
+// take takes a token from the bucket of a keygroup, which holds at most one second worth of writes. It returns false
+// if the bucket is empty. The lock of the quotaService has to be held.
+func (k *kgQuota) take(rate int64) bool {
+	now := time.Now()
+
+	if k.last.IsZero() {
+		k.tokens = float64(rate)
+	} else {
+		k.tokens = min(float64(rate), k.tokens+now.Sub(k.last).Seconds()*float64(rate))
+	}
+
+	k.last = now
+
+	if k.tokens < 1 {
+		return false
+	}
+
+	k.tokens--
+	return true
+}
+
+// counted returns whether the usage of a keygroup has been counted.
+func (q *quotaService) counted(kg KeygroupName) bool {
+	q.Lock()
+	defer q.Unlock()
+
+	k, ok := q.kgs[kg]
+	return ok && !k.counted.IsZero()
+}
+
+// record adds the change of a successful write to the usage of a keygroup, if it has been counted or is being counted.
+// The lock of the quotaService has to be held.
+func (k *kgQuota) record(d Usage) {
+	if !k.counted.IsZero() {
+		k.usage.Bytes += d.Bytes
+		k.usage.Items += d.Items
+	}
+
+	if k.counting {
+		k.recorded.Bytes += d.Bytes
+		k.recorded.Items += d.Items
+	}
+}
+
+// record adds the change of a successful write that was not admitted, e.g., a delete, to the usage of a keygroup.
+func (q *quotaService) record(kg KeygroupName, d Usage) {
+	q.Lock()
+	defer q.Unlock()
+
+	q.get(kg).record(d)
+}
+
+// commit adds the change of an admitted write to the usage of a keygroup once the write has succeeded.
+func (q *quotaService) commit(kg KeygroupName, d Usage) {
+	q.Lock()
+	defer q.Unlock()
+
+	k := q.get(kg)
+	k.pending.Bytes -= d.Bytes
+	k.pending.Items -= d.Items
+	k.record(d)
+}
+
+// release drops the change of an admitted write that has failed.
+func (q *quotaService) release(kg KeygroupName, d Usage) {
+	q.Lock()
+	defer q.Unlock()
+
+	k := q.get(kg)
+	k.pending.Bytes -= d.Bytes
+	k.pending.Items -= d.Items
+}
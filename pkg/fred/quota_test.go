@@ -0,0 +1,255 @@
+package fred
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/badgerdb"
+	"github.com/stretchr/testify/assert"
+)
+
+// quotaNaSe is a NameService that only knows the quotas of keygroups.
+type quotaNaSe struct {
+	NameService
+	quotas map[KeygroupName]Quota
+}
+
+func (n *quotaNaSe) GetKeygroupQuota(kg KeygroupName) (Quota, error) {
+	return n.quotas[kg], nil
+}
+
+func TestUsage(t *testing.T) {
+	kg := KeygroupName("usagekg")
+
+	store := badgerdb.NewMemory()
+	t.Cleanup(func() { _ = store.Close() })
+
+	s := newStoreService(store, "X")
+	assert.NoError(t, s.createKeygroup(kg))
+
+	_, err := s.update(Item{Keygroup: kg, ID: "a", Val: "aaa"}, 0, nil)
+	assert.NoError(t, err)
+
+	_, err = s.update(Item{Keygroup: kg, ID: "b", Val: "bb"}, 0, nil)
+	assert.NoError(t, err)
+
+	_, err = s.tombstone(Item{Keygroup: kg, ID: "b"}, nil)
+	assert.NoError(t, err)
+
+	u, err := s.usage(kg)
+	assert.NoError(t, err)
+	assert.Equal(t, Usage{Bytes: 4 + 1, Items: 1}, u)
+
+	// overwriting a replaces its value
+	d, err := s.change(Item{Keygroup: kg, ID: "a", Val: "a"})
+	assert.NoError(t, err)
+	assert.Equal(t, Usage{Bytes: -2, Items: 0}, d)
+
+	// writing b brings it back
+	d, err = s.change(Item{Keygroup: kg, ID: "b", Val: "b"})
+	assert.NoError(t, err)
+	assert.Equal(t, Usage{Bytes: 1, Items: 1}, d)
+
+	d, err = s.change(Item{Keygroup: kg, ID: "a", Tombstoned: true})
+	assert.NoError(t, err)
+	assert.Equal(t, Usage{Bytes: -3, Items: -1}, d)
+}
+
+func TestQuota(t *testing.T) {
+	kg := KeygroupName("quotakg")
+
+	store := badgerdb.NewMemory()
+	t.Cleanup(func() { _ = store.Close() })
+
+	s := newStoreService(store, "X")
+	assert.NoError(t, s.createKeygroup(kg))
+
+	n := &quotaNaSe{quotas: map[KeygroupName]Quota{
+		kg: {MaxBytes: 10, MaxItems: 2, MaxValueSize: 5},
+	}}
+
+	q := newQuotaService(s, n)
+
+	write := func(id string, val string) error {
+		i := Item{Keygroup: kg, ID: id, Val: val}
+
+		d, err := q.admit(kg, []Item{i})
+
+		if err != nil {
+			return err
+		}
+
+		if _, err := s.update(i, 0, nil); err != nil {
+			q.release(kg, d)
+			return err
+		}
+
+		q.commit(kg, d)
+		return nil
+	}
+
+	var qerr *QuotaError
+
+	assert.ErrorAs(t, write("a", "too long"), &qerr)
+	assert.NoError(t, write("a", "aaaa"))
+	assert.NoError(t, write("b", "bbbb"))
+
+	u, err := q.usage(kg)
+	assert.NoError(t, err)
+	assert.Equal(t, Usage{Bytes: 10, Items: 2}, u)
+
+	// a third item is too many, a bigger value too big
+	assert.ErrorAs(t, write("c", ""), &qerr)
+	assert.ErrorAs(t, write("a", "aaaaa"), &qerr)
+
+	// writes that don't make the keygroup bigger are fine
+	assert.NoError(t, write("a", "a"))
+
+	u, err = q.usage(kg)
+	assert.NoError(t, err)
+	assert.Equal(t, Usage{Bytes: 7, Items: 2}, u)
+
+	// without a quota, everything is allowed
+	n.quotas[kg] = Quota{}
+	assert.NoError(t, write("c", "too long"))
+}
+
+func TestWriteRate(t *testing.T) {
+	kg := KeygroupName("ratekg")
+
+	store := badgerdb.NewMemory()
+	t.Cleanup(func() { _ = store.Close() })
+
+	s := newStoreService(store, "X")
+	assert.NoError(t, s.createKeygroup(kg))
+
+	q := newQuotaService(s, &quotaNaSe{quotas: map[KeygroupName]Quota{
+		kg: {WritesPerSecond: 2},
+	}})
+
+	i := []Item{{Keygroup: kg, ID: "a", Val: "a"}}
+
+	_, err := q.admit(kg, i)
+	assert.NoError(t, err)
+
+	_, err = q.admit(kg, i)
+	assert.NoError(t, err)
+
+	var qerr *QuotaError
+	_, err = q.admit(kg, i)
+	assert.ErrorAs(t, err, &qerr)
+}
+
+func TestQuotaConcurrentWriters(t *testing.T) {
+	kg := KeygroupName("concurrentkg")
+
+	store := badgerdb.NewMemory()
+	t.Cleanup(func() { _ = store.Close() })
+
+	s := newStoreService(store, "X")
+	assert.NoError(t, s.createKeygroup(kg))
+
+	q := newQuotaService(s, &quotaNaSe{quotas: map[KeygroupName]Quota{
+		kg: {MaxItems: 10},
+	}})
+
+	var wg sync.WaitGroup
+	var admitted sync.Map
+
+	for j := 0; j < 50; j++ {
+		wg.Add(1)
+		go func(j int) {
+			defer wg.Done()
+
+			i := Item{Keygroup: kg, ID: fmt.Sprintf("item%02d", j), Val: "val"}
+
+			d, err := q.admit(kg, []Item{i})
+
+			if err != nil {
+				return
+			}
+
+			if _, err := s.update(i, 0, nil); err != nil {
+				q.release(kg, d)
+				return
+			}
+
+			q.commit(kg, d)
+			admitted.Store(i.ID, struct{}{})
+		}(j)
+	}
+
+	wg.Wait()
+
+	n := 0
+	admitted.Range(func(_, _ interface{}) bool {
+		n++
+		return true
+	})
+
+	// every writer checks the quota with the writes of the others, so only as many items as allowed are written
+	assert.Equal(t, 10, n)
+
+	u, err := q.usage(kg)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), u.Items)
+
+	// counting again finds the same usage
+	assert.NoError(t, q.count(kg, true))
+
+	u, err = q.usage(kg)
+	assert.NoError(t, err)
+	assert.Equal(t, Usage{Bytes: 10 * int64(len("item00val")), Items: 10}, u)
+}
+
+// crdtQuotaNaSe is a quotaNaSe whose keygroups are sets that everyone may update.
+type crdtQuotaNaSe struct {
+	quotaNaSe
+}
+
+func (n *crdtQuotaNaSe) GetUserPermissions(_ string, _ KeygroupName) (map[Method]struct{}, error) {
+	return map[Method]struct{}{Update: {}}, nil
+}
+
+func (n *crdtQuotaNaSe) GetKeygroupType(_ KeygroupName) (KeygroupType, error) {
+	return ORSet, nil
+}
+
+func (n *crdtQuotaNaSe) IsReadOnly(_ KeygroupName) (bool, error) {
+	return false, nil
+}
+
+func (n *crdtQuotaNaSe) GetExpiry(_ KeygroupName) (int, error) {
+	return 0, nil
+}
+
+func TestQuotaCRDT(t *testing.T) {
+	kg := KeygroupName("setkg")
+
+	store := badgerdb.NewMemory()
+	t.Cleanup(func() { _ = store.Close() })
+
+	s := newStoreService(store, "X")
+	assert.NoError(t, s.createKeygroup(kg))
+
+	n := &crdtQuotaNaSe{quotaNaSe{quotas: map[KeygroupName]Quota{
+		kg: {MaxValueSize: 10},
+	}}}
+
+	q := newQuotaService(s, n)
+
+	h := &ExtHandler{s: s, q: q, a: newAuthService(n, nil), n: n}
+
+	// the state of the set is bigger than a value may be
+	var qerr *QuotaError
+	_, _, err := h.HandleSetAdd("user", Item{Keygroup: kg, ID: "set"}, []string{"a", "b", "c"})
+	assert.ErrorAs(t, err, &qerr)
+
+	items, err := s.read(kg, "set")
+	assert.NoError(t, err)
+	assert.Empty(t, items)
+
+	// the rejected write does not count against the quota
+	assert.Equal(t, Usage{}, q.get(kg).pending)
+}
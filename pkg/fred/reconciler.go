@@ -0,0 +1,226 @@
+package fred
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// reconcilerElection is the election in the NaSe that decides which node reconciles the replicas of all keygroups.
+const reconcilerElection = "reconciler"
+
+// ReplicationPolicy keeps a keygroup on a minimum number of replicas that are alive. If fewer of its replicas are
+// alive, e.g. because nodes have failed permanently, replicas are added on other nodes that meet the constraints.
+type ReplicationPolicy struct {
+	// MinReplicas is the number of replicas that should be alive, 0 means that no replicas are added automatically.
+	MinReplicas int
+	// Labels have to be set to the same values on the nodes that replicas are added on.
+	Labels map[string]string
+	// GeohashPrefix is what the geohash of the location of the nodes that replicas are added on has to start with.
+	GeohashPrefix string
+}
+
+// checkReplicationPolicy checks that a replication policy is valid.
+func checkReplicationPolicy(p ReplicationPolicy) error {
+	if p.MinReplicas < 0 {
+		return errors.Errorf("minimum number of replicas must not be negative, got %d", p.MinReplicas)
+	}
+
+	return nil
+}
+
+// allows returns whether replicas may be added on a node.
+func (p ReplicationPolicy) allows(info NodeInfo) bool {
+	for k, v := range p.Labels {
+		if l, ok := info.Labels[k]; !ok || l != v {
+			return false
+		}
+	}
+
+	return strings.HasPrefix(info.Geohash, p.GeohashPrefix)
+}
+
+// runReconciler campaigns to be the node that reconciles the replicas of all keygroups and does so each interval for
+// as long as it is elected. It does not return.
+func (s *replicationService) runReconciler(interval time.Duration) {
+	for {
+		lost, err := s.n.Campaign(reconcilerElection, nil)
+
+		if err != nil {
+			log.Err(err).Msg("reconciler from replservice: could not campaign to be the reconciler")
+			time.Sleep(interval)
+			continue
+		}
+
+		log.Info().Msg("reconciler from replservice: this node is now the reconciler")
+
+		t := time.NewTicker(interval)
+
+		s.reconcile()
+
+	elected:
+		for {
+			select {
+			case <-lost:
+				break elected
+			case <-t.C:
+				s.reconcile()
+			}
+		}
+
+		t.Stop()
+
+		log.Warn().Msg("reconciler from replservice: this node is no longer the reconciler")
+	}
+}
+
+// reconcile adds replicas to every keygroup that has fewer replicas alive than its replication policy asks for.
+func (s *replicationService) reconcile() {
+	kgs, err := s.n.GetAllKeygroups()
+
+	if err != nil {
+		log.Err(err).Msg("reconciler from replservice: could not get keygroups")
+		return
+	}
+
+	for _, kg := range kgs {
+		if err := s.reconcileKeygroup(kg); err != nil {
+			log.Err(err).Msgf("reconciler from replservice: could not reconcile keygroup %s", kg)
+		}
+	}
+}
+
+// reconcileKeygroup adds replicas to a keygroup until as many are alive as its replication policy asks for, or until
+// there are no more nodes that the policy allows. Replicas that are dead are kept as members in case they come back.
+// The new replicas get their data from a replica that is alive, which is this node if it is one.
+func (s *replicationService) reconcileKeygroup(kg KeygroupName) error {
+	p, err := s.n.GetKeygroupReplicationPolicy(kg)
+
+	if err != nil {
+		return err
+	}
+
+	if p.MinReplicas == 0 {
+		return nil
+	}
+
+	members, err := s.n.GetKeygroupMembers(kg, false)
+
+	if err != nil {
+		return err
+	}
+
+	alive, _ := s.livePeers(members)
+
+	if len(alive) >= p.MinReplicas {
+		return nil
+	}
+
+	if len(alive) == 0 {
+		return errors.Errorf("keygroup %s has no replica left that is alive to copy its data from", kg)
+	}
+
+	candidates, err := s.placementCandidates(members, p)
+
+	if err != nil {
+		return err
+	}
+
+	missing := p.MinReplicas - len(alive)
+
+	if len(candidates) < missing {
+		log.Warn().Msgf("reconciler from replservice: keygroup %s needs %d more replicas but only %d nodes are alive and allowed by its policy", kg, missing, len(candidates))
+		missing = len(candidates)
+	}
+
+	source := s.replicaSource(members, alive)
+
+	for _, id := range candidates[:missing] {
+		log.Info().Msgf("reconciler from replservice: keygroup %s has %d of %d replicas alive, adding a replica on %s with data from %s", kg, len(alive), p.MinReplicas, id, source)
+
+		if err := s.addReplicaFrom(Keygroup{Name: kg, Expiry: members[source]}, source, id); err != nil {
+			return err
+		}
+
+		alive = append(alive, id)
+	}
+
+	return nil
+}
+
+// replicaSource returns the replica that new replicas of a keygroup get their data from, which is this node if it is
+// one of them, and otherwise the replica with the smallest id that is alive.
+func (s *replicationService) replicaSource(members map[NodeID]int, alive []NodeID) NodeID {
+	if _, ok := members[s.n.GetNodeID()]; ok {
+		return s.n.GetNodeID()
+	}
+
+	source := alive[0]
+
+	for _, id := range alive[1:] {
+		if id < source {
+			source = id
+		}
+	}
+
+	return source
+}
+
+// addReplicaFrom adds a node as a replica of a keygroup with the data of source. If source is not this node, it is
+// asked to add the replica, as only a replica of a keygroup can send its data to a new one.
+func (s *replicationService) addReplicaFrom(k Keygroup, source NodeID, id NodeID) error {
+	if source == s.n.GetNodeID() {
+		return s.addReplica(k, Node{ID: id})
+	}
+
+	addr, err := s.n.GetNodeAddress(source)
+
+	if err != nil {
+		return err
+	}
+
+	return s.c.SendAddReplica(addr, k.Name, id, k.Expiry)
+}
+
+// placementCandidates returns the nodes that are alive, not yet members of a keygroup, and allowed by its replication
+// policy, ordered by their id.
+func (s *replicationService) placementCandidates(members map[NodeID]int, p ReplicationPolicy) ([]NodeID, error) {
+	nodes, err := s.n.GetAllNodes()
+
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]NodeID, 0)
+
+	for _, n := range nodes {
+		if _, ok := members[n.ID]; ok {
+			continue
+		}
+
+		if !s.isAlive(n.ID) {
+			continue
+		}
+
+		info, err := s.n.GetNodeInfo(n.ID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !p.allows(info) {
+			continue
+		}
+
+		candidates = append(candidates, n.ID)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i] < candidates[j]
+	})
+
+	return candidates, nil
+}
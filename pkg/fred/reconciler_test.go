@@ -0,0 +1,121 @@
+package fred
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// reconcilerNaSe is a NameService with a single keygroup and a set of nodes that have labels and locations.
+type reconcilerNaSe struct {
+	NameService
+	policy  ReplicationPolicy
+	members map[NodeID]int
+	nodes   map[NodeID]NodeInfo
+}
+
+func (n *reconcilerNaSe) GetNodeID() NodeID {
+	return "self"
+}
+
+func (n *reconcilerNaSe) GetKeygroupReplicationPolicy(_ KeygroupName) (ReplicationPolicy, error) {
+	return n.policy, nil
+}
+
+func (n *reconcilerNaSe) GetKeygroupMembers(_ KeygroupName, _ bool) (map[NodeID]int, error) {
+	return n.members, nil
+}
+
+func (n *reconcilerNaSe) GetAllNodes() ([]Node, error) {
+	nodes := make([]Node, 0, len(n.nodes))
+
+	for id := range n.nodes {
+		nodes = append(nodes, Node{ID: id, Host: string(id)})
+	}
+
+	return nodes, nil
+}
+
+func (n *reconcilerNaSe) GetNodeInfo(nodeID NodeID) (NodeInfo, error) {
+	return n.nodes[nodeID], nil
+}
+
+func (n *reconcilerNaSe) GetNodeAddress(nodeID NodeID) (string, error) {
+	return string(nodeID), nil
+}
+
+// reconcilerClient remembers which replicas it was asked to add.
+type reconcilerClient struct {
+	Client
+	added map[string][]NodeID
+}
+
+func (c *reconcilerClient) SendAddReplica(host string, _ KeygroupName, nodeID NodeID, _ int) error {
+	c.added[host] = append(c.added[host], nodeID)
+	return nil
+}
+
+func TestReplicationPolicyAllows(t *testing.T) {
+	p := ReplicationPolicy{
+		MinReplicas:   2,
+		Labels:        map[string]string{"zone": "a"},
+		GeohashPrefix: "u33",
+	}
+
+	assert.True(t, p.allows(NodeInfo{Labels: map[string]string{"zone": "a", "disk": "ssd"}, Geohash: "u33dc0"}))
+	assert.False(t, p.allows(NodeInfo{Labels: map[string]string{"zone": "b"}, Geohash: "u33dc0"}))
+	assert.False(t, p.allows(NodeInfo{Geohash: "u33dc0"}))
+	assert.False(t, p.allows(NodeInfo{Labels: map[string]string{"zone": "a"}, Geohash: "u281z7"}))
+	assert.True(t, ReplicationPolicy{}.allows(NodeInfo{}))
+
+	assert.NoError(t, checkReplicationPolicy(p))
+	assert.Error(t, checkReplicationPolicy(ReplicationPolicy{MinReplicas: -1}))
+}
+
+func TestReconcileKeygroup(t *testing.T) {
+	n := &reconcilerNaSe{
+		policy: ReplicationPolicy{
+			MinReplicas: 3,
+			Labels:      map[string]string{"zone": "a"},
+		},
+		members: map[NodeID]int{"A": 10, "B": 10, "C": 10},
+		nodes: map[NodeID]NodeInfo{
+			"self": {Labels: map[string]string{"zone": "a"}},
+			"A":    {Labels: map[string]string{"zone": "a"}},
+			"B":    {Labels: map[string]string{"zone": "a"}},
+			"C":    {Labels: map[string]string{"zone": "a"}},
+			"D":    {Labels: map[string]string{"zone": "b"}},
+			"E":    {Labels: map[string]string{"zone": "a"}},
+			"F":    {Labels: map[string]string{"zone": "a"}},
+		},
+	}
+	c := &reconcilerClient{added: make(map[string][]NodeID)}
+
+	r := newReplicationService(nil, c, n, nil, nil, false, nil, 0)
+	r.setNodeStatus("A", NodeAlive)
+	r.setNodeStatus("B", NodeDead)
+	r.setNodeStatus("C", NodeDead)
+	r.setNodeStatus("E", NodeAlive)
+	r.setNodeStatus("F", NodeDead)
+
+	// only A is alive, so two replicas are added with data from A on nodes that are alive and in zone a
+	assert.NoError(t, r.reconcileKeygroup("kg"))
+	assert.Equal(t, map[string][]NodeID{"A": {"E", "self"}}, c.added)
+
+	// enough replicas are alive
+	c.added = make(map[string][]NodeID)
+	n.members = map[NodeID]int{"A": 10, "E": 10, "self": 10}
+	r.setNodeStatus("self", NodeAlive)
+	assert.NoError(t, r.reconcileKeygroup("kg"))
+	assert.Empty(t, c.added)
+
+	// no replica is alive to copy the data from
+	n.members = map[NodeID]int{"B": 10, "C": 10}
+	assert.Error(t, r.reconcileKeygroup("kg"))
+	assert.Empty(t, c.added)
+
+	// keygroups without a minimum are left alone
+	n.policy = ReplicationPolicy{}
+	assert.NoError(t, r.reconcileKeygroup("kg"))
+	assert.Empty(t, c.added)
+}
@@ -12,29 +12,59 @@ import (
 type Client interface {
 	SendCreateKeygroup(host string, kgname KeygroupName, expiry int) error
 	SendDeleteKeygroup(host string, kgname KeygroupName) error
-	SendUpdate(host string, kgname KeygroupName, id string, value string, tombstoned bool, vvector vclock.VClock) error
-	SendAppend(host string, kgname KeygroupName, id string, value string) error
+	SendUpdate(host string, kgname KeygroupName, source NodeID, id string, value string, tombstoned bool, vvector vclock.VClock, ttl int, timestamp uint64) error
+	SendAppend(host string, kgname KeygroupName, source NodeID, id string, value string, ttl int) error
 	SendGetItem(host string, kgname KeygroupName, id string) ([]Item, error)
 	SendGetAllItems(host string, kgname KeygroupName) ([]Item, error)
+	SendTransferKeygroup(host string, kgname KeygroupName, checkpoint string, pageSize uint64, receive func(items []Item) error) error
+	SendPushKeygroup(host string, kgname KeygroupName, source NodeID, next func() ([]Item, error)) error
+	SendGetMerkleTree(host string, kgname KeygroupName) ([]uint64, error)
+	SendGetBucketItems(host string, kgname KeygroupName, buckets []int, receive func(items []Item) error) error
+	SendReplicateBatch(host string, source NodeID, batch []ReplicationMessage) (int, []int, error)
+	SendPutBatch(host string, kgname KeygroupName, source NodeID, items []Item) error
+	SendAcknowledgeTombstones(host string, kgname KeygroupName, items []Item) ([]string, []string, error)
+	SendCloneKeygroup(host string, src KeygroupName, dst KeygroupName) error
+	SendAddReplica(host string, kgname KeygroupName, nodeID NodeID, expiry int) error
 }
 
+// transferPageSize is the number of items that are sent at once when a new replica is added to a keygroup.
+const transferPageSize = 1000
+
 type replicationService struct {
-	c     Client
-	s     *storeService
-	n     NameService
-	async bool
+	c           Client
+	s           *storeService
+	n           NameService
+	w           *watchService
+	l           *changeLogService
+	async       bool
+	o           Outbox
+	outboxSize  uint64
+	workers     map[NodeID]*outboxWorker
+	workersLock sync.Mutex
+	status      map[NodeID]NodeStatus
+	statusLock  sync.RWMutex
 }
 
 // newReplicationService creates a new handler for internal request (i.e. from peer nodes or the naming service).
 // The nameservice makes sure that the information is synced with the other nodes.
 // The experimental async flag determines whether the replication service should send the replication messages asynchronously.
 // This only applies to data item updates (update, delete, append), not keygroup modification.
-func newReplicationService(s *storeService, c Client, n NameService, async bool) *replicationService {
+// If an outbox is given, asynchronous replication messages are queued there and delivered at least once, with at most
+// outboxSize messages queued per peer. Otherwise, they are sent once on a best-effort basis.
+// Items that change without a client asking for it, e.g., when replicas are repaired, are passed on to the watch service
+// and the change log.
+func newReplicationService(s *storeService, c Client, n NameService, w *watchService, l *changeLogService, async bool, o Outbox, outboxSize uint64) *replicationService {
 	service := &replicationService{
-		s:     s,
-		c:     c,
-		n:     n,
-		async: async,
+		s:          s,
+		c:          c,
+		n:          n,
+		w:          w,
+		l:          l,
+		async:      async,
+		o:          o,
+		outboxSize: outboxSize,
+		workers:    make(map[NodeID]*outboxWorker),
+		status:     make(map[NodeID]NodeStatus),
 	}
 
 	return service
@@ -80,6 +110,50 @@ func (s *replicationService) createKeygroup(k Keygroup) error {
 		return err
 	}
 
+	// always store the index definitions, conflict policy, type, history retention, quota, compression, and replication
+	// policy so that nothing of an earlier keygroup with that name remains
+	err = s.n.SetKeygroupIndexes(k.Name, k.Indexes)
+	if err != nil {
+		log.Err(err).Msg("Error storing Keygroup indexes in NaSe")
+		return err
+	}
+
+	err = s.n.SetConflictPolicy(k.Name, k.ConflictPolicy)
+	if err != nil {
+		log.Err(err).Msg("Error storing Keygroup conflict policy in NaSe")
+		return err
+	}
+
+	err = s.n.SetKeygroupType(k.Name, k.Type)
+	if err != nil {
+		log.Err(err).Msg("Error storing Keygroup type in NaSe")
+		return err
+	}
+
+	err = s.n.SetKeygroupHistory(k.Name, k.History)
+	if err != nil {
+		log.Err(err).Msg("Error storing Keygroup history retention in NaSe")
+		return err
+	}
+
+	err = s.n.SetKeygroupQuota(k.Name, k.Quota)
+	if err != nil {
+		log.Err(err).Msg("Error storing Keygroup quota in NaSe")
+		return err
+	}
+
+	err = s.n.SetKeygroupCompression(k.Name, k.Compression)
+	if err != nil {
+		log.Err(err).Msg("Error storing Keygroup compression in NaSe")
+		return err
+	}
+
+	err = s.n.SetKeygroupReplicationPolicy(k.Name, k.ReplicationPolicy)
+	if err != nil {
+		log.Err(err).Msg("Error storing Keygroup replication policy in NaSe")
+		return err
+	}
+
 	return err
 }
 
@@ -182,9 +256,30 @@ func (s *replicationService) relayUpdate(i Item) error {
 		return err
 	}
 
+	if s.async && s.o != nil {
+		for id := range ids {
+			if err := s.enqueue(id, ReplicationMessage{Item: i}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	// nodes that are known to be dead would only make us wait, so we record right away that they missed the update
+	alive, dead := s.livePeers(ids)
+
+	for _, id := range dead {
+		log.Debug().Msgf("RelayUpdate from replservice: skipping %s as it is not alive", id)
+
+		if err := s.reportNodeFail(id, i.Keygroup, i.ID); err != nil {
+			log.Debug().Msg(err.Error())
+		}
+	}
+
 	addrs := make(map[NodeID]string)
 
-	for id := range ids {
+	for _, id := range alive {
 		addr, err := s.n.GetNodeAddress(id)
 
 		if err != nil {
@@ -204,7 +299,7 @@ func (s *replicationService) relayUpdate(i Item) error {
 			defer wg.Done()
 
 			log.Debug().Msgf("RelayUpdate from replservice: sending %+v to %+v", i, addr)
-			if err := s.c.SendUpdate(addr, i.Keygroup, i.ID, i.Val, i.Tombstoned, i.Version); err != nil {
+			if err := s.c.SendUpdate(addr, i.Keygroup, s.n.GetNodeID(), i.ID, i.Val, i.Tombstoned, i.Version, i.TTL, i.Timestamp); err != nil {
 				err = s.reportNodeFail(id, i.Keygroup, i.ID)
 
 				if err != nil {
@@ -221,6 +316,91 @@ func (s *replicationService) relayUpdate(i Item) error {
 	return nil
 }
 
+// relayBatch handles replication after requests to the Batch endpoint of the client interface.
+// It sends all items to all other nodes at once so that they can apply them atomically.
+func (s *replicationService) relayBatch(kg KeygroupName, items []Item) error {
+	log.Debug().Msgf("relayBatch from replservice: in %d items for %s", len(items), kg)
+
+	exists, err := s.n.ExistsKeygroup(kg)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		err = errors.Errorf("no such keygroup according to NaSe: %+v", kg)
+		return err
+	}
+
+	// inform all other nodes about the batch, get a list of all nodes subscribed to this keygroup
+	ids, err := s.n.GetKeygroupMembers(kg, true)
+	if err != nil {
+		log.Err(err).Msg("Cannot relayBatch because the nase threw an error")
+		return err
+	}
+
+	if s.async && s.o != nil {
+		for id := range ids {
+			if err := s.enqueue(id, ReplicationMessage{Item: Item{Keygroup: kg}, Batch: items}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	// nodes that are known to be dead would only make us wait, so we record right away that they missed the batch
+	alive, dead := s.livePeers(ids)
+
+	for _, id := range dead {
+		log.Debug().Msgf("relayBatch from replservice: skipping %s as it is not alive", id)
+
+		for _, i := range items {
+			if err := s.reportNodeFail(id, kg, i.ID); err != nil {
+				log.Debug().Msg(err.Error())
+			}
+		}
+	}
+
+	addrs := make(map[NodeID]string)
+
+	for _, id := range alive {
+		addr, err := s.n.GetNodeAddress(id)
+
+		if err != nil {
+			log.Err(err).Msg("Cannot Get node address from NaSe")
+			return err
+		}
+
+		addrs[id] = addr
+	}
+
+	// wait group to wait for all the other nodes to receive the batch
+	var wg sync.WaitGroup
+
+	for id, addr := range addrs {
+		wg.Add(1)
+		go func(addr string, id NodeID) {
+			defer wg.Done()
+
+			log.Debug().Msgf("relayBatch from replservice: sending %d items to %+v", len(items), addr)
+			if err := s.c.SendPutBatch(addr, kg, s.n.GetNodeID(), items); err != nil {
+				for _, i := range items {
+					err = s.reportNodeFail(id, kg, i.ID)
+
+					if err != nil {
+						log.Debug().Msg(err.Error())
+					}
+				}
+			}
+		}(addr, id)
+	}
+
+	if !s.async {
+		wg.Wait()
+	}
+
+	return nil
+}
+
 // relayAppend handles replication after requests to the Append endpoint of the client interface.
 // It sends the append to all other nodes by calling their Append method
 func (s *replicationService) relayAppend(i Item) error {
@@ -242,9 +422,30 @@ func (s *replicationService) relayAppend(i Item) error {
 		return err
 	}
 
+	if s.async && s.o != nil {
+		for id := range ids {
+			if err := s.enqueue(id, ReplicationMessage{Append: true, Item: i}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	// nodes that are known to be dead would only make us wait, so we record right away that they missed the append
+	alive, dead := s.livePeers(ids)
+
+	for _, id := range dead {
+		log.Debug().Msgf("relayAppend from replservice: skipping %s as it is not alive", id)
+
+		if err := s.reportNodeFail(id, i.Keygroup, i.ID); err != nil {
+			log.Debug().Msg(err.Error())
+		}
+	}
+
 	addrs := make(map[NodeID]string)
 
-	for id := range ids {
+	for _, id := range alive {
 		addr, err := s.n.GetNodeAddress(id)
 
 		if err != nil {
@@ -264,7 +465,7 @@ func (s *replicationService) relayAppend(i Item) error {
 			defer wg.Done()
 
 			log.Debug().Msgf("relayAppend from replservice: sending %+v to %+v", i, addr)
-			if err := s.c.SendAppend(addr, i.Keygroup, i.ID, i.Val); err != nil {
+			if err := s.c.SendAppend(addr, i.Keygroup, s.n.GetNodeID(), i.ID, i.Val, i.TTL); err != nil {
 				err = s.reportNodeFail(id, i.Keygroup, i.ID)
 
 				if err != nil {
@@ -322,80 +523,178 @@ func (s *replicationService) addReplica(k Keygroup, n Node) error {
 		return err
 	}
 
-	// let's tell this new node that it should create a local copy of this keygroup
-	err = s.c.SendCreateKeygroup(newNodeAddr, k.Name, k.Expiry)
+	// if an earlier attempt to add this replica failed while transferring data, we can continue where it stopped
+	// in that case, the new node already has a local copy of this keygroup
+	source, checkpoint, err := s.n.GetTransferCheckpoint(k.Name, n.ID)
+
 	if err != nil {
 		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
 		return err
 	}
 
+	if source == "" {
+		// let's tell this new node that it should create a local copy of this keygroup
+		err = s.c.SendCreateKeygroup(newNodeAddr, k.Name, k.Expiry)
+		if err != nil {
+			log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+			return err
+		}
+	}
+
 	// send all existing data to the new node
 	// there are three basic possibilities:
 	// we are a replica for this keygroup and we need to send someone else the data
 	// we are not a replica for this keygroup and we need to get the data from somewhere
 	// we are not a replica but we are also not the new replica
 	// in the last case we just have nothing to do with this and error out
-	// TODO so this one array contains all data items? Maybe not a good idea if there is a lot of data to be sent
-	var i []Item
-
+	// data is streamed in pages and the new node stores a checkpoint after every page, so we never need to hold the
+	// entire keygroup in memory and can resume a failed transfer
 	if n.ID != s.n.GetNodeID() {
 		// we are adding a new node and we have all the data: send our data
-		i, err = s.s.readAll(k.Name)
+		// a checkpoint is only valid for the node that created it, as item order depends on the store
+		if source != s.n.GetNodeID() {
+			checkpoint = ""
+		}
+
+		err = s.n.SetTransferCheckpoint(k.Name, n.ID, s.n.GetNodeID(), checkpoint)
 
 		if err != nil {
 			log.Err(err).Msg(err.(*errors.Error).ErrorStack())
-			return errors.Errorf("error adding replica")
+			return err
 		}
+
+		log.Debug().Msgf("AddReplica from replservice: pushing keygroup %s to %+v starting after %s", k.Name, n, checkpoint)
+
+		err = s.c.SendPushKeygroup(newNodeAddr, k.Name, s.n.GetNodeID(), func() ([]Item, error) {
+			i, err := s.s.readPage(k.Name, checkpoint, transferPageSize)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if len(i) > 0 {
+				checkpoint = i[len(i)-1].ID
+			}
+
+			s.s.addTimestamps(k.Name, i)
+
+			return i, nil
+		})
 	} else {
 		// oh no! We are the new node and have no data locally, let's request it from somewhere
 		// take a victim with a higher expiry to request data from (but not ourselves!)
-		_, addr := s.n.GetNodeWithBiggerExpiry(k.Name)
+		nodeID, addr := s.n.GetNodeWithBiggerExpiry(k.Name)
 
 		if addr == "" {
 			log.Error().Msgf("AddReplica: Can not find node to get this keygroup data from, so this keygroup is empty")
 			// TODO is this an error? because there is nothing you can do (except tell the user to not fuck up their replica placement)
-			return nil
+			return s.n.DeleteTransferCheckpoint(k.Name, n.ID)
 		}
 
-		i, err = s.c.SendGetAllItems(addr, k.Name)
+		if source != nodeID {
+			checkpoint = ""
+		}
+
+		err = s.n.SetTransferCheckpoint(k.Name, n.ID, nodeID, checkpoint)
 
 		if err != nil {
 			log.Err(err).Msg(err.(*errors.Error).ErrorStack())
-			return errors.Errorf("error adding replica")
+			return err
+		}
+
+		log.Debug().Msgf("AddReplica from replservice: requesting keygroup %s from %s starting after %s", k.Name, nodeID, checkpoint)
+
+		err = s.c.SendTransferKeygroup(addr, k.Name, checkpoint, transferPageSize, func(i []Item) error {
+			return s.receiveItemPage(k.Name, nodeID, i)
+		})
+	}
+
+	if err != nil {
+		log.Err(err).Msg(err.(*errors.Error).ErrorStack())
+		return errors.Errorf("error adding replica: transfer of keygroup %s to %s was interrupted, add the replica again to resume", k.Name, n.ID)
+	}
+
+	return s.n.DeleteTransferCheckpoint(k.Name, n.ID)
+}
+
+// receiveItemPage stores a page of items that was transferred to this node while it is added to a keygroup.
+// Once all items are stored, the last id of the page is saved as the checkpoint of the transfer.
+func (s *replicationService) receiveItemPage(kg KeygroupName, source NodeID, i []Item) error {
+	if len(i) == 0 {
+		return nil
+	}
+
+	log.Debug().Msgf("receiveItemPage from replservice: storing %d items from %s", len(i), source)
+
+	err := s.storeRemoteItems(kg, source, i)
+
+	if err != nil {
+		return err
+	}
+
+	return s.n.SetTransferCheckpoint(kg, s.n.GetNodeID(), source, i[len(i)-1].ID)
+}
+
+// publish passes items that have changed on this node on to watchers and the change log.
+func (s *replicationService) publish(items []Item, appended bool, origin NodeID) {
+	for _, i := range items {
+		s.w.notify(i)
+
+		if err := s.l.record(i, appended, origin); err != nil {
+			log.Err(err).Msg(err.(*errors.Error).ErrorStack())
 		}
 	}
+}
 
-	mutable, err := s.n.IsMutable(k.Name)
+// storeRemoteItems stores items that we got from another replica of the keygroup.
+// Items that we already know are skipped, so it is safe to store the same items twice.
+func (s *replicationService) storeRemoteItems(kg KeygroupName, source NodeID, i []Item) error {
+	mutable, err := s.n.IsMutable(kg)
+
+	if err != nil {
+		return err
+	}
+
+	expiry, err := s.n.GetExpiry(kg)
+
+	if err != nil {
+		return err
+	}
+
+	policy, err := s.n.GetConflictPolicy(kg)
 
 	if err != nil {
 		return err
 	}
 
-	log.Debug().Msgf("AddReplica from replservice: About to send %d Elements to new node", len(i))
 	for _, item := range i {
-		// iterate over all data for that keygroup and send it to the new node
-		// a batch might be better here
-		if mutable {
-			log.Debug().Msgf("AddReplica from replservice: sending %+v to %+v (update)", item, n)
-			if err := s.c.SendUpdate(newNodeAddr, k.Name, item.ID, item.Val, item.Tombstoned, item.Version); err != nil {
-				err = s.reportNodeFail(n.ID, k.Name, item.ID)
-				if err != nil {
-					log.Debug().Msg(err.Error())
-				}
+		item.Keygroup = kg
+
+		if !mutable {
+			// items in an immutable keygroup can never change
+			if s.s.exists(item) {
+				continue
 			}
-		} else {
-			log.Debug().Msgf("AddReplica from replservice: sending %+v to %+v (append)", item, n)
-			if err := s.c.SendAppend(newNodeAddr, k.Name, item.ID, item.Val); err != nil {
-				err = s.reportNodeFail(n.ID, k.Name, item.ID)
-				if err != nil {
-					log.Debug().Msg(err.Error())
-				}
+
+			if err := s.s.append(item, expiry); err != nil {
+				return err
 			}
+
+			s.publish([]Item{item}, true, source)
+			continue
 		}
+
+		// adding a version we already know (or an older one) is a no-op
+		stored, err := s.s.addVersion(item, item.Version, expiry, policy)
+
+		if err != nil {
+			return err
+		}
+
+		s.publish(stored, false, source)
 	}
 
 	return nil
-
 }
 
 // removeReplica handles replication after requests to the RemoveReplica endpoint
@@ -22,9 +22,10 @@ var (
 			Delete: {},
 		},
 		ConfigureReplica: {
-			AddReplica:    {},
-			GetReplica:    {},
-			RemoveReplica: {},
+			AddReplica:           {},
+			GetReplica:           {},
+			RemoveReplica:        {},
+			SetReplicationPolicy: {},
 		},
 		ConfigureTrigger: {
 			GetTrigger:    {},
@@ -35,6 +36,9 @@ var (
 			DeleteKeygroup: {},
 			AddUser:        {},
 			RemoveUser:     {},
+			ExportKeygroup: {},
+			ImportKeygroup: {},
+			SetQuota:       {},
 		},
 	}
 )
@@ -0,0 +1,39 @@
+package fred
+
+import (
+	"encoding/base64"
+
+	"github.com/go-errors/errors"
+)
+
+// ScanOptions restrict and order the items that a scan returns.
+type ScanOptions struct {
+	// End is the id at which the scan stops (exclusive), empty means that the scan goes to the end of the keygroup.
+	End string
+	// Prefix restricts the scan to items with ids that start with it.
+	Prefix string
+	// Reverse returns items in descending order of their ids.
+	Reverse bool
+	// Token continues a previous scan with the same parameters after the last item it returned.
+	Token string
+}
+
+// encodeScanToken creates a token to continue a scan after the item with the given id.
+func encodeScanToken(id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(id))
+}
+
+// decodeScanToken returns the id of the last item of a scan from a token.
+func decodeScanToken(token string) (string, error) {
+	id, err := base64.RawURLEncoding.DecodeString(token)
+
+	if err != nil {
+		return "", errors.Errorf("malformed scan token %s", token)
+	}
+
+	if err := checkID(string(id)); err != nil {
+		return "", err
+	}
+
+	return string(id), nil
+}
@@ -0,0 +1,60 @@
+package fred
+
+import (
+	"testing"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/badgerdb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanPages(t *testing.T) {
+	store := badgerdb.NewMemory()
+	defer store.Close()
+
+	s := newStoreService(store, "X")
+
+	kg := KeygroupName("scankg")
+
+	assert.NoError(t, s.createKeygroup(kg))
+
+	all := []string{"a", "ab", "ab1", "ab2", "abc", "b", "b1", "c"}
+
+	for _, id := range all {
+		_, err := s.update(Item{Keygroup: kg, ID: id, Val: "val" + id}, 0, nil)
+		assert.NoError(t, err)
+	}
+
+	scan := func(id string, o ScanOptions) []string {
+		result := make([]string, 0)
+
+		for {
+			items, token, err := s.scan(kg, id, 3, o)
+			assert.NoError(t, err)
+			assert.LessOrEqual(t, len(items), 3)
+
+			for _, i := range items {
+				result = append(result, i.ID)
+			}
+
+			if token == "" {
+				return result
+			}
+
+			o.Token = token
+		}
+	}
+
+	assert.Equal(t, all, scan("", ScanOptions{}))
+	assert.Equal(t, []string{"c", "b1", "b", "abc", "ab2", "ab1", "ab", "a"}, scan("", ScanOptions{Reverse: true}))
+	assert.Equal(t, []string{"ab1", "ab2", "abc", "b"}, scan("ab1", ScanOptions{End: "b1"}))
+	assert.Equal(t, []string{"b", "abc", "ab2", "ab1"}, scan("ab1", ScanOptions{End: "b1", Reverse: true}))
+	assert.Equal(t, []string{"ab", "ab1", "ab2", "abc"}, scan("", ScanOptions{Prefix: "ab"}))
+	assert.Equal(t, []string{"abc", "ab2", "ab1", "ab"}, scan("", ScanOptions{Prefix: "ab", Reverse: true}))
+
+	// the first item has to exist
+	_, _, err := s.scan(kg, "aa", 3, ScanOptions{})
+	assert.Error(t, err)
+
+	_, _, err = s.scan(kg, "", 3, ScanOptions{Token: "!"})
+	assert.Error(t, err)
+}
@@ -10,19 +10,35 @@ import (
 )
 
 // Store is an interface for the storage medium that the key-value val items are persisted on.
+// Values are arbitrary bytes, including empty values. Deleted items are kept as tombstones, which are versions that are
+// explicitly marked as such.
 type Store interface {
-	// Update Needs: keygroup, id, val
-	Update(kg string, id string, val string, expiry int, vvector vclock.VClock) error
+	// Update Needs: keygroup, id, val, whether this version is a tombstone
+	Update(kg string, id string, val string, tombstoned bool, expiry int, vvector vclock.VClock) error
 	// Delete Needs: keygroup, id
 	Delete(kg string, id string, vvector vclock.VClock) error
+	// UpdateBatch Needs: keygroup, ids, values, tombstone markers, expiries, version vectors, and for each id the version
+	// vectors that are replaced by the new version; stores all new versions and removes all replaced versions atomically
+	UpdateBatch(kg string, ids []string, vals []string, tombstones []bool, expiries []int, vvectors []vclock.VClock, replaced [][]vclock.VClock) error
 	// Append Needs: keygroup, val, Returns: key
 	Append(kg string, id string, val string, expiry int) error
-	// Read Needs: keygroup, id; Returns: val, version vector, found
-	Read(kg string, id string) ([]string, []vclock.VClock, bool, error)
-	// ReadSome Needs: keygroup, id, range; Returns: ids, values, versions
-	ReadSome(kg string, id string, count uint64) ([]string, []string, []vclock.VClock, error)
-	// ReadAll Needs: keygroup; Returns: ids, values, versions
-	ReadAll(kg string) ([]string, []string, []vclock.VClock, error)
+	// Read Needs: keygroup, id; Returns: val, tombstone markers, version vector, found
+	Read(kg string, id string) ([]string, []bool, []vclock.VClock, bool, error)
+	// ReadSome Needs: keygroup, id, range; Returns: ids, values, tombstone markers, versions
+	ReadSome(kg string, id string, count uint64) ([]string, []string, []bool, []vclock.VClock, error)
+	// ReadPage Needs: keygroup, id to start after (exclusive, empty to start at the beginning), number of ids;
+	// Returns: ids, values, tombstone markers, versions. All versions of an id are returned within the same page, an
+	// empty page means there are no more items.
+	ReadPage(kg string, after string, count uint64) ([]string, []string, []bool, []vclock.VClock, error)
+	// ReadRange Needs: keygroup, first id (inclusive, empty for no lower bound), last id (exclusive, empty for no upper
+	// bound), prefix of all ids, whether to go in descending order, number of ids; Returns: ids, values, tombstone
+	// markers, versions. Ids are in lexicographic order and all versions of an id are returned together.
+	ReadRange(kg string, start string, end string, prefix string, reverse bool, count uint64) ([]string, []string, []bool, []vclock.VClock, error)
+	// ReadAll Needs: keygroup; Returns: ids, values, tombstone markers, versions
+	ReadAll(kg string) ([]string, []string, []bool, []vclock.VClock, error)
+	// ReadHistory Needs: keygroup, id; Returns: values, tombstone markers, versions, and the times (in unix seconds) at
+	// which they were replaced of the replaced versions of the id that are kept, in no particular order
+	ReadHistory(kg string, id string) ([]string, []bool, []vclock.VClock, []int64, error)
 	// IDs Needs: keygroup, Returns:[] keygroup, id
 	IDs(kg string) ([]string, error)
 	// Exists Needs: keygroup, id
@@ -31,6 +47,10 @@ type Store interface {
 	// Doesnt really need to store the KG itself, that is keygroup/store.go's job.
 	// But might be useful for databases using it
 	CreateKeygroup(kg string) error
+	// SetKeygroupHistory Needs: keygroup, number of replaced versions to keep per id, seconds to keep replaced versions
+	// for; 0 means no limit. Versions that are removed with Delete or replaced in UpdateBatch are only kept if one of the
+	// two is set, and never longer than their expiry.
+	SetKeygroupHistory(kg string, versions int, window int) error
 	// DeleteKeygroup Same as with CreateKeygroup
 	DeleteKeygroup(kg string) error
 	// ExistsKeygroup Needs: keygroup
@@ -47,6 +67,10 @@ type Store interface {
 
 type cachedClock struct {
 	clocks []vclock.VClock
+	// timestamps are the hybrid timestamps of the current versions that we know, by their sorted version vector.
+	timestamps map[string]uint64
+	// purged is the version of a tombstone of this item that has been collected, if any.
+	purged vclock.VClock
 	*sync.Mutex
 }
 
@@ -60,6 +84,9 @@ type storeService struct {
 	id         string
 	vCache     map[KeygroupName]keygroupCache
 	vCacheLock sync.RWMutex
+	idx        map[KeygroupName]*keygroupIndexes
+	idxLock    sync.RWMutex
+	clock      hybridClock
 }
 
 // NewStoreService creates a new val manipulation service.
@@ -69,6 +96,8 @@ func newStoreService(iS Store, id NodeID) *storeService {
 		id:         string(id),
 		vCache:     make(map[KeygroupName]keygroupCache),
 		vCacheLock: sync.RWMutex{},
+		idx:        make(map[KeygroupName]*keygroupIndexes),
+		idxLock:    sync.RWMutex{},
 	}
 }
 
@@ -85,7 +114,7 @@ func (s *storeService) read(kg KeygroupName, id string) ([]Item, error) {
 		return nil, errors.Errorf("no such keygroup in store: %+v", kg)
 	}
 
-	data, vvectors, found, err := s.iS.Read(string(kg), id)
+	data, tombstones, vvectors, found, err := s.iS.Read(string(kg), id)
 
 	if err != nil {
 		return nil, err
@@ -103,7 +132,7 @@ func (s *storeService) read(kg KeygroupName, id string) ([]Item, error) {
 			ID:         id,
 			Val:        data[i],
 			Version:    vvectors[i],
-			Tombstoned: data[i] == "",
+			Tombstoned: tombstones[i],
 		}
 	}
 
@@ -126,7 +155,7 @@ func (s *storeService) readVersion(kg KeygroupName, id string, versions []vclock
 		return nil, errors.Errorf("no such keygroup in store: %+v", kg)
 	}
 
-	data, vvectors, found, err := s.iS.Read(string(kg), id)
+	data, tombstones, vvectors, found, err := s.iS.Read(string(kg), id)
 
 	if err != nil {
 		return nil, err
@@ -146,7 +175,7 @@ func (s *storeService) readVersion(kg KeygroupName, id string, versions []vclock
 					ID:         id,
 					Val:        data[i],
 					Version:    vvectors[i],
-					Tombstoned: data[i] == "",
+					Tombstoned: tombstones[i],
 				})
 				continue
 			}
@@ -157,49 +186,100 @@ func (s *storeService) readVersion(kg KeygroupName, id string, versions []vclock
 	return items, nil
 }
 
-// Scan returns a list of count items starting with id from the key-value store.
-func (s *storeService) scan(kg KeygroupName, id string, count uint64) ([]Item, error) {
-	err := checkKGandID(kg, id)
+// Scan returns a list of count items starting with id from the key-value store. The id has to exist unless it is empty,
+// in which case the scan starts at the first item. It also returns a token to continue the scan, which is empty if
+// there are no more items.
+func (s *storeService) scan(kg KeygroupName, id string, count uint64, o ScanOptions) ([]Item, string, error) {
+	err := checkKeygroup(kg)
 
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	for _, x := range []string{id, o.End, o.Prefix} {
+		if x == "" {
+			continue
+		}
+
+		if err := checkID(x); err != nil {
+			return nil, "", err
+		}
 	}
 
 	if !s.iS.ExistsKeygroup(string(kg)) {
-		return nil, errors.Errorf("no such keygroup in store: %+v", kg)
+		return nil, "", errors.Errorf("no such keygroup in store: %+v", kg)
 	}
 
-	if !s.exists(Item{
+	if count == 0 {
+		return []Item{}, "", nil
+	}
+
+	start, end := id, o.End
+	skip := ""
+
+	if o.Token != "" {
+		last, err := decodeScanToken(o.Token)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if o.Reverse {
+			end = last
+		} else {
+			start = last
+			skip = last
+		}
+	} else if id != "" && !s.exists(Item{
 		Keygroup: kg,
 		ID:       id,
 	}) {
-		return nil, errors.Errorf("no such item %s in keygroup %+v", id, kg)
+		return nil, "", errors.Errorf("no such item %s in keygroup %+v", id, kg)
+	}
+
+	// we read one more item than requested to know whether there are more, and going forward the first item might be
+	// the last item of the previous page
+	n := count + 1
+
+	if skip != "" {
+		n++
 	}
 
-	keys, data, vvectors, err := s.iS.ReadSome(string(kg), id, count)
+	keys, data, tombstones, vvectors, err := s.iS.ReadRange(string(kg), start, end, o.Prefix, o.Reverse, n)
 
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	items := make([]Item, len(keys))
+	items := make([]Item, 0, len(keys))
+	token := ""
+
+	var ids uint64
 
 	for i := range keys {
-		key := keys[i]
-		item := data[i]
-		version := vvectors[i]
+		if keys[i] == skip {
+			continue
+		}
 
-		items[i] = Item{
-			Keygroup:   kg,
-			ID:         key,
-			Val:        item,
-			Version:    version,
-			Tombstoned: item == "",
+		if i == 0 || keys[i] != keys[i-1] {
+			if ids == count {
+				token = encodeScanToken(items[len(items)-1].ID)
+				break
+			}
+
+			ids++
 		}
+
+		items = append(items, Item{
+			Keygroup:   kg,
+			ID:         keys[i],
+			Val:        data[i],
+			Version:    vvectors[i],
+			Tombstoned: tombstones[i],
+		})
 	}
 
-	//return s.cleanOlderVersions(items)
-	return items, nil
+	return items, token, nil
 }
 
 // Keys returns a list of count item keys starting with id from the key-value store.
@@ -221,7 +301,7 @@ func (s *storeService) keys(kg KeygroupName, id string, count uint64) ([]Item, e
 		return nil, errors.Errorf("no such item %s in keygroup %+v", id, kg)
 	}
 
-	keys, data, vvectors, err := s.iS.ReadSome(string(kg), id, count)
+	keys, _, tombstones, vvectors, err := s.iS.ReadSome(string(kg), id, count)
 
 	if err != nil {
 		return nil, err
@@ -231,14 +311,13 @@ func (s *storeService) keys(kg KeygroupName, id string, count uint64) ([]Item, e
 
 	for i := range keys {
 		key := keys[i]
-		item := data[i]
 		version := vvectors[i]
 
 		items[i] = Item{
 			Keygroup:   kg,
 			ID:         key,
 			Version:    version,
-			Tombstoned: item == "",
+			Tombstoned: tombstones[i],
 		}
 	}
 
@@ -258,7 +337,7 @@ func (s *storeService) readAll(kg KeygroupName) ([]Item, error) {
 		return nil, errors.Errorf("no such keygroup in store: %+v", kg)
 	}
 
-	keys, data, vvectors, err := s.iS.ReadAll(string(kg))
+	keys, data, tombstones, vvectors, err := s.iS.ReadAll(string(kg))
 
 	if err != nil {
 		return nil, err
@@ -276,7 +355,7 @@ func (s *storeService) readAll(kg KeygroupName) ([]Item, error) {
 			ID:         key,
 			Val:        item,
 			Version:    version,
-			Tombstoned: item == "",
+			Tombstoned: tombstones[i],
 		}
 	}
 
@@ -284,6 +363,68 @@ func (s *storeService) readAll(kg KeygroupName) ([]Item, error) {
 	return items, nil
 }
 
+// readPage returns all versions of the next count items after the given id of a keygroup from the key-value store.
+// An empty id starts at the beginning of the keygroup, an empty result means that there are no more items.
+func (s *storeService) readPage(kg KeygroupName, after string, count uint64) ([]Item, error) {
+	err := checkKeygroup(kg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.iS.ExistsKeygroup(string(kg)) {
+		return nil, errors.Errorf("no such keygroup in store: %+v", kg)
+	}
+
+	keys, data, tombstones, vvectors, err := s.iS.ReadPage(string(kg), after, count)
+
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]Item, len(keys))
+
+	for i := range keys {
+		items[i] = Item{
+			Keygroup:   kg,
+			ID:         keys[i],
+			Val:        data[i],
+			Version:    vvectors[i],
+			Tombstoned: tombstones[i],
+		}
+	}
+
+	return items, nil
+}
+
+// addTimestamps sets the timestamps that we know for the versions of items that were read from the store, so that other
+// replicas can resolve conflicts with them.
+func (s *storeService) addTimestamps(kg KeygroupName, items []Item) {
+	s.vCacheLock.RLock()
+	defer s.vCacheLock.RUnlock()
+
+	cache, ok := s.vCache[kg]
+
+	if !ok {
+		return
+	}
+
+	cache.RLock()
+	defer cache.RUnlock()
+
+	for j := range items {
+		c, ok := cache.clocks[items[j].ID]
+
+		if !ok {
+			continue
+		}
+
+		c.Lock()
+		items[j].Timestamp = c.timestamp(items[j].Version)
+		c.Unlock()
+	}
+}
+
 // exists checks if an item exists in the key-value store.
 func (s *storeService) exists(i Item) bool {
 	if !s.iS.ExistsKeygroup(string(i.Keygroup)) {
@@ -298,8 +439,22 @@ func (s *storeService) existsKeygroup(name KeygroupName) bool {
 	return s.iS.ExistsKeygroup(string(name))
 }
 
-// Update updates an item in the key-value store.
-func (s *storeService) update(i Item, expiry int) (vclock.VClock, error) {
+// keygroups returns the names of all keygroups that this node currently holds.
+func (s *storeService) keygroups() []KeygroupName {
+	s.vCacheLock.RLock()
+	defer s.vCacheLock.RUnlock()
+
+	kgs := make([]KeygroupName, 0, len(s.vCache))
+
+	for kg := range s.vCache {
+		kgs = append(kgs, kg)
+	}
+
+	return kgs
+}
+
+// Update updates an item in the key-value store if the given precondition holds.
+func (s *storeService) update(i Item, expiry int, p *Precondition) (vclock.VClock, error) {
 	// no version given means:
 	// increment the locally stored version
 	// check the cache for the local version number
@@ -338,9 +493,14 @@ func (s *storeService) update(i Item, expiry int) (vclock.VClock, error) {
 	s.vCache[i.Keygroup].clocks[i.ID].Lock()
 	defer s.vCache[i.Keygroup].clocks[i.ID].Unlock()
 
+	if err := s.checkPrecondition(i, p); err != nil {
+		return nil, err
+	}
+
 	log.Debug().Msgf("update: before known %+v", s.vCache[i.Keygroup].clocks[i.ID].clocks)
 
-	oldVersion := vclock.VClock{}
+	// new versions have to be newer than a collected tombstone, otherwise other replicas might discard them
+	oldVersion := s.vCache[i.Keygroup].clocks[i.ID].purged.Copy()
 	toPrune := make([]vclock.VClock, len(s.vCache[i.Keygroup].clocks[i.ID].clocks))
 	for j, c := range s.vCache[i.Keygroup].clocks[i.ID].clocks {
 		oldVersion.Merge(c)
@@ -351,8 +511,9 @@ func (s *storeService) update(i Item, expiry int) (vclock.VClock, error) {
 	newVersion.Tick(s.id)
 
 	s.vCache[i.Keygroup].clocks[i.ID].clocks = []vclock.VClock{newVersion}
+	s.vCache[i.Keygroup].clocks[i.ID].setTimestamp(newVersion, i.Timestamp)
 
-	err = s.iS.Update(string(i.Keygroup), i.ID, i.Val, expiry, newVersion.GetMap())
+	err = s.iS.Update(string(i.Keygroup), i.ID, i.Val, false, expiry, newVersion.GetMap())
 
 	if err != nil {
 		return nil, err
@@ -360,13 +521,105 @@ func (s *storeService) update(i Item, expiry int) (vclock.VClock, error) {
 
 	s.prune(string(i.Keygroup), i.ID, toPrune)
 
+	s.reindex(i.Keygroup, i.ID)
+
 	log.Debug().Msgf("update: after known %+v", s.vCache[i.Keygroup].clocks[i.ID].clocks)
 
 	return newVersion.Copy(), nil
 }
 
-// updateVersions updates an item in the key-value store given a list of known versions.
-func (s *storeService) updateVersions(i Item, versions []vclock.VClock, expiry int) (vclock.VClock, error) {
+// modify updates an item in the key-value store with a value that is computed from all of its current values while no
+// other update of the item can happen, which allows read-modify-write operations. Tombstones are left out.
+func (s *storeService) modify(i Item, expiry int, f func(vals []string) (string, error)) (Item, error) {
+	err := checkKGandID(i.Keygroup, i.ID)
+
+	if err != nil {
+		return i, err
+	}
+
+	if !s.iS.ExistsKeygroup(string(i.Keygroup)) {
+		return i, errors.Errorf("no such keygroup in store: %+v", i.Keygroup)
+	}
+
+	s.vCacheLock.RLock()
+	defer s.vCacheLock.RUnlock()
+
+	s.vCache[i.Keygroup].RLock()
+	defer s.vCache[i.Keygroup].RUnlock()
+
+	if _, ok := s.vCache[i.Keygroup].clocks[i.ID]; !ok {
+		s.vCache[i.Keygroup].RUnlock()
+		s.vCache[i.Keygroup].Lock()
+		if _, ok := s.vCache[i.Keygroup].clocks[i.ID]; !ok {
+			s.vCache[i.Keygroup].clocks[i.ID] = &cachedClock{
+				clocks: []vclock.VClock{},
+				Mutex:  &sync.Mutex{},
+			}
+		}
+		s.vCache[i.Keygroup].Unlock()
+		s.vCache[i.Keygroup].RLock()
+	}
+
+	s.vCache[i.Keygroup].clocks[i.ID].Lock()
+	defer s.vCache[i.Keygroup].clocks[i.ID].Unlock()
+
+	data, tombstones, _, found, err := s.iS.Read(string(i.Keygroup), i.ID)
+
+	if err != nil {
+		return i, err
+	}
+
+	vals := make([]string, 0, len(data))
+
+	if found {
+		for j := range data {
+			if !tombstones[j] {
+				vals = append(vals, data[j])
+			}
+		}
+	}
+
+	i.Val, err = f(vals)
+
+	if err != nil {
+		return i, err
+	}
+
+	if err := checkItem(i); err != nil {
+		return i, err
+	}
+
+	// new versions have to be newer than a collected tombstone, otherwise other replicas might discard them
+	oldVersion := s.vCache[i.Keygroup].clocks[i.ID].purged.Copy()
+	toPrune := make([]vclock.VClock, len(s.vCache[i.Keygroup].clocks[i.ID].clocks))
+	for j, c := range s.vCache[i.Keygroup].clocks[i.ID].clocks {
+		oldVersion.Merge(c)
+		toPrune[j] = c.Copy()
+	}
+
+	newVersion := oldVersion
+	newVersion.Tick(s.id)
+
+	err = s.iS.Update(string(i.Keygroup), i.ID, i.Val, false, expiry, newVersion.GetMap())
+
+	if err != nil {
+		return i, err
+	}
+
+	s.vCache[i.Keygroup].clocks[i.ID].clocks = []vclock.VClock{newVersion}
+	s.vCache[i.Keygroup].clocks[i.ID].setTimestamp(newVersion, i.Timestamp)
+
+	s.prune(string(i.Keygroup), i.ID, toPrune)
+
+	s.reindex(i.Keygroup, i.ID)
+
+	i.Version = newVersion.Copy()
+
+	return i, nil
+}
+
+// updateVersions updates an item in the key-value store given a list of known versions if the given precondition holds.
+func (s *storeService) updateVersions(i Item, versions []vclock.VClock, expiry int, p *Precondition) (vclock.VClock, error) {
 	// in this case, all existing versions will be removed and there will be a new item with a new version
 	// for that to work, we first need to check whether for each of the version we have locally, there is a version in
 	// the given versions that is greater or equal
@@ -407,6 +660,10 @@ func (s *storeService) updateVersions(i Item, versions []vclock.VClock, expiry i
 	s.vCache[i.Keygroup].clocks[i.ID].Lock()
 	defer s.vCache[i.Keygroup].clocks[i.ID].Unlock()
 
+	if err := s.checkPrecondition(i, p); err != nil {
+		return nil, err
+	}
+
 	// ok, let's check if we want to reject this update!
 	// go through all of our known versions and check that the client has seen it in some form by checking that the
 	// given versions have one that is greater or equal than that
@@ -425,14 +682,14 @@ func (s *storeService) updateVersions(i Item, versions []vclock.VClock, expiry i
 	}
 
 	// looks good! let's make up a new version for this update
-	newVersion := vclock.VClock{}
+	newVersion := s.vCache[i.Keygroup].clocks[i.ID].purged.Copy()
 	for _, v := range versions {
 		newVersion.Merge(v)
 	}
 
 	newVersion.Tick(s.id)
 
-	err = s.iS.Update(string(i.Keygroup), i.ID, i.Val, expiry, newVersion)
+	err = s.iS.Update(string(i.Keygroup), i.ID, i.Val, false, expiry, newVersion)
 
 	if err != nil {
 		return nil, err
@@ -442,20 +699,26 @@ func (s *storeService) updateVersions(i Item, versions []vclock.VClock, expiry i
 	s.prune(string(i.Keygroup), i.ID, s.vCache[i.Keygroup].clocks[i.ID].clocks)
 
 	s.vCache[i.Keygroup].clocks[i.ID].clocks = []vclock.VClock{newVersion}
+	s.vCache[i.Keygroup].clocks[i.ID].setTimestamp(newVersion, i.Timestamp)
+
+	s.reindex(i.Keygroup, i.ID)
 
 	return newVersion.Copy(), nil
 }
 
-func (s *storeService) addVersion(i Item, remoteVersion vclock.VClock, expiry int) error {
+// addVersion stores a version of an item that we got from another replica. If it is concurrent to versions that we
+// already have, the conflict policy of the keygroup decides whether all of them are kept. Returns the item as it was
+// stored, which is the result of resolving the conflict if there was one, or nothing if the version was discarded.
+func (s *storeService) addVersion(i Item, remoteVersion vclock.VClock, expiry int, p ConflictPolicy) ([]Item, error) {
 	// TODO
 	err := checkItem(i)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if !s.iS.ExistsKeygroup(string(i.Keygroup)) {
-		return errors.Errorf("no such keygroup in store: %+v", i.Keygroup)
+		return nil, errors.Errorf("no such keygroup in store: %+v", i.Keygroup)
 	}
 
 	s.vCacheLock.RLock()
@@ -487,6 +750,11 @@ func (s *storeService) addVersion(i Item, remoteVersion vclock.VClock, expiry in
 
 	log.Debug().Msgf("addVersion: before known %+v", s.vCache[i.Keygroup].clocks[i.ID].clocks)
 
+	if s.vCache[i.Keygroup].clocks[i.ID].collected(remoteVersion) {
+		log.Debug().Msgf("%s is not newer than a collected tombstone: discarding version", vector.SortedVCString(remoteVersion))
+		return nil, nil
+	}
+
 	newClocks := make([]vclock.VClock, 0, len(s.vCache[i.Keygroup].clocks[i.ID].clocks))
 
 	for _, local := range s.vCache[i.Keygroup].clocks[i.ID].clocks {
@@ -495,7 +763,7 @@ func (s *storeService) addVersion(i Item, remoteVersion vclock.VClock, expiry in
 		case vclock.Ancestor:
 			{
 				log.Debug().Msgf("%s is an ancestor of %s: discarding version", vector.SortedVCString(remoteVersion), vector.SortedVCString(local))
-				return nil
+				return nil, nil
 			}
 
 		// if this is an equal version, we better hope that we already know about this and the contents aren't any different
@@ -509,7 +777,16 @@ func (s *storeService) addVersion(i Item, remoteVersion vclock.VClock, expiry in
 				// but I'm not a fan of that tbh
 				// TODO: figure out why updates can arrive twice while adding a replica
 
-				return nil
+				// it also happens when replicas resolved a conflict in different steps, then the values can differ
+				r, changed, err := s.reconcileEqual(i, remoteVersion, s.vCache[i.Keygroup].clocks[i.ID], expiry, p)
+
+				if err != nil || !changed {
+					return nil, err
+				}
+
+				s.reindex(i.Keygroup, i.ID)
+
+				return []Item{r}, nil
 			}
 
 		// if this is a newer version than we have, we can actually just overwrite our local version, and we're good
@@ -540,20 +817,33 @@ func (s *storeService) addVersion(i Item, remoteVersion vclock.VClock, expiry in
 
 	log.Debug().Msgf("storing version %s", vector.SortedVCString(remoteVersion))
 
-	err = s.iS.Update(string(i.Keygroup), i.ID, i.Val, expiry, remoteVersion.GetMap())
+	err = s.iS.Update(string(i.Keygroup), i.ID, i.Val, i.Tombstoned, expiry, remoteVersion.GetMap())
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// and then since we have seen a remote version, we will update our local cache with this new version we saw
 	newClocks = append(newClocks, remoteVersion.Copy())
 
 	s.vCache[i.Keygroup].clocks[i.ID].clocks = newClocks
+	s.vCache[i.Keygroup].clocks[i.ID].setTimestamp(remoteVersion, i.Timestamp)
+	s.clock.observe(i.Timestamp)
+
+	stored := i
+	stored.Version = remoteVersion.Copy()
+
+	if r, ok, err := s.resolve(i.Keygroup, i.ID, s.vCache[i.Keygroup].clocks[i.ID], expiry, p); err != nil {
+		log.Err(err).Msgf("addVersion: could not resolve conflict for %s in keygroup %s, keeping all versions", i.ID, i.Keygroup)
+	} else if ok {
+		stored = r
+	}
+
+	s.reindex(i.Keygroup, i.ID)
 
 	log.Debug().Msgf("addVersion: after known %+v", s.vCache[i.Keygroup].clocks[i.ID].clocks)
 
-	return nil
+	return []Item{stored}, nil
 }
 
 // prune removes old versions of an Item
@@ -576,7 +866,7 @@ func (s *storeService) prune(kg string, id string, versions []vclock.VClock) {
 		}
 	}
 
-	_, v, _, err := s.iS.Read(kg, id)
+	_, _, v, _, err := s.iS.Read(kg, id)
 
 	if err != nil {
 		log.Err(err).Msgf("error pruning version %+v of %s in keygroup %s", v, id, kg)
@@ -585,7 +875,7 @@ func (s *storeService) prune(kg string, id string, versions []vclock.VClock) {
 	log.Debug().Msgf("pruning: versions %+v pruned, have versions %+v", versions, v)
 }
 
-func (s *storeService) tombstone(i Item) (vclock.VClock, error) {
+func (s *storeService) tombstone(i Item, p *Precondition) (vclock.VClock, error) {
 	//TODO
 	err := checkKGandID(i.Keygroup, i.ID)
 
@@ -622,7 +912,12 @@ func (s *storeService) tombstone(i Item) (vclock.VClock, error) {
 	s.vCache[i.Keygroup].clocks[i.ID].Lock()
 	defer s.vCache[i.Keygroup].clocks[i.ID].Unlock()
 
-	oldVersion := vclock.VClock{}
+	if err := s.checkPrecondition(i, p); err != nil {
+		return nil, err
+	}
+
+	// new versions have to be newer than a collected tombstone, otherwise other replicas might discard them
+	oldVersion := s.vCache[i.Keygroup].clocks[i.ID].purged.Copy()
 	toPrune := make([]vclock.VClock, len(s.vCache[i.Keygroup].clocks[i.ID].clocks))
 	for j, c := range s.vCache[i.Keygroup].clocks[i.ID].clocks {
 		oldVersion.Merge(c)
@@ -633,8 +928,9 @@ func (s *storeService) tombstone(i Item) (vclock.VClock, error) {
 	newVersion.Tick(s.id)
 
 	s.vCache[i.Keygroup].clocks[i.ID].clocks = []vclock.VClock{newVersion}
+	s.vCache[i.Keygroup].clocks[i.ID].setTimestamp(newVersion, i.Timestamp)
 
-	err = s.iS.Update(string(i.Keygroup), i.ID, i.Val, 0, newVersion.GetMap())
+	err = s.iS.Update(string(i.Keygroup), i.ID, i.Val, true, 0, newVersion.GetMap())
 
 	if err != nil {
 		return nil, err
@@ -642,10 +938,12 @@ func (s *storeService) tombstone(i Item) (vclock.VClock, error) {
 
 	s.prune(string(i.Keygroup), i.ID, toPrune)
 
+	s.reindex(i.Keygroup, i.ID)
+
 	return newVersion.Copy(), nil
 }
 
-func (s *storeService) tombstoneVersions(i Item, versions []vclock.VClock) (vclock.VClock, error) {
+func (s *storeService) tombstoneVersions(i Item, versions []vclock.VClock, p *Precondition) (vclock.VClock, error) {
 	// TODO
 	// this works like updateVersions
 	err := checkKGandID(i.Keygroup, i.ID)
@@ -683,6 +981,10 @@ func (s *storeService) tombstoneVersions(i Item, versions []vclock.VClock) (vclo
 	s.vCache[i.Keygroup].clocks[i.ID].Lock()
 	defer s.vCache[i.Keygroup].clocks[i.ID].Unlock()
 
+	if err := s.checkPrecondition(i, p); err != nil {
+		return nil, err
+	}
+
 	// ok, let's check if we want to reject this update!
 	// look, if the client sends (B:0, C:1) AND (B:0, C:2), it's their fault
 	// the client should make sure that it only sends concurrent versions
@@ -696,14 +998,14 @@ func (s *storeService) tombstoneVersions(i Item, versions []vclock.VClock) (vclo
 	}
 
 	// looks good! let's make up a new version for this update
-	newVersion := vclock.VClock{}
+	newVersion := s.vCache[i.Keygroup].clocks[i.ID].purged.Copy()
 	for _, v := range versions {
 		newVersion.Merge(v)
 	}
 
 	newVersion.Tick(s.id)
 
-	err = s.iS.Update(string(i.Keygroup), i.ID, i.Val, 0, newVersion.GetMap())
+	err = s.iS.Update(string(i.Keygroup), i.ID, i.Val, true, 0, newVersion.GetMap())
 
 	if err != nil {
 		return nil, err
@@ -719,6 +1021,9 @@ func (s *storeService) tombstoneVersions(i Item, versions []vclock.VClock) (vclo
 		}
 	}
 	s.vCache[i.Keygroup].clocks[i.ID].clocks = newClocks
+	s.vCache[i.Keygroup].clocks[i.ID].setTimestamp(newVersion, i.Timestamp)
+
+	s.reindex(i.Keygroup, i.ID)
 
 	return newVersion.Copy(), nil
 }
@@ -757,6 +1062,8 @@ func (s *storeService) append(i Item, expiry int) error {
 		return err
 	}
 
+	s.reindex(i.Keygroup, i.ID)
+
 	return nil
 }
 
@@ -810,6 +1117,8 @@ func (s *storeService) deleteKeygroup(kg KeygroupName) error {
 	//delete(s.lockGroups, kg)
 	delete(s.vCache, kg)
 
+	s.deleteIndexes(kg)
+
 	return nil
 }
 
@@ -0,0 +1,294 @@
+package fred
+
+import (
+	"time"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/vector"
+	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/go-errors/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// collected returns whether a version is equal to or older than a tombstone of the item that has already been collected.
+// Such a version must have been on its way to us for a long time and has to be discarded, otherwise the item would come
+// back. The lock of the cached clock has to be held.
+func (c *cachedClock) collected(v vclock.VClock) bool {
+	return c.purged != nil && c.purged.Compare(v, vclock.Ancestor|vclock.Equal)
+}
+
+// tombstones reads all tombstones of a keygroup that could be collected, i.e., items whose only version is a tombstone.
+// The tombstones are passed to send page by page.
+func (s *storeService) tombstones(kg KeygroupName, send func(items []Item) error) error {
+	after := ""
+
+	for {
+		items, err := s.readPage(kg, after, transferPageSize)
+
+		if err != nil {
+			return err
+		}
+
+		if len(items) == 0 {
+			return nil
+		}
+
+		var page []Item
+
+		// the versions of an item are next to each other
+		for j := 0; j < len(items); {
+			k := j + 1
+
+			for k < len(items) && items[k].ID == items[j].ID {
+				k++
+			}
+
+			if k-j == 1 && items[j].Tombstoned {
+				page = append(page, items[j])
+			}
+
+			j = k
+		}
+
+		if len(page) > 0 {
+			if err := send(page); err != nil {
+				return err
+			}
+		}
+
+		after = items[len(items)-1].ID
+	}
+}
+
+// acknowledgeTombstones checks which tombstones of a keygroup that another replica wants to collect we have seen: a
+// tombstone is acknowledged if every version of the item that we know is equal to or newer than the tombstone. It also
+// returns the items that we don't know at all, e.g., because we have only just been added to the keygroup.
+func (s *storeService) acknowledgeTombstones(kg KeygroupName, items []Item) ([]string, []string, error) {
+	if err := checkKeygroup(kg); err != nil {
+		return nil, nil, err
+	}
+
+	s.vCacheLock.RLock()
+	defer s.vCacheLock.RUnlock()
+
+	kc, ok := s.vCache[kg]
+
+	if !ok {
+		return nil, nil, errors.Errorf("no version cache for keygroup %+v", kg)
+	}
+
+	kc.RLock()
+	defer kc.RUnlock()
+
+	acked := make([]string, 0, len(items))
+	var missing []string
+
+	for _, i := range items {
+		c, ok := kc.clocks[i.ID]
+
+		if !ok {
+			missing = append(missing, i.ID)
+			continue
+		}
+
+		c.Lock()
+
+		known := c.clocks
+
+		if len(known) == 0 && c.purged != nil {
+			known = []vclock.VClock{c.purged}
+		}
+
+		seen := len(known) > 0
+
+		for _, v := range known {
+			if !i.Version.Compare(v, vclock.Equal|vclock.Descendant) {
+				seen = false
+				break
+			}
+		}
+
+		c.Unlock()
+
+		if len(known) == 0 {
+			missing = append(missing, i.ID)
+			continue
+		}
+
+		if seen {
+			acked = append(acked, i.ID)
+		}
+	}
+
+	return acked, missing, nil
+}
+
+// collectTombstone removes a tombstone from the store if it is still the only version of its item. The version of the
+// tombstone is kept in the version cache, so that new versions of the item are newer than the tombstone and older
+// versions that are still on their way to us are discarded. It returns whether the tombstone was removed.
+func (s *storeService) collectTombstone(i Item) (bool, error) {
+	s.vCacheLock.RLock()
+	defer s.vCacheLock.RUnlock()
+
+	kc, ok := s.vCache[i.Keygroup]
+
+	if !ok {
+		return false, errors.Errorf("no version cache for keygroup %+v", i.Keygroup)
+	}
+
+	kc.RLock()
+	defer kc.RUnlock()
+
+	c, ok := kc.clocks[i.ID]
+
+	if !ok {
+		return false, nil
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	// the item might have changed since the other replicas acknowledged the tombstone
+	if len(c.clocks) != 1 || !c.clocks[0].Compare(i.Version, vclock.Equal) {
+		return false, nil
+	}
+
+	_, tombstones, versions, found, err := s.iS.Read(string(i.Keygroup), i.ID)
+
+	if err != nil {
+		return false, err
+	}
+
+	if !found || len(versions) != 1 || !tombstones[0] || !versions[0].Compare(i.Version, vclock.Equal) {
+		return false, nil
+	}
+
+	log.Debug().Msgf("collectTombstone: removing tombstone %s of %s in keygroup %s", vector.SortedVCString(i.Version), i.ID, i.Keygroup)
+
+	if err := s.iS.Delete(string(i.Keygroup), i.ID, versions[0]); err != nil {
+		return false, err
+	}
+
+	c.purged = c.clocks[0]
+	c.clocks = []vclock.VClock{}
+	c.timestamps = nil
+
+	s.reindex(i.Keygroup, i.ID)
+
+	return true, nil
+}
+
+// runTombstoneGC collects the tombstones of every keygroup on this node that all replicas have seen each interval. It
+// does not return.
+func (s *replicationService) runTombstoneGC(interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for range t.C {
+		for _, kg := range s.s.keygroups() {
+			if err := s.collectTombstones(kg); err != nil {
+				log.Err(err).Msgf("collectTombstones from replservice: could not collect tombstones of keygroup %s", kg)
+			}
+		}
+	}
+}
+
+// collectTombstones removes the tombstones of a keygroup that are causally stable, i.e., every member of the keygroup
+// has acknowledged a version of the item that is equal to or newer than the tombstone. The members are looked up
+// anew every time: members that have left the keygroup no longer hold back collection and members that have joined
+// have to acknowledge a tombstone first. If a member does not know an item at all, e.g., because it joined after the
+// item was deleted, the tombstone is sent to it so that it can acknowledge it next time.
+func (s *replicationService) collectTombstones(kg KeygroupName) error {
+	members, err := s.n.GetKeygroupMembers(kg, false)
+
+	if err != nil {
+		return err
+	}
+
+	if _, ok := members[s.n.GetNodeID()]; !ok {
+		// we are not (or no longer) a replica of this keygroup
+		return nil
+	}
+
+	peers := make(map[NodeID]string, len(members))
+
+	for id := range members {
+		if id == s.n.GetNodeID() {
+			continue
+		}
+
+		addr, err := s.n.GetNodeAddress(id)
+
+		if err != nil {
+			return err
+		}
+
+		peers[id] = addr
+	}
+
+	collected := 0
+
+	err = s.s.tombstones(kg, func(items []Item) error {
+		acks := make(map[string]int, len(items))
+
+		for id, addr := range peers {
+			acked, missing, err := s.c.SendAcknowledgeTombstones(addr, kg, items)
+
+			if err != nil {
+				// we can't collect anything without hearing from every replica
+				return err
+			}
+
+			for _, a := range acked {
+				acks[a]++
+			}
+
+			if len(missing) == 0 {
+				continue
+			}
+
+			log.Debug().Msgf("collectTombstones from replservice: %s is missing %d tombstones of keygroup %s, sending them", id, len(missing), kg)
+
+			want := make(map[string]struct{}, len(missing))
+
+			for _, m := range missing {
+				want[m] = struct{}{}
+			}
+
+			for _, i := range items {
+				if _, ok := want[i.ID]; !ok {
+					continue
+				}
+
+				if err := s.c.SendUpdate(addr, kg, s.n.GetNodeID(), i.ID, "", true, i.Version, 0, i.Timestamp); err != nil {
+					log.Err(err).Msgf("collectTombstones from replservice: could not send tombstone of %s to %s", i.ID, id)
+				}
+			}
+		}
+
+		for _, i := range items {
+			if acks[i.ID] < len(peers) {
+				continue
+			}
+
+			ok, err := s.s.collectTombstone(i)
+
+			if err != nil {
+				return err
+			}
+
+			if ok {
+				// watchers and readers of the change log learn that the deleted item is now gone for good
+				s.publish([]Item{i}, false, s.n.GetNodeID())
+				collected++
+			}
+		}
+
+		return nil
+	})
+
+	if collected > 0 {
+		log.Info().Msgf("collectTombstones from replservice: collected %d tombstones of keygroup %s", collected, kg)
+	}
+
+	return err
+}
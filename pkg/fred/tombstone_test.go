@@ -0,0 +1,96 @@
+package fred
+
+import (
+	"testing"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/badgerdb"
+	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectTombstone(t *testing.T) {
+	kg := KeygroupName("gckg")
+	replicas := make([]*storeService, 2)
+
+	for j, id := range []NodeID{"X", "Y"} {
+		store := badgerdb.NewMemory()
+		t.Cleanup(func() { _ = store.Close() })
+
+		replicas[j] = newStoreService(store, id)
+		assert.NoError(t, replicas[j].createKeygroup(kg))
+	}
+
+	x, y := replicas[0], replicas[1]
+
+	old, err := x.update(Item{Keygroup: kg, ID: "a", Val: "old"}, 0, nil)
+	assert.NoError(t, err)
+
+	v, err := x.tombstone(Item{Keygroup: kg, ID: "a"}, nil)
+	assert.NoError(t, err)
+
+	var tombstones []Item
+	assert.NoError(t, x.tombstones(kg, func(items []Item) error {
+		tombstones = append(tombstones, items...)
+		return nil
+	}))
+	assert.Len(t, tombstones, 1)
+	assert.Equal(t, "a", tombstones[0].ID)
+
+	// Y has never heard of the item
+	acked, missing, err := y.acknowledgeTombstones(kg, tombstones)
+	assert.NoError(t, err)
+	assert.Empty(t, acked)
+	assert.Equal(t, []string{"a"}, missing)
+
+	// Y has only seen the old version
+	_, err = y.addVersion(Item{Keygroup: kg, ID: "a", Val: "old"}, old, 0, "")
+	assert.NoError(t, err)
+
+	acked, missing, err = y.acknowledgeTombstones(kg, tombstones)
+	assert.NoError(t, err)
+	assert.Empty(t, acked)
+	assert.Empty(t, missing)
+
+	_, err = y.addVersion(Item{Keygroup: kg, ID: "a", Tombstoned: true}, v, 0, "")
+	assert.NoError(t, err)
+
+	acked, _, err = y.acknowledgeTombstones(kg, tombstones)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a"}, acked)
+
+	for _, s := range replicas {
+		ok, err := s.collectTombstone(Item{Keygroup: kg, ID: "a", Version: v})
+		assert.NoError(t, err)
+		assert.True(t, ok)
+
+		assert.False(t, s.exists(Item{Keygroup: kg, ID: "a"}))
+	}
+
+	// a collected tombstone is still acknowledged
+	acked, _, err = y.acknowledgeTombstones(kg, tombstones)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a"}, acked)
+
+	// a late copy of the old version must not bring the item back
+	_, err = y.addVersion(Item{Keygroup: kg, ID: "a", Val: "old"}, old, 0, "")
+	assert.NoError(t, err)
+	assert.False(t, y.exists(Item{Keygroup: kg, ID: "a"}))
+
+	// new versions are newer than the collected tombstone
+	n, err := y.update(Item{Keygroup: kg, ID: "a", Val: "new"}, 0, nil)
+	assert.NoError(t, err)
+	assert.True(t, v.Compare(n, vclock.Descendant))
+
+	_, err = x.addVersion(Item{Keygroup: kg, ID: "a", Val: "new"}, n, 0, "")
+	assert.NoError(t, err)
+
+	items, err := x.read(kg, "a")
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "new", items[0].Val)
+
+	// a tombstone that is no longer the only version is not collected
+	ok, err := x.collectTombstone(Item{Keygroup: kg, ID: "a", Version: v})
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
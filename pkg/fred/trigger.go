@@ -165,7 +165,7 @@ func (t *triggerService) triggerUpdate(i Item) (e error) {
 			_, err = client.PutItemTrigger(context.Background(), &trigger.PutItemTriggerRequest{
 				Keygroup: string(i.Keygroup),
 				Id:       i.ID,
-				Val:      i.Val,
+				Val:      []byte(i.Val),
 			})
 
 			if err != nil {
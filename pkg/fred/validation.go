@@ -19,8 +19,8 @@ func checkItem(params ...Item) error {
 			return errors.Errorf("checkItem failed for item %+v because the ID does not match %s", p, expr)
 		}
 
-		if !p.Tombstoned && len(p.Val) == 0 {
-			return errors.Errorf("checkItem failed for item %+v because it is empty", p)
+		if p.TTL < 0 {
+			return errors.Errorf("checkItem failed for item %+v because the TTL is negative", p)
 		}
 	}
 
@@ -0,0 +1,139 @@
+package fred
+
+import (
+	"strings"
+	"sync"
+
+	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/go-errors/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// watchBufferSize is the number of changes that are buffered for a watcher. If a watcher falls behind by more than
+// that, it is disconnected so that it does not hold up writes.
+const watchBufferSize = 1000
+
+type watcher struct {
+	prefix  string
+	changes chan Item
+	dropped chan struct{}
+	drop    sync.Once
+}
+
+type watchService struct {
+	watchers map[KeygroupName]map[*watcher]struct{}
+	lock     sync.RWMutex
+}
+
+// newWatchService creates a new service that passes changes to items on to watchers.
+func newWatchService() *watchService {
+	return &watchService{
+		watchers: make(map[KeygroupName]map[*watcher]struct{}),
+	}
+}
+
+// subscribe registers a new watcher for all items of a keygroup with ids that start with the given prefix.
+func (w *watchService) subscribe(kg KeygroupName, prefix string) *watcher {
+	x := &watcher{
+		prefix:  prefix,
+		changes: make(chan Item, watchBufferSize),
+		dropped: make(chan struct{}),
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if _, ok := w.watchers[kg]; !ok {
+		w.watchers[kg] = make(map[*watcher]struct{})
+	}
+
+	w.watchers[kg][x] = struct{}{}
+
+	return x
+}
+
+// unsubscribe removes a watcher.
+func (w *watchService) unsubscribe(kg KeygroupName, x *watcher) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	delete(w.watchers[kg], x)
+
+	if len(w.watchers[kg]) == 0 {
+		delete(w.watchers, kg)
+	}
+}
+
+// notify passes a change to an item on to all watchers of that item. It never blocks: watchers that are too slow to
+// keep up are dropped.
+func (w *watchService) notify(i Item) {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+
+	for x := range w.watchers[i.Keygroup] {
+		if !strings.HasPrefix(i.ID, x.prefix) {
+			continue
+		}
+
+		select {
+		case x.changes <- i:
+		default:
+			x.drop.Do(func() {
+				log.Warn().Msgf("watcher for %s/%s* cannot keep up, dropping it", i.Keygroup, x.prefix)
+				close(x.dropped)
+			})
+		}
+	}
+}
+
+// watch sends all changes to items of a keygroup with ids that start with the given prefix to send until done is closed.
+// If a version is given, all current items with versions that are newer than or concurrent to that version are sent
+// first. Changes may be sent more than once.
+func (w *watchService) watch(s *storeService, kg KeygroupName, prefix string, from vclock.VClock, done <-chan struct{}, send func(i Item) error) error {
+	x := w.subscribe(kg, prefix)
+	defer w.unsubscribe(kg, x)
+
+	// we subscribe before reading the current items so that no change can slip through in between
+	if from != nil {
+		after := ""
+
+		for {
+			items, err := s.readPage(kg, after, transferPageSize)
+
+			if err != nil {
+				return err
+			}
+
+			if len(items) == 0 {
+				break
+			}
+
+			for _, item := range items {
+				if !strings.HasPrefix(item.ID, prefix) {
+					continue
+				}
+
+				if item.Version.Compare(from, vclock.Ancestor|vclock.Concurrent) {
+					if err := send(item); err != nil {
+						return errors.New(err)
+					}
+				}
+			}
+
+			after = items[len(items)-1].ID
+		}
+	}
+
+	for {
+		select {
+		case <-done:
+			return nil
+		case <-x.dropped:
+			return errors.Errorf("watcher for %s/%s* fell behind by more than %d changes", kg, prefix, watchBufferSize)
+		case i := <-x.changes:
+			if err := send(i); err != nil {
+				return errors.New(err)
+			}
+		}
+	}
+}
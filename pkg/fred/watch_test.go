@@ -0,0 +1,86 @@
+package fred
+
+import (
+	"testing"
+	"time"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/badgerdb"
+	"git.tu-berlin.de/mcc-fred/vclock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatch(t *testing.T) {
+	store := badgerdb.NewMemory()
+	t.Cleanup(func() { _ = store.Close() })
+
+	s := newStoreService(store, "X")
+	assert.NoError(t, s.createKeygroup("kg"))
+
+	_, err := s.update(Item{Keygroup: "kg", ID: "user0", Val: "existing"}, 0, nil)
+	assert.NoError(t, err)
+
+	w := newWatchService()
+
+	done := make(chan struct{})
+	received := make(chan Item)
+	result := make(chan error)
+
+	go func() {
+		result <- w.watch(s, "kg", "user", vclock.VClock{}, done, func(i Item) error {
+			received <- i
+			return nil
+		})
+	}()
+
+	next := func() Item {
+		select {
+		case i := <-received:
+			return i
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for a change")
+			return Item{}
+		}
+	}
+
+	// current items are sent after the watcher is registered, so no change can be missed from here on
+	assert.Equal(t, "existing", next().Val)
+
+	w.notify(Item{Keygroup: "other", ID: "user1", Val: "ignored"})
+	w.notify(Item{Keygroup: "kg", ID: "order1", Val: "ignored"})
+	w.notify(Item{Keygroup: "kg", ID: "user1", Val: "value"})
+	w.notify(Item{Keygroup: "kg", ID: "user1", Tombstoned: true})
+
+	assert.Equal(t, "value", next().Val)
+	assert.True(t, next().Tombstoned)
+
+	close(done)
+
+	select {
+	case err := <-result:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the watcher to stop")
+	}
+
+	w.lock.RLock()
+	assert.Empty(t, w.watchers)
+	w.lock.RUnlock()
+}
+
+func TestWatchDropsSlowWatcher(t *testing.T) {
+	w := newWatchService()
+
+	x := w.subscribe("kg", "")
+
+	for j := 0; j < watchBufferSize+1; j++ {
+		w.notify(Item{Keygroup: "kg", ID: "id"})
+	}
+
+	select {
+	case <-x.dropped:
+	default:
+		t.Fatal("watcher should have been dropped")
+	}
+
+	w.unsubscribe("kg", x)
+}
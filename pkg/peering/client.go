@@ -2,8 +2,11 @@ package peering
 
 import (
 	"context"
+	"io"
 	"sync"
+	"time"
 
+	"git.tu-berlin.de/mcc-fred/fred/pkg/compression"
 	"git.tu-berlin.de/mcc-fred/fred/pkg/fred"
 	"git.tu-berlin.de/mcc-fred/fred/pkg/grpcutil"
 	"git.tu-berlin.de/mcc-fred/fred/proto/peering"
@@ -11,14 +14,33 @@ import (
 	"github.com/go-errors/errors"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 )
 
+// capabilitiesRefresh is how long the capabilities of a peer are remembered before they are requested again, so that
+// upgraded peers are noticed.
+const capabilitiesRefresh = 5 * time.Minute
+
+// capabilitiesTimeout is how long we wait for a peer to tell its capabilities.
+const capabilitiesTimeout = 5 * time.Second
+
+// capabilities are the codecs that a peer supports.
+type capabilities struct {
+	codecs  map[fred.Compression]struct{}
+	fetched time.Time
+}
+
 // Client is an peering client to communicate with peers.
 type Client struct {
 	conn        map[string]peering.NodeClient
 	connLock    sync.RWMutex
 	credentials credentials.TransportCredentials
+
+	compression compression.Settings
+	caps        map[string]*capabilities
+	capsLock    sync.Mutex
 }
 
 // NewClient creates a new empty client to communicate with peers.
@@ -35,9 +57,99 @@ func NewClient(certFile string, keyFile string, caFile string, skipVerify bool)
 		conn:        make(map[string]peering.NodeClient),
 		connLock:    sync.RWMutex{},
 		credentials: creds,
+		caps:        make(map[string]*capabilities),
 	}
 }
 
+// SetCompression makes the client compress the values that it sends with the codec of their keygroup, if the
+// receiving peer supports it.
+func (c *Client) SetCompression(n compression.Settings) {
+	c.compression = n
+}
+
+// supports returns whether the peer at this address supports a codec. Peers that don't know about capabilities
+// support none.
+func (c *Client) supports(host string, codec fred.Compression) bool {
+	c.capsLock.Lock()
+	caps, ok := c.caps[host]
+	c.capsLock.Unlock()
+
+	if ok && time.Since(caps.fetched) < capabilitiesRefresh {
+		_, ok = caps.codecs[codec]
+		return ok
+	}
+
+	client, err := c.getClient(host)
+
+	if err != nil {
+		return false
+	}
+
+	ctx, cncl := context.WithTimeout(context.Background(), capabilitiesTimeout)
+	defer cncl()
+
+	res, err := client.GetCapabilities(ctx, &peering.Empty{})
+
+	if err != nil && status.Code(err) != codes.Unimplemented {
+		// we try again next time, the actual request will probably fail anyway
+		log.Debug().Msgf("peering client: cannot get capabilities of %s: %s", host, err.Error())
+		return false
+	}
+
+	caps = &capabilities{
+		codecs:  make(map[fred.Compression]struct{}),
+		fetched: time.Now(),
+	}
+
+	for _, s := range res.GetCodecs() {
+		caps.codecs[fred.Compression(s)] = struct{}{}
+	}
+
+	c.capsLock.Lock()
+	c.caps[host] = caps
+	c.capsLock.Unlock()
+
+	_, ok = caps.codecs[codec]
+	return ok
+}
+
+// codec returns the codec that values of a keygroup are sent to the peer at this address with, empty if they are not
+// compressed.
+func (c *Client) codec(host string, kgname fred.KeygroupName) fred.Compression {
+	if c.compression == nil {
+		return ""
+	}
+
+	codec, err := c.compression.GetKeygroupCompression(kgname)
+
+	if err != nil {
+		log.Debug().Msgf("peering client: cannot get compression of keygroup %s: %s", kgname, err.Error())
+		return ""
+	}
+
+	if codec == "" || codec == fred.NoCompression || !c.supports(host, codec) {
+		return ""
+	}
+
+	return codec
+}
+
+// compress compresses a value with a codec. It returns the value as it is and no codec if compressing does not make
+// it smaller.
+func compress(codec fred.Compression, value string) ([]byte, string) {
+	if codec == "" {
+		return []byte(value), ""
+	}
+
+	compressed, err := compression.Compress(codec, []byte(value))
+
+	if err != nil || len(compressed) >= len(value) {
+		return []byte(value), ""
+	}
+
+	return compressed, string(codec)
+}
+
 // getClient creates a new connection to a server or uses an existing one.
 func (c *Client) getClient(host string) (peering.NodeClient, error) {
 
@@ -103,19 +215,25 @@ func (c *Client) SendDeleteKeygroup(host string, kgname fred.KeygroupName) error
 }
 
 // SendUpdate sends this command to the server at this address
-func (c *Client) SendUpdate(host string, kgname fred.KeygroupName, id string, value string, tombstoned bool, vvector vclock.VClock) error {
+func (c *Client) SendUpdate(host string, kgname fred.KeygroupName, source fred.NodeID, id string, value string, tombstoned bool, vvector vclock.VClock, ttl int, timestamp uint64) error {
 	client, err := c.getClient(host)
 
 	if err != nil {
 		return errors.New(err)
 	}
 
+	val, codec := compress(c.codec(host, kgname), value)
+
 	_, err = client.PutItem(context.Background(), &peering.PutItemRequest{
 		Keygroup:   string(kgname),
 		Id:         id,
-		Val:        value,
+		Val:        val,
 		Tombstoned: tombstoned,
 		Version:    vvector,
+		Ttl:        int64(ttl),
+		Timestamp:  timestamp,
+		Codec:      codec,
+		Source:     string(source),
 	})
 
 	if err != nil {
@@ -125,17 +243,22 @@ func (c *Client) SendUpdate(host string, kgname fred.KeygroupName, id string, va
 }
 
 // SendAppend sends this command to the server at this address
-func (c *Client) SendAppend(host string, kgname fred.KeygroupName, id string, value string) error {
+func (c *Client) SendAppend(host string, kgname fred.KeygroupName, source fred.NodeID, id string, value string, ttl int) error {
 	client, err := c.getClient(host)
 
 	if err != nil {
 		return errors.New(err)
 	}
 
+	data, codec := compress(c.codec(host, kgname), value)
+
 	_, err = client.AppendItem(context.Background(), &peering.AppendItemRequest{
 		Keygroup: string(kgname),
 		Id:       id,
-		Data:     value,
+		Data:     data,
+		Ttl:      int64(ttl),
+		Codec:    codec,
+		Source:   string(source),
 	})
 
 	if err != nil {
@@ -165,10 +288,11 @@ func (c *Client) SendGetItem(host string, kgname fred.KeygroupName, id string) (
 
 	for i, item := range res.Data {
 		items[i] = fred.Item{
-			Keygroup: kgname,
-			ID:       id,
-			Val:      item.Val,
-			Version:  item.Version,
+			Keygroup:   kgname,
+			ID:         id,
+			Val:        string(item.Val),
+			Version:    item.Version,
+			Tombstoned: item.Tombstoned,
 		}
 	}
 
@@ -195,12 +319,370 @@ func (c *Client) SendGetAllItems(host string, kgname fred.KeygroupName) ([]fred.
 
 	for i, item := range res.Data {
 		d[i] = fred.Item{
-			Keygroup: kgname,
-			ID:       item.Id,
-			Val:      item.Val,
-			Version:  item.Version,
+			Keygroup:   kgname,
+			ID:         item.Id,
+			Val:        string(item.Val),
+			Version:    item.Version,
+			Tombstoned: item.Tombstoned,
 		}
 	}
 
 	return d, nil
 }
+
+// SendTransferKeygroup requests all items of a keygroup after the given checkpoint from the server at this address.
+// The items are streamed back in pages of pageSize items, receive is called for every page.
+func (c *Client) SendTransferKeygroup(host string, kgname fred.KeygroupName, checkpoint string, pageSize uint64, receive func(items []fred.Item) error) error {
+	client, err := c.getClient(host)
+
+	if err != nil {
+		return err
+	}
+
+	ctx, cncl := context.WithCancel(context.Background())
+	defer cncl()
+
+	stream, err := client.TransferKeygroup(ctx, &peering.TransferKeygroupRequest{
+		Keygroup:   string(kgname),
+		Checkpoint: checkpoint,
+		PageSize:   pageSize,
+	})
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	for {
+		chunk, err := stream.Recv()
+
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return errors.New(err)
+		}
+
+		d := make([]fred.Item, len(chunk.Data))
+
+		for i, item := range chunk.Data {
+			d[i] = fred.Item{
+				Keygroup:   kgname,
+				ID:         item.Id,
+				Val:        string(item.Val),
+				Version:    item.Version,
+				Tombstoned: item.Tombstoned,
+				Timestamp:  item.Timestamp,
+			}
+		}
+
+		err = receive(d)
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// SendPushKeygroup streams pages of items of a keygroup to the server at this address until next returns an empty page.
+func (c *Client) SendPushKeygroup(host string, kgname fred.KeygroupName, source fred.NodeID, next func() ([]fred.Item, error)) error {
+	client, err := c.getClient(host)
+
+	if err != nil {
+		return err
+	}
+
+	ctx, cncl := context.WithCancel(context.Background())
+	defer cncl()
+
+	stream, err := client.PushKeygroup(ctx)
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	codec := c.codec(host, kgname)
+
+	for {
+		items, err := next()
+
+		if err != nil {
+			return err
+		}
+
+		if len(items) == 0 {
+			break
+		}
+
+		err = stream.Send(&peering.KeygroupChunk{
+			Keygroup: string(kgname),
+			Source:   string(source),
+			Data:     itemsToData(items, codec),
+		})
+
+		// if the server has aborted the stream, Send only returns io.EOF and the actual error comes with CloseAndRecv
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return errors.New(err)
+		}
+	}
+
+	_, err = stream.CloseAndRecv()
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+// SendGetMerkleTree requests the merkle tree over all items of a keygroup from the server at this address.
+func (c *Client) SendGetMerkleTree(host string, kgname fred.KeygroupName) ([]uint64, error) {
+	client, err := c.getClient(host)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.GetMerkleTree(context.Background(), &peering.GetMerkleTreeRequest{
+		Keygroup: string(kgname),
+	})
+
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	return res.Nodes, nil
+}
+
+// SendGetBucketItems requests all items of a keygroup in the given merkle tree buckets from the server at this address.
+// receive is called for every page of items that is streamed back.
+func (c *Client) SendGetBucketItems(host string, kgname fred.KeygroupName, buckets []int, receive func(items []fred.Item) error) error {
+	client, err := c.getClient(host)
+
+	if err != nil {
+		return err
+	}
+
+	ctx, cncl := context.WithCancel(context.Background())
+	defer cncl()
+
+	b := make([]uint32, len(buckets))
+
+	for i, bucket := range buckets {
+		b[i] = uint32(bucket)
+	}
+
+	stream, err := client.GetBucketItems(ctx, &peering.GetBucketItemsRequest{
+		Keygroup: string(kgname),
+		Buckets:  b,
+	})
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	for {
+		chunk, err := stream.Recv()
+
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return errors.New(err)
+		}
+
+		d := make([]fred.Item, len(chunk.Data))
+
+		for i, item := range chunk.Data {
+			d[i] = fred.Item{
+				Keygroup:   kgname,
+				ID:         item.Id,
+				Val:        string(item.Val),
+				Version:    item.Version,
+				Tombstoned: item.Tombstoned,
+				Timestamp:  item.Timestamp,
+			}
+		}
+
+		err = receive(d)
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// SendReplicateBatch sends a batch of updates and appends to the server at this address. Returns how many messages from
+// the start of the batch the server is done with and the indexes of the messages that it rejected.
+func (c *Client) SendReplicateBatch(host string, source fred.NodeID, batch []fred.ReplicationMessage) (int, []int, error) {
+	client, err := c.getClient(host)
+
+	if err != nil {
+		return 0, nil, errors.New(err)
+	}
+
+	entries := make([]*peering.ReplicationEntry, len(batch))
+	codecs := make(map[fred.KeygroupName]fred.Compression)
+
+	for i, m := range batch {
+		kgCodec, ok := codecs[m.Item.Keygroup]
+
+		if !ok {
+			kgCodec = c.codec(host, m.Item.Keygroup)
+			codecs[m.Item.Keygroup] = kgCodec
+		}
+
+		val, codec := compress(kgCodec, m.Item.Val)
+
+		entries[i] = &peering.ReplicationEntry{
+			Append:     m.Append,
+			Keygroup:   string(m.Item.Keygroup),
+			Id:         m.Item.ID,
+			Val:        val,
+			Tombstoned: m.Item.Tombstoned,
+			Version:    m.Item.Version,
+			Ttl:        int64(m.Item.TTL),
+			Timestamp:  m.Item.Timestamp,
+			Codec:      codec,
+		}
+
+		if m.Batch != nil {
+			entries[i].Batch = itemsToData(m.Batch, kgCodec)
+		}
+	}
+
+	res, err := client.ReplicateBatch(context.Background(), &peering.ReplicateBatchRequest{
+		Entries: entries,
+		Source:  string(source),
+	})
+
+	if err != nil {
+		return 0, nil, errors.New(err)
+	}
+
+	rejected := make([]int, len(res.Rejected))
+
+	for i, j := range res.Rejected {
+		rejected[i] = int(j)
+	}
+
+	return int(res.Applied), rejected, nil
+}
+
+// SendPutBatch sends a batch of updates to a keygroup that have to be applied atomically to the server at this address
+func (c *Client) SendPutBatch(host string, kgname fred.KeygroupName, source fred.NodeID, items []fred.Item) error {
+	client, err := c.getClient(host)
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	_, err = client.PutBatch(context.Background(), &peering.PutBatchRequest{
+		Keygroup: string(kgname),
+		Data:     itemsToData(items, c.codec(host, kgname)),
+		Source:   string(source),
+	})
+
+	if err != nil {
+		return errors.New(err)
+	}
+	return nil
+}
+
+// SendAcknowledgeTombstones asks the server at this address which of the given tombstones of a keygroup it has seen.
+// It returns the IDs of the acknowledged tombstones and the IDs of the items that the server does not know at all.
+func (c *Client) SendAcknowledgeTombstones(host string, kgname fred.KeygroupName, items []fred.Item) ([]string, []string, error) {
+	client, err := c.getClient(host)
+
+	if err != nil {
+		return nil, nil, errors.New(err)
+	}
+
+	d := make([]*peering.Data, len(items))
+
+	for i, item := range items {
+		d[i] = &peering.Data{
+			Id:         item.ID,
+			Tombstoned: item.Tombstoned,
+			Version:    item.Version,
+		}
+	}
+
+	res, err := client.AcknowledgeTombstones(context.Background(), &peering.AcknowledgeTombstonesRequest{
+		Keygroup: string(kgname),
+		Data:     d,
+	})
+
+	if err != nil {
+		return nil, nil, errors.New(err)
+	}
+
+	return res.Acknowledged, res.Missing, nil
+}
+
+// SendCloneKeygroup asks the server at this address to copy its copy of a keygroup into a new keygroup.
+func (c *Client) SendCloneKeygroup(host string, src fred.KeygroupName, dst fred.KeygroupName) error {
+	client, err := c.getClient(host)
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	_, err = client.CloneKeygroup(context.Background(), &peering.CloneKeygroupRequest{
+		Source:   string(src),
+		Keygroup: string(dst),
+	})
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+// SendAddReplica asks the server at this address to add a replica to a keygroup and to send it its data.
+func (c *Client) SendAddReplica(host string, kgname fred.KeygroupName, nodeID fred.NodeID, expiry int) error {
+	client, err := c.getClient(host)
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	_, err = client.AddReplica(context.Background(), &peering.AddReplicaRequest{
+		Keygroup: string(kgname),
+		NodeId:   string(nodeID),
+		Expiry:   int64(expiry),
+	})
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+// itemsToData converts a list of items that we send to another node, their values are compressed with the codec.
+func itemsToData(items []fred.Item, codec fred.Compression) []*peering.Data {
+	d := make([]*peering.Data, len(items))
+
+	for i, item := range items {
+		val, c := compress(codec, item.Val)
+
+		d[i] = &peering.Data{
+			Id:         item.ID,
+			Val:        val,
+			Tombstoned: item.Tombstoned,
+			Version:    item.Version,
+			Timestamp:  item.Timestamp,
+			Codec:      c,
+			Ttl:        int64(item.TTL),
+		}
+	}
+
+	return d
+}
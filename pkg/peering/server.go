@@ -3,8 +3,11 @@ package peering
 import (
 	"context"
 	"crypto/tls"
+	"io"
 	"net"
+	"sort"
 
+	"git.tu-berlin.de/mcc-fred/fred/pkg/compression"
 	"git.tu-berlin.de/mcc-fred/fred/pkg/grpcutil"
 	"git.tu-berlin.de/mcc-fred/fred/proto/peering"
 	"github.com/rs/zerolog/log"
@@ -94,12 +97,20 @@ func (s *Server) PutItem(ctx context.Context, request *peering.PutItemRequest) (
 
 	log.Info().Msgf("Peering server has rcvd PutItem. In: %+v from %s to %s", request, other, s.host)
 
-	err := s.i.HandleUpdate(fred.Item{
+	val, err := value(request.Codec, request.Val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.i.HandleUpdate(fred.NodeID(request.Source), fred.Item{
 		Keygroup:   fred.KeygroupName(request.Keygroup),
 		ID:         request.Id,
-		Val:        request.Val,
+		Val:        val,
 		Version:    request.Version,
 		Tombstoned: request.Tombstoned,
+		TTL:        int(request.Ttl),
+		Timestamp:  request.Timestamp,
 	})
 
 	if err != nil {
@@ -112,10 +123,17 @@ func (s *Server) PutItem(ctx context.Context, request *peering.PutItemRequest) (
 func (s *Server) AppendItem(_ context.Context, request *peering.AppendItemRequest) (*peering.Empty, error) {
 	log.Info().Msgf("Peering server has rcvd AppendItem. In: %+v", request)
 
-	err := s.i.HandleAppend(fred.Item{
+	val, err := value(request.Codec, request.Data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.i.HandleAppend(fred.NodeID(request.Source), fred.Item{
 		Keygroup: fred.KeygroupName(request.Keygroup),
 		ID:       request.Id,
-		Val:      request.Data,
+		Val:      val,
+		TTL:      int(request.Ttl),
 	})
 
 	if err != nil {
@@ -141,9 +159,10 @@ func (s *Server) GetItem(_ context.Context, request *peering.GetItemRequest) (*p
 
 	for i, item := range items {
 		data[i] = &peering.Data{
-			Id:      item.ID,
-			Val:     item.Val,
-			Version: item.Version,
+			Id:         item.ID,
+			Val:        []byte(item.Val),
+			Tombstoned: item.Tombstoned,
+			Version:    item.Version,
 		}
 	}
 
@@ -166,9 +185,10 @@ func (s *Server) GetAllItems(_ context.Context, request *peering.GetAllItemsRequ
 
 	for i, item := range data {
 		d[i] = &peering.Data{
-			Id:      item.ID,
-			Val:     item.Val,
-			Version: item.Version,
+			Id:         item.ID,
+			Val:        []byte(item.Val),
+			Tombstoned: item.Tombstoned,
+			Version:    item.Version,
 		}
 	}
 
@@ -176,3 +196,328 @@ func (s *Server) GetAllItems(_ context.Context, request *peering.GetAllItemsRequ
 		Data: d,
 	}, nil
 }
+
+// TransferKeygroup streams all items of a keygroup after the given checkpoint page by page from the Inthandler
+func (s *Server) TransferKeygroup(request *peering.TransferKeygroupRequest, stream peering.Node_TransferKeygroupServer) error {
+	log.Info().Msgf("Peering server has rcvd TransferKeygroup. In: %+v", request)
+
+	after := request.Checkpoint
+
+	for {
+		items, err := s.i.HandleGetItemPage(fred.Keygroup{
+			Name: fred.KeygroupName(request.Keygroup),
+		}, after, request.PageSize)
+
+		if err != nil {
+			return err
+		}
+
+		if len(items) == 0 {
+			return nil
+		}
+
+		d := make([]*peering.Data, len(items))
+
+		for i, item := range items {
+			d[i] = &peering.Data{
+				Id:         item.ID,
+				Val:        []byte(item.Val),
+				Tombstoned: item.Tombstoned,
+				Version:    item.Version,
+				Timestamp:  item.Timestamp,
+			}
+		}
+
+		err = stream.Send(&peering.KeygroupChunk{
+			Keygroup: request.Keygroup,
+			Data:     d,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		after = items[len(items)-1].ID
+	}
+}
+
+// PushKeygroup calls HandleReceiveItemPage on the Inthandler for every page of items that is streamed to this node
+func (s *Server) PushKeygroup(stream peering.Node_PushKeygroupServer) error {
+	log.Info().Msg("Peering server has rcvd PushKeygroup")
+
+	for {
+		chunk, err := stream.Recv()
+
+		if err == io.EOF {
+			return stream.SendAndClose(&peering.Empty{})
+		}
+
+		if err != nil {
+			return err
+		}
+
+		items, err := dataToItems(fred.KeygroupName(chunk.Keygroup), chunk.Data)
+
+		if err != nil {
+			return err
+		}
+
+		err = s.i.HandleReceiveItemPage(fred.Keygroup{
+			Name: fred.KeygroupName(chunk.Keygroup),
+		}, fred.NodeID(chunk.Source), items)
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// GetMerkleTree calls HandleGetMerkleTree on the Inthandler
+func (s *Server) GetMerkleTree(_ context.Context, request *peering.GetMerkleTreeRequest) (*peering.GetMerkleTreeResponse, error) {
+	log.Debug().Msgf("Peering server has rcvd GetMerkleTree. In: %+v", request)
+
+	nodes, err := s.i.HandleGetMerkleTree(fred.Keygroup{
+		Name: fred.KeygroupName(request.Keygroup),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &peering.GetMerkleTreeResponse{
+		Nodes: nodes,
+	}, nil
+}
+
+// GetBucketItems streams all items of a keygroup in the requested merkle tree buckets page by page from the Inthandler
+func (s *Server) GetBucketItems(request *peering.GetBucketItemsRequest, stream peering.Node_GetBucketItemsServer) error {
+	log.Info().Msgf("Peering server has rcvd GetBucketItems. In: %+v", request)
+
+	buckets := make([]int, len(request.Buckets))
+
+	for i, b := range request.Buckets {
+		buckets[i] = int(b)
+	}
+
+	return s.i.HandleGetBucketItems(fred.Keygroup{
+		Name: fred.KeygroupName(request.Keygroup),
+	}, buckets, func(items []fred.Item) error {
+		d := make([]*peering.Data, len(items))
+
+		for i, item := range items {
+			d[i] = &peering.Data{
+				Id:         item.ID,
+				Val:        []byte(item.Val),
+				Tombstoned: item.Tombstoned,
+				Version:    item.Version,
+				Timestamp:  item.Timestamp,
+			}
+		}
+
+		return stream.Send(&peering.KeygroupChunk{
+			Keygroup: request.Keygroup,
+			Data:     d,
+		})
+	})
+}
+
+// ReplicateBatch calls HandleReplicateBatch on the Inthandler. Entries that cannot be decoded are rejected.
+func (s *Server) ReplicateBatch(_ context.Context, request *peering.ReplicateBatchRequest) (*peering.ReplicateBatchResponse, error) {
+	log.Info().Msgf("Peering server has rcvd ReplicateBatch with %d entries", len(request.Entries))
+
+	msgs := make([]fred.ReplicationMessage, 0, len(request.Entries))
+	// index is the position of each message in the entries of the request
+	index := make([]int, 0, len(request.Entries))
+	rejected := make([]uint64, 0)
+
+	for i, e := range request.Entries {
+		val, err := value(e.Codec, e.Val)
+
+		if err != nil {
+			log.Err(err).Msgf("ReplicateBatch: rejecting entry for %s that cannot be decoded", e.Keygroup)
+			rejected = append(rejected, uint64(i))
+			continue
+		}
+
+		m := fred.ReplicationMessage{
+			Append: e.Append,
+			Item: fred.Item{
+				Keygroup:   fred.KeygroupName(e.Keygroup),
+				ID:         e.Id,
+				Val:        val,
+				Version:    e.Version,
+				Tombstoned: e.Tombstoned,
+				TTL:        int(e.Ttl),
+				Timestamp:  e.Timestamp,
+			},
+		}
+
+		if e.Batch != nil {
+			if m.Batch, err = dataToItems(fred.KeygroupName(e.Keygroup), e.Batch); err != nil {
+				log.Err(err).Msgf("ReplicateBatch: rejecting entry for %s that cannot be decoded", e.Keygroup)
+				rejected = append(rejected, uint64(i))
+				continue
+			}
+		}
+
+		msgs = append(msgs, m)
+		index = append(index, i)
+	}
+
+	applied, r, err := s.i.HandleReplicateBatch(fred.NodeID(request.Source), msgs)
+
+	for _, j := range r {
+		rejected = append(rejected, uint64(index[j]))
+	}
+
+	done := uint64(len(request.Entries))
+
+	if err != nil {
+		log.Warn().Msgf("ReplicateBatch: could only apply %d of %d entries: %s", applied, len(msgs), err.Error())
+		done = uint64(index[applied])
+	}
+
+	return &peering.ReplicateBatchResponse{
+		Applied:  done,
+		Rejected: rejectedBefore(rejected, done),
+	}, nil
+}
+
+// rejectedBefore returns the sorted indexes of rejected entries that come before the given index, the others are sent
+// again anyway.
+func rejectedBefore(rejected []uint64, done uint64) []uint64 {
+	sort.Slice(rejected, func(i, j int) bool {
+		return rejected[i] < rejected[j]
+	})
+
+	for i, j := range rejected {
+		if j >= done {
+			return rejected[:i]
+		}
+	}
+
+	return rejected
+}
+
+// PutBatch calls HandleBatch on the Inthandler
+func (s *Server) PutBatch(_ context.Context, request *peering.PutBatchRequest) (*peering.Empty, error) {
+	log.Info().Msgf("Peering server has rcvd PutBatch with %d items for %s", len(request.Data), request.Keygroup)
+
+	items, err := dataToItems(fred.KeygroupName(request.Keygroup), request.Data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.i.HandleBatch(fred.Keygroup{
+		Name: fred.KeygroupName(request.Keygroup),
+	}, fred.NodeID(request.Source), items)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &peering.Empty{}, nil
+}
+
+// AcknowledgeTombstones calls HandleAcknowledgeTombstones on the Inthandler
+func (s *Server) AcknowledgeTombstones(_ context.Context, request *peering.AcknowledgeTombstonesRequest) (*peering.AcknowledgeTombstonesResponse, error) {
+	log.Debug().Msgf("Peering server has rcvd AcknowledgeTombstones with %d items for %s", len(request.Data), request.Keygroup)
+
+	items, err := dataToItems(fred.KeygroupName(request.Keygroup), request.Data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	acked, missing, err := s.i.HandleAcknowledgeTombstones(fred.Keygroup{
+		Name: fred.KeygroupName(request.Keygroup),
+	}, items)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &peering.AcknowledgeTombstonesResponse{
+		Acknowledged: acked,
+		Missing:      missing,
+	}, nil
+}
+
+// CloneKeygroup calls HandleCloneKeygroup on the Inthandler
+func (s *Server) CloneKeygroup(_ context.Context, request *peering.CloneKeygroupRequest) (*peering.Empty, error) {
+	log.Info().Msgf("Peering server has rcvd CloneKeygroup. In: %+v", request)
+
+	err := s.i.HandleCloneKeygroup(fred.Keygroup{Name: fred.KeygroupName(request.Source)}, fred.Keygroup{Name: fred.KeygroupName(request.Keygroup)})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &peering.Empty{}, nil
+}
+
+// AddReplica calls HandleAddReplica on the Inthandler
+func (s *Server) AddReplica(_ context.Context, request *peering.AddReplicaRequest) (*peering.Empty, error) {
+	log.Info().Msgf("Peering server has rcvd AddReplica. In: %+v", request)
+
+	err := s.i.HandleAddReplica(fred.Keygroup{Name: fred.KeygroupName(request.Keygroup), Expiry: int(request.Expiry)}, fred.Node{ID: fred.NodeID(request.NodeId)})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &peering.Empty{}, nil
+}
+
+// GetCapabilities returns the codecs that this node can decompress values with.
+func (s *Server) GetCapabilities(_ context.Context, _ *peering.Empty) (*peering.Capabilities, error) {
+	log.Debug().Msg("Peering server has rcvd GetCapabilities")
+
+	supported := compression.Supported()
+	codecs := make([]string, len(supported))
+
+	for i, c := range supported {
+		codecs[i] = string(c)
+	}
+
+	return &peering.Capabilities{
+		Codecs: codecs,
+	}, nil
+}
+
+// value decompresses a value that we received from another node with the codec that it was sent with.
+func value(codec string, val []byte) (string, error) {
+	d, err := compression.Decompress(fred.Compression(codec), val)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(d), nil
+}
+
+// dataToItems converts a list of items of a keygroup that we received from another node.
+func dataToItems(kg fred.KeygroupName, data []*peering.Data) ([]fred.Item, error) {
+	items := make([]fred.Item, len(data))
+
+	for i, item := range data {
+		val, err := value(item.Codec, item.Val)
+
+		if err != nil {
+			return nil, err
+		}
+
+		items[i] = fred.Item{
+			Keygroup:   kg,
+			ID:         item.Id,
+			Val:        val,
+			Version:    item.Version,
+			Tombstoned: item.Tombstoned,
+			TTL:        int(item.Ttl),
+			Timestamp:  item.Timestamp,
+		}
+	}
+
+	return items, nil
+}
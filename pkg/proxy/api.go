@@ -5,6 +5,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
+	"io"
 	"net"
 	"time"
 
@@ -202,6 +203,38 @@ func (a *APIProxy) Read(ctx context.Context, req *client.ReadRequest) (*client.R
 	return c.Read(ctx, req)
 }
 
+// ReadHistory calls this method on the exthandler
+func (a *APIProxy) ReadHistory(ctx context.Context, req *client.ReadHistoryRequest) (*client.ReadHistoryResponse, error) {
+	c, err := a.getConn(req.Keygroup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = a.addUserHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ReadHistory(ctx, req)
+}
+
+// ReadAt calls this method on the exthandler
+func (a *APIProxy) ReadAt(ctx context.Context, req *client.ReadAtRequest) (*client.ReadResponse, error) {
+	c, err := a.getConn(req.Keygroup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = a.addUserHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ReadAt(ctx, req)
+}
+
 // Scan calls this method on the exthandler
 func (a *APIProxy) Scan(ctx context.Context, req *client.ScanRequest) (*client.ScanResponse, error) {
 
@@ -447,3 +480,438 @@ func (a *APIProxy) RemoveUser(ctx context.Context, req *client.RemoveUserRequest
 
 	return c.RemoveUser(ctx, req)
 }
+
+// Watch forwards the stream of changes from the node that has this keygroup
+func (a *APIProxy) Watch(req *client.WatchRequest, stream client.Client_WatchServer) error {
+	c, err := a.getConn(req.Keygroup)
+
+	if err != nil {
+		return err
+	}
+
+	ctx, err := a.addUserHeader(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	w, err := c.Watch(ctx, req)
+
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := w.Recv()
+
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		err = stream.Send(event)
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// ReadChanges forwards the stream of changes from the node that has this keygroup
+func (a *APIProxy) ReadChanges(req *client.ReadChangesRequest, stream client.Client_ReadChangesServer) error {
+	c, err := a.getConn(req.Keygroup)
+
+	if err != nil {
+		return err
+	}
+
+	ctx, err := a.addUserHeader(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	r, err := c.ReadChanges(ctx, req)
+
+	if err != nil {
+		return err
+	}
+
+	for {
+		change, err := r.Recv()
+
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		err = stream.Send(change)
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// SetChangeCursor calls this method on the exthandler
+func (a *APIProxy) SetChangeCursor(ctx context.Context, req *client.SetChangeCursorRequest) (*client.Empty, error) {
+	c, err := a.getConn(req.Keygroup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = a.addUserHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.SetChangeCursor(ctx, req)
+}
+
+// CloneKeygroup calls this method on the exthandler
+func (a *APIProxy) CloneKeygroup(ctx context.Context, req *client.CloneKeygroupRequest) (*client.Empty, error) {
+	c, err := a.getConn(req.Keygroup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = a.addUserHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CloneKeygroup(ctx, req)
+}
+
+// RenameKeygroup calls this method on the exthandler
+func (a *APIProxy) RenameKeygroup(ctx context.Context, req *client.RenameKeygroupRequest) (*client.Empty, error) {
+	c, err := a.getConn(req.Keygroup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = a.addUserHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.RenameKeygroup(ctx, req)
+}
+
+// SetKeygroupQuota calls this method on the exthandler
+func (a *APIProxy) SetKeygroupQuota(ctx context.Context, req *client.SetKeygroupQuotaRequest) (*client.Empty, error) {
+	c, err := a.getConn(req.Keygroup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = a.addUserHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.SetKeygroupQuota(ctx, req)
+}
+
+// SetKeygroupReplicationPolicy calls this method on the exthandler
+func (a *APIProxy) SetKeygroupReplicationPolicy(ctx context.Context, req *client.SetKeygroupReplicationPolicyRequest) (*client.Empty, error) {
+	c, err := a.getConn(req.Keygroup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = a.addUserHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.SetKeygroupReplicationPolicy(ctx, req)
+}
+
+// GetNearestReplicas calls this method on the exthandler
+func (a *APIProxy) GetNearestReplicas(ctx context.Context, req *client.GetNearestReplicasRequest) (*client.GetNearestReplicasResponse, error) {
+	c, err := a.getConn(req.Keygroup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = a.addUserHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetNearestReplicas(ctx, req)
+}
+
+// ProposeReplicas calls this method on the exthandler
+func (a *APIProxy) ProposeReplicas(ctx context.Context, req *client.ProposeReplicasRequest) (*client.ProposeReplicasResponse, error) {
+	c, err := a.getConn(req.Keygroup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = a.addUserHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ProposeReplicas(ctx, req)
+}
+
+// DecommissionNode calls this method on the exthandler
+func (a *APIProxy) DecommissionNode(req *client.DecommissionNodeRequest, stream client.Client_DecommissionNodeServer) error {
+	c, err := a.getAny()
+
+	if err != nil {
+		return err
+	}
+
+	ctx, err := a.addUserHeader(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	d, err := c.DecommissionNode(ctx, req)
+
+	if err != nil {
+		return err
+	}
+
+	for {
+		p, err := d.Recv()
+
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		err = stream.Send(p)
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// ExportKeygroup calls this method on the exthandler
+func (a *APIProxy) ExportKeygroup(req *client.ExportKeygroupRequest, stream client.Client_ExportKeygroupServer) error {
+	c, err := a.getConn(req.Keygroup)
+
+	if err != nil {
+		return err
+	}
+
+	ctx, err := a.addUserHeader(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	e, err := c.ExportKeygroup(ctx, req)
+
+	if err != nil {
+		return err
+	}
+
+	for {
+		chunk, err := e.Recv()
+
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		err = stream.Send(chunk)
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// ImportKeygroup calls this method on the exthandler
+func (a *APIProxy) ImportKeygroup(stream client.Client_ImportKeygroupServer) error {
+	// the keygroup is only known once we have the first request
+	first, err := stream.Recv()
+
+	if err != nil {
+		return err
+	}
+
+	kg := first.Keygroup
+
+	if kg == "" {
+		kg = first.Chunk.GetHeader().GetKeygroup()
+	}
+
+	c, err := a.getConn(kg)
+
+	if err != nil {
+		return err
+	}
+
+	ctx, err := a.addUserHeader(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	i, err := c.ImportKeygroup(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	req := first
+
+	for {
+		if err := i.Send(req); err != nil {
+			return err
+		}
+
+		req, err = stream.Recv()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	res, err := i.CloseAndRecv()
+
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(res)
+}
+
+// Batch calls this method on the exthandler
+func (a *APIProxy) Batch(ctx context.Context, req *client.BatchRequest) (*client.BatchResponse, error) {
+	c, err := a.getConn(req.Keygroup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = a.addUserHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Batch(ctx, req)
+}
+
+// QueryIndex calls this method on the exthandler
+func (a *APIProxy) QueryIndex(ctx context.Context, req *client.QueryIndexRequest) (*client.QueryIndexResponse, error) {
+	c, err := a.getConn(req.Keygroup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = a.addUserHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.QueryIndex(ctx, req)
+}
+
+// Increment calls this method on the exthandler
+func (a *APIProxy) Increment(ctx context.Context, req *client.IncrementRequest) (*client.IncrementResponse, error) {
+	c, err := a.getConn(req.Keygroup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = a.addUserHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Increment(ctx, req)
+}
+
+// SetAdd calls this method on the exthandler
+func (a *APIProxy) SetAdd(ctx context.Context, req *client.SetRequest) (*client.SetResponse, error) {
+	c, err := a.getConn(req.Keygroup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = a.addUserHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.SetAdd(ctx, req)
+}
+
+// SetRemove calls this method on the exthandler
+func (a *APIProxy) SetRemove(ctx context.Context, req *client.SetRequest) (*client.SetResponse, error) {
+	c, err := a.getConn(req.Keygroup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = a.addUserHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.SetRemove(ctx, req)
+}
+
+// MapPut calls this method on the exthandler
+func (a *APIProxy) MapPut(ctx context.Context, req *client.MapPutRequest) (*client.MapResponse, error) {
+	c, err := a.getConn(req.Keygroup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = a.addUserHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.MapPut(ctx, req)
+}
+
+// MapRemove calls this method on the exthandler
+func (a *APIProxy) MapRemove(ctx context.Context, req *client.MapRemoveRequest) (*client.MapResponse, error) {
+	c, err := a.getConn(req.Keygroup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = a.addUserHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.MapRemove(ctx, req)
+}
@@ -3,6 +3,8 @@ package proxy
 import (
 	"context"
 	"fmt"
+	"io"
+	"sort"
 
 	"git.tu-berlin.de/mcc-fred/fred/pkg/grpcutil"
 	"git.tu-berlin.de/mcc-fred/fred/proto/peering"
@@ -122,3 +124,256 @@ func (p *PeeringProxy) GetAllItems(ctx context.Context, req *peering.GetAllItems
 
 	return c.GetAllItems(ctx, req)
 }
+
+// TransferKeygroup forwards the stream of items from the node that has this keygroup
+func (p *PeeringProxy) TransferKeygroup(req *peering.TransferKeygroupRequest, stream peering.Node_TransferKeygroupServer) error {
+	c, err := p.getConn(req.Keygroup)
+
+	if err != nil {
+		return err
+	}
+
+	client, err := c.TransferKeygroup(stream.Context(), req)
+
+	if err != nil {
+		return err
+	}
+
+	for {
+		chunk, err := client.Recv()
+
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		err = stream.Send(chunk)
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// PushKeygroup forwards the stream of items to the node that has this keygroup
+func (p *PeeringProxy) PushKeygroup(stream peering.Node_PushKeygroupServer) error {
+	// we only know where to send these items once we have the first one
+	chunk, err := stream.Recv()
+
+	if err == io.EOF {
+		return stream.SendAndClose(&peering.Empty{})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	c, err := p.getConn(chunk.Keygroup)
+
+	if err != nil {
+		return err
+	}
+
+	client, err := c.PushKeygroup(stream.Context())
+
+	if err != nil {
+		return err
+	}
+
+	for {
+		err = client.Send(chunk)
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		chunk, err = stream.Recv()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	res, err := client.CloseAndRecv()
+
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(res)
+}
+
+// GetMerkleTree forwards the request to the node that has this keygroup
+func (p *PeeringProxy) GetMerkleTree(ctx context.Context, req *peering.GetMerkleTreeRequest) (*peering.GetMerkleTreeResponse, error) {
+	c, err := p.getConn(req.Keygroup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetMerkleTree(ctx, req)
+}
+
+// GetBucketItems forwards the stream of items from the node that has this keygroup
+func (p *PeeringProxy) GetBucketItems(req *peering.GetBucketItemsRequest, stream peering.Node_GetBucketItemsServer) error {
+	c, err := p.getConn(req.Keygroup)
+
+	if err != nil {
+		return err
+	}
+
+	client, err := c.GetBucketItems(stream.Context(), req)
+
+	if err != nil {
+		return err
+	}
+
+	for {
+		chunk, err := client.Recv()
+
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		err = stream.Send(chunk)
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// ReplicateBatch splits the batch by the node that has each keygroup and forwards the parts, keeping their order. The
+// batch is applied up to the first entry that one of the nodes could not apply.
+func (p *PeeringProxy) ReplicateBatch(ctx context.Context, req *peering.ReplicateBatchRequest) (*peering.ReplicateBatchResponse, error) {
+	hosts := make([]string, 0)
+	parts := make(map[string]*peering.ReplicateBatchRequest)
+	keygroups := make(map[string]string)
+	// index is the position of each entry of a part in the batch
+	index := make(map[string][]uint64)
+
+	for i, e := range req.Entries {
+		host := p.p.getHost(e.Keygroup)
+
+		if _, ok := parts[host]; !ok {
+			hosts = append(hosts, host)
+			parts[host] = &peering.ReplicateBatchRequest{Source: req.Source}
+			keygroups[host] = e.Keygroup
+		}
+
+		parts[host].Entries = append(parts[host].Entries, e)
+		index[host] = append(index[host], uint64(i))
+	}
+
+	applied := uint64(len(req.Entries))
+	rejected := make([]uint64, 0)
+
+	for _, host := range hosts {
+		c, err := p.getConn(keygroups[host])
+
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := c.ReplicateBatch(ctx, parts[host])
+
+		if err != nil {
+			return nil, err
+		}
+
+		if res.Applied < uint64(len(index[host])) && index[host][res.Applied] < applied {
+			applied = index[host][res.Applied]
+		}
+
+		for _, j := range res.Rejected {
+			rejected = append(rejected, index[host][j])
+		}
+	}
+
+	sort.Slice(rejected, func(i, j int) bool {
+		return rejected[i] < rejected[j]
+	})
+
+	for i, j := range rejected {
+		if j >= applied {
+			rejected = rejected[:i]
+			break
+		}
+	}
+
+	return &peering.ReplicateBatchResponse{
+		Applied:  applied,
+		Rejected: rejected,
+	}, nil
+}
+
+// PutBatch forwards the request to the node that has this keygroup
+func (p *PeeringProxy) PutBatch(ctx context.Context, req *peering.PutBatchRequest) (*peering.Empty, error) {
+	c, err := p.getConn(req.Keygroup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.PutBatch(ctx, req)
+}
+
+// AcknowledgeTombstones forwards the request to the node that has this keygroup
+func (p *PeeringProxy) AcknowledgeTombstones(ctx context.Context, req *peering.AcknowledgeTombstonesRequest) (*peering.AcknowledgeTombstonesResponse, error) {
+	c, err := p.getConn(req.Keygroup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.AcknowledgeTombstones(ctx, req)
+}
+
+// CloneKeygroup forwards the request to the node that has the source keygroup
+func (p *PeeringProxy) CloneKeygroup(ctx context.Context, req *peering.CloneKeygroupRequest) (*peering.Empty, error) {
+	c, err := p.getConn(req.Source)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CloneKeygroup(ctx, req)
+}
+
+// AddReplica forwards the request to the node that has this keygroup
+func (p *PeeringProxy) AddReplica(ctx context.Context, req *peering.AddReplicaRequest) (*peering.Empty, error) {
+	c, err := p.getConn(req.Keygroup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.AddReplica(ctx, req)
+}
+
+// GetCapabilities calls this Method on the Inthandler. All nodes behind the proxy are expected to run the same version,
+// so any one of them can answer.
+func (p *PeeringProxy) GetCapabilities(ctx context.Context, req *peering.Empty) (*peering.Capabilities, error) {
+	c, err := p.getConn("")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetCapabilities(ctx, req)
+}
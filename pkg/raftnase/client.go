@@ -0,0 +1,288 @@
+package raftnase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/etcdnase"
+	"git.tu-berlin.de/mcc-fred/fred/pkg/grpcutil"
+	"git.tu-berlin.de/mcc-fred/fred/proto/nase"
+	"github.com/go-errors/errors"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+)
+
+// Client lets FReD nodes that are not members of an embedded NaSe use it. Requests go to one member and fail over to
+// the next if it cannot be reached. It implements etcdnase.KV.
+type Client struct {
+	hosts   []string
+	conns   []*grpc.ClientConn
+	clients []nase.RaftClient
+
+	lock    sync.Mutex
+	current int
+
+	stop chan struct{}
+	once sync.Once
+}
+
+// NewClient connects to the members of an embedded NaSe at the given addresses.
+func NewClient(hosts []string, certFile string, keyFile string, caFile string, skipVerify bool) (*Client, error) {
+	if len(hosts) == 0 {
+		return nil, errors.Errorf("no members of the embedded NaSe given")
+	}
+
+	creds, _, err := grpcutil.GetCreds(certFile, keyFile, []string{caFile}, false, skipVerify)
+
+	if err != nil {
+		return nil, errors.Errorf("error configuring certificates for the embedded NaSe: %v", err)
+	}
+
+	c := &Client{
+		hosts:   hosts,
+		conns:   make([]*grpc.ClientConn, len(hosts)),
+		clients: make([]nase.RaftClient, len(hosts)),
+		stop:    make(chan struct{}),
+	}
+
+	for i, h := range hosts {
+		conn, err := grpc.Dial(h, grpc.WithTransportCredentials(creds))
+
+		if err != nil {
+			_ = c.Close()
+			return nil, errors.New(err)
+		}
+
+		c.conns[i] = conn
+		c.clients[i] = nase.NewRaftClient(conn)
+	}
+
+	return c, nil
+}
+
+// do calls f with the member that worked last, and with the others in turn if that fails.
+func (c *Client) do(f func(client nase.RaftClient) error) error {
+	c.lock.Lock()
+	start := c.current
+	c.lock.Unlock()
+
+	var err error
+
+	for i := 0; i < len(c.clients); i++ {
+		j := (start + i) % len(c.clients)
+
+		if err = f(c.clients[j]); err == nil {
+			c.lock.Lock()
+			c.current = j
+			c.lock.Unlock()
+
+			return nil
+		}
+
+		log.Debug().Msgf("NaSe: request to embedded NaSe member %s failed: %s", c.hosts[j], err.Error())
+	}
+
+	return errors.New(err)
+}
+
+// Get returns the value of a key or of all keys that start with it.
+func (c *Client) Get(ctx context.Context, key string, prefix bool) (map[string]string, error) {
+	kv := make(map[string]string)
+
+	err := c.do(func(client nase.RaftClient) error {
+		res, err := client.Get(ctx, &nase.GetRequest{
+			Key:    key,
+			Prefix: prefix,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, e := range res.Kvs {
+			kv[e.Key] = e.Value
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return kv, nil
+}
+
+// Put stores a value for a key.
+func (c *Client) Put(ctx context.Context, key string, value string) error {
+	return c.PutAll(ctx, map[string]string{key: value})
+}
+
+// PutAll stores several keys at once.
+func (c *Client) PutAll(ctx context.Context, kv map[string]string) error {
+	req := &nase.PutRequest{
+		Kvs: make([]*nase.KeyValue, 0, len(kv)),
+	}
+
+	for k, v := range kv {
+		req.Kvs = append(req.Kvs, &nase.KeyValue{
+			Key:   k,
+			Value: v,
+		})
+	}
+
+	return c.do(func(client nase.RaftClient) error {
+		_, err := client.Put(ctx, req)
+		return err
+	})
+}
+
+// Delete removes a key or all keys that start with it.
+func (c *Client) Delete(ctx context.Context, key string, prefix bool) error {
+	return c.do(func(client nase.RaftClient) error {
+		_, err := client.Delete(ctx, &nase.DeleteRequest{
+			Key:    key,
+			Prefix: prefix,
+		})
+		return err
+	})
+}
+
+// Watch sends all changes to a key or to all keys that start with it until ctx is canceled. If the member that sends
+// the changes fails, the client watches another one, but changes in between are lost.
+func (c *Client) Watch(ctx context.Context, key string, prefix bool) <-chan []etcdnase.Event {
+	out := make(chan []etcdnase.Event)
+
+	go func() {
+		defer close(out)
+
+		for ctx.Err() == nil {
+			var stream nase.Raft_WatchClient
+
+			err := c.do(func(client nase.RaftClient) error {
+				var err error
+				stream, err = client.Watch(ctx, &nase.WatchRequest{
+					Key:    key,
+					Prefix: prefix,
+				})
+				return err
+			})
+
+			if err != nil {
+				log.Err(err).Msgf("NaSe: could not watch key %s", key)
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(retryInterval):
+				}
+
+				continue
+			}
+
+			for {
+				res, err := stream.Recv()
+
+				if err != nil {
+					if ctx.Err() == nil {
+						log.Warn().Msgf("NaSe: watching key %s was interrupted, changes may have been missed: %s", key, err.Error())
+					}
+					break
+				}
+
+				events := make([]etcdnase.Event, len(res.Events))
+
+				for i, ev := range res.Events {
+					events[i] = etcdnase.Event{
+						Key:     ev.Key,
+						Value:   ev.Value,
+						Deleted: ev.Deleted,
+					}
+				}
+
+				select {
+				case out <- events:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+// renew sets a key and renews its lease.
+func (c *Client) renew(ctx context.Context, key string, value string, ttl time.Duration) error {
+	return c.do(func(client nase.RaftClient) error {
+		_, err := client.Lease(ctx, &nase.LeaseRequest{
+			Key:   key,
+			Value: value,
+			Ttl:   ttl.Milliseconds(),
+		})
+		return err
+	})
+}
+
+// Lease sets a key and keeps it set until ctx is canceled or the client is closed. If this node stops renewing the
+// lease, e.g. because it crashed, the key is removed after ttl.
+func (c *Client) Lease(ctx context.Context, key string, value string, ttl time.Duration) error {
+	rctx, cncl := context.WithTimeout(ctx, leaseTimeout)
+	defer cncl()
+
+	if err := c.renew(rctx, key, value, ttl); err != nil {
+		return err
+	}
+
+	go keepAlive(ctx, c.stop, key, value, ttl, c.renew, c.Delete)
+
+	return nil
+}
+
+// acquire sets a key with a lease if it is not held with another value and returns whether it is held with ours.
+func (c *Client) acquire(ctx context.Context, key string, value string, ttl time.Duration) (bool, error) {
+	var held bool
+
+	err := c.do(func(client nase.RaftClient) error {
+		res, err := client.Acquire(ctx, &nase.LeaseRequest{
+			Key:   key,
+			Value: value,
+			Ttl:   ttl.Milliseconds(),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		held = res.Held
+		return nil
+	})
+
+	return held, err
+}
+
+// Campaign blocks until this node holds the election key and keeps holding it until ctx is canceled or the client is
+// closed. The key is then left to expire.
+func (c *Client) Campaign(ctx context.Context, key string, value string, ttl time.Duration) (<-chan struct{}, error) {
+	return campaign(ctx, c.stop, key, value, ttl, c.acquire)
+}
+
+// Close closes the connections to all members.
+func (c *Client) Close() error {
+	c.once.Do(func() {
+		close(c.stop)
+	})
+
+	for _, conn := range c.conns {
+		if conn == nil {
+			continue
+		}
+
+		if err := conn.Close(); err != nil {
+			return errors.New(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,220 @@
+package raftnase
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/rs/zerolog/log"
+	"go.etcd.io/etcd/raft/v3"
+)
+
+const (
+	// leaseCheckInterval is how often the leader looks for leases that have expired.
+	leaseCheckInterval = 5 * tickInterval
+	// leaseTimeout is how long setting a leased key may take before Lease gives up.
+	leaseTimeout = 5 * time.Second
+)
+
+// lease keeps a key in the state only as long as it is renewed.
+type lease struct {
+	TTL time.Duration `json:"ttl"`
+	// Renewed is the index of the entry that last renewed the lease, so that a lease that is renewed while it is
+	// being expired is not removed.
+	Renewed uint64 `json:"renewed"`
+}
+
+// expire removes the keys whose leases have not been renewed within their time to live until the store is closed.
+// Only the leader does this so that the keys are removed once. A member that has just become leader does not know how
+// long ago the leases were renewed, so it gives them their full time to live again.
+func (s *Store) expire() {
+	t := time.NewTicker(leaseCheckInterval)
+	defer t.Stop()
+
+	leader := false
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-t.C:
+		}
+
+		if s.node.Status().RaftState != raft.StateLeader {
+			leader = false
+			continue
+		}
+
+		now := time.Now()
+		expired := make(map[string]uint64)
+
+		s.lock.Lock()
+		for k, l := range s.leases {
+			if !leader {
+				s.renewed[k] = now
+				continue
+			}
+
+			if now.Sub(s.renewed[k]) > l.TTL {
+				expired[k] = l.Renewed
+			}
+		}
+		s.lock.Unlock()
+
+		leader = true
+
+		if len(expired) == 0 {
+			continue
+		}
+
+		log.Debug().Msgf("NaSe: leases of %d keys have expired", len(expired))
+
+		ctx, cncl := context.WithTimeout(context.Background(), reproposeInterval)
+		err := s.propose(ctx, op{Expire: expired})
+		cncl()
+
+		if err != nil {
+			log.Debug().Msgf("NaSe: could not remove keys with expired leases: %s", err.Error())
+		}
+	}
+}
+
+// renew sets a key and renews its lease.
+func (s *Store) renew(ctx context.Context, key string, value string, ttl time.Duration) error {
+	return s.propose(ctx, op{Put: map[string]string{key: value}, TTL: ttl})
+}
+
+// acquire sets a key with a lease if it is not held with another value and returns whether it is held with ours.
+func (s *Store) acquire(ctx context.Context, key string, value string, ttl time.Duration) (bool, error) {
+	if err := s.propose(ctx, op{Put: map[string]string{key: value}, TTL: ttl, Acquire: true}); err != nil {
+		return false, err
+	}
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.kv[key] == value, nil
+}
+
+// Campaign blocks until this member holds the election key and keeps holding it until ctx is canceled or the store is
+// closed. The key is then left to expire.
+func (s *Store) Campaign(ctx context.Context, key string, value string, ttl time.Duration) (<-chan struct{}, error) {
+	return campaign(ctx, s.stop, key, value, ttl, s.acquire)
+}
+
+// Lease sets a key and keeps it set until ctx is canceled or the store is closed. If this member stops renewing the
+// lease, e.g. because it crashed, the leader removes the key after ttl.
+func (s *Store) Lease(ctx context.Context, key string, value string, ttl time.Duration) error {
+	rctx, cncl := context.WithTimeout(ctx, leaseTimeout)
+	defer cncl()
+
+	if err := s.renew(rctx, key, value, ttl); err != nil {
+		return err
+	}
+
+	go keepAlive(ctx, s.stop, key, value, ttl, s.renew, s.Delete)
+
+	return nil
+}
+
+// keepAlive renews a lease three times within its time to live until ctx is canceled, then removes the key. If stop
+// is closed first, the key is left to expire.
+func keepAlive(ctx context.Context, stop <-chan struct{}, key string, value string, ttl time.Duration, renew func(ctx context.Context, key string, value string, ttl time.Duration) error, remove func(ctx context.Context, key string, prefix bool) error) {
+	t := time.NewTicker(ttl / 3)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ctx.Done():
+			dctx, cncl := context.WithTimeout(context.Background(), ttl)
+			defer cncl()
+
+			if err := remove(dctx, key, false); err != nil {
+				log.Debug().Msgf("NaSe: could not remove leased key %s: %s", key, err.Error())
+			}
+
+			return
+		case <-t.C:
+		}
+
+		rctx, cncl := context.WithTimeout(ctx, ttl/3)
+		err := renew(rctx, key, value, ttl)
+		cncl()
+
+		if err != nil && ctx.Err() == nil {
+			log.Warn().Msgf("NaSe: could not renew lease for key %s: %s", key, err.Error())
+		}
+	}
+}
+
+// campaign tries to acquire an election key until it holds it, then renews it three times within its time to live.
+// The returned channel is closed once ctx is canceled, stop is closed, or the key may have been lost, which is when
+// another node acquired it or when it could not be renewed for half of its time to live.
+func campaign(ctx context.Context, stop <-chan struct{}, key string, value string, ttl time.Duration, acquire func(ctx context.Context, key string, value string, ttl time.Duration) (bool, error)) (<-chan struct{}, error) {
+	for {
+		actx, cncl := context.WithTimeout(ctx, leaseTimeout)
+		held, err := acquire(actx, key, value, ttl)
+		cncl()
+
+		if err == nil && held {
+			break
+		}
+
+		if err != nil && ctx.Err() == nil {
+			log.Debug().Msgf("NaSe: could not campaign for %s: %s", key, err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, errors.New(ctx.Err())
+		case <-stop:
+			return nil, errors.Errorf("embedded NaSe is closed")
+		case <-time.After(ttl / 3):
+		}
+	}
+
+	lost := make(chan struct{})
+
+	go func() {
+		defer close(lost)
+
+		t := time.NewTicker(ttl / 3)
+		defer t.Stop()
+
+		renewed := time.Now()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ctx.Done():
+				return
+			case <-t.C:
+			}
+
+			actx, cncl := context.WithTimeout(ctx, ttl/3)
+			held, err := acquire(actx, key, value, ttl)
+			cncl()
+
+			if err != nil {
+				log.Warn().Msgf("NaSe: could not renew election key %s: %s", key, err.Error())
+
+				if time.Since(renewed) > ttl/2 {
+					return
+				}
+
+				continue
+			}
+
+			if !held {
+				return
+			}
+
+			renewed = time.Now()
+		}
+	}()
+
+	return lost, nil
+}
@@ -0,0 +1,58 @@
+package raftnase
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+// logger passes the log messages of the raft library on to zerolog.
+type logger struct{}
+
+func (logger) Debug(v ...interface{}) {
+	log.Debug().Msg("raft: " + fmt.Sprint(v...))
+}
+
+func (logger) Debugf(format string, v ...interface{}) {
+	log.Debug().Msgf("raft: "+format, v...)
+}
+
+func (logger) Error(v ...interface{}) {
+	log.Error().Msg("raft: " + fmt.Sprint(v...))
+}
+
+func (logger) Errorf(format string, v ...interface{}) {
+	log.Error().Msgf("raft: "+format, v...)
+}
+
+func (logger) Info(v ...interface{}) {
+	log.Info().Msg("raft: " + fmt.Sprint(v...))
+}
+
+func (logger) Infof(format string, v ...interface{}) {
+	log.Info().Msgf("raft: "+format, v...)
+}
+
+func (logger) Warning(v ...interface{}) {
+	log.Warn().Msg("raft: " + fmt.Sprint(v...))
+}
+
+func (logger) Warningf(format string, v ...interface{}) {
+	log.Warn().Msgf("raft: "+format, v...)
+}
+
+func (logger) Fatal(v ...interface{}) {
+	log.Fatal().Msg("raft: " + fmt.Sprint(v...))
+}
+
+func (logger) Fatalf(format string, v ...interface{}) {
+	log.Fatal().Msgf("raft: "+format, v...)
+}
+
+func (logger) Panic(v ...interface{}) {
+	log.Panic().Msg("raft: " + fmt.Sprint(v...))
+}
+
+func (logger) Panicf(format string, v ...interface{}) {
+	log.Panic().Msgf("raft: "+format, v...)
+}
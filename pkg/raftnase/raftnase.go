@@ -0,0 +1,624 @@
+package raftnase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/etcdnase"
+	"github.com/go-errors/errors"
+	"github.com/rs/zerolog/log"
+	"go.etcd.io/etcd/raft/v3"
+	"go.etcd.io/etcd/raft/v3/raftpb"
+)
+
+const (
+	// tickInterval is the length of a raft tick. Leaders send heartbeats every tick and followers start an election
+	// after electionTicks ticks without hearing from the leader.
+	tickInterval  = 100 * time.Millisecond
+	electionTicks = 10
+	// snapshotEntries is the number of entries after which the state is snapshotted and the log is compacted,
+	// snapshotKeep entries are kept for members that lag behind.
+	snapshotEntries = 1000
+	snapshotKeep    = 100
+	// retryInterval is how often proposals and reads are retried while there is no leader.
+	retryInterval = 200 * time.Millisecond
+	// reproposeInterval is how long to wait for a proposal to be applied before it is proposed again, as proposals
+	// that are forwarded to a leader that fails are lost.
+	reproposeInterval = electionTicks * tickInterval
+	// recentOps is the number of applied proposals that are remembered so that proposals that are made again are only
+	// applied once.
+	recentOps = 10000
+)
+
+// op is a change to the state that is agreed on through raft.
+type op struct {
+	ID     uint64            `json:"id"`
+	Put    map[string]string `json:"put,omitempty"`
+	Delete *string           `json:"delete,omitempty"`
+	Prefix bool              `json:"prefix,omitempty"`
+	// TTL is set if the keys of Put are leased, putting them again renews the lease
+	TTL time.Duration `json:"ttl,omitempty"`
+	// Acquire is set if the keys of Put are only set if they are not set yet or already have the same value
+	Acquire bool `json:"acquire,omitempty"`
+	// Expire has the keys whose leases have expired, with the index at which each was last renewed
+	Expire map[string]uint64 `json:"expire,omitempty"`
+}
+
+// state is what a snapshot contains.
+type state struct {
+	KV     map[string]string `json:"kv"`
+	Recent []uint64          `json:"recent"`
+	Leases map[string]lease  `json:"leases,omitempty"`
+}
+
+// Store is a key-value store that is replicated across a fixed group of FReD nodes with raft, so that the NaSe can run
+// without an external etcd. Writes and reads are linearizable, like those of etcd. It implements etcdnase.KV.
+type Store struct {
+	id        uint64
+	node      raft.Node
+	storage   *storage
+	transport *transport
+
+	// lock protects the state machine, renewed is when this member last saw each lease renewed and is not replicated
+	lock      sync.RWMutex
+	kv        map[string]string
+	leases    map[string]lease
+	renewed   map[string]time.Time
+	recent    map[uint64]struct{}
+	order     []uint64
+	applied   uint64
+	snapshot  uint64
+	confState raftpb.ConfState
+	// appliedCh is closed whenever new entries are applied
+	appliedCh chan struct{}
+
+	waitLock sync.Mutex
+	pending  map[uint64]chan struct{}
+	reads    map[string]chan uint64
+
+	watchLock sync.Mutex
+	watchers  map[*watcher]struct{}
+
+	stop chan struct{}
+	done chan struct{}
+	once sync.Once
+}
+
+// New starts this node as a member of an embedded NaSe. Peers are the addresses of all members in the same order on
+// every member, host is the address of this member and has to be one of them. The state of the member is persisted
+// in a BadgerDB database at path.
+func New(host string, peers []string, path string, certFile string, keyFile string, caFile string, skipVerify bool) (*Store, error) {
+	var id uint64
+
+	for i, p := range peers {
+		if p == host {
+			id = uint64(i + 1)
+		}
+	}
+
+	if id == 0 {
+		return nil, errors.Errorf("%s is not one of the members of the embedded NaSe %v", host, peers)
+	}
+
+	st, existing, err := newStorage(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Store{
+		id:        id,
+		storage:   st,
+		kv:        make(map[string]string),
+		leases:    make(map[string]lease),
+		renewed:   make(map[string]time.Time),
+		recent:    make(map[uint64]struct{}),
+		order:     make([]uint64, 0),
+		appliedCh: make(chan struct{}),
+		pending:   make(map[uint64]chan struct{}),
+		reads:     make(map[string]chan uint64),
+		watchers:  make(map[*watcher]struct{}),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+
+	snap, err := st.Snapshot()
+
+	if err != nil {
+		_ = st.close()
+		return nil, errors.New(err)
+	}
+
+	if !raft.IsEmptySnap(snap) {
+		if err := s.restore(snap); err != nil {
+			_ = st.close()
+			return nil, err
+		}
+	}
+
+	s.transport, err = newTransport(s, host, peers, certFile, keyFile, caFile, skipVerify)
+
+	if err != nil {
+		_ = st.close()
+		return nil, err
+	}
+
+	c := &raft.Config{
+		ID:              id,
+		ElectionTick:    electionTicks,
+		HeartbeatTick:   1,
+		Storage:         st,
+		Applied:         s.applied,
+		MaxSizePerMsg:   1024 * 1024,
+		MaxInflightMsgs: 256,
+		CheckQuorum:     true,
+		PreVote:         true,
+		Logger:          logger{},
+	}
+
+	if existing {
+		log.Info().Msgf("NaSe: restarting member %d of embedded NaSe from %s", id, path)
+		s.node = raft.RestartNode(c)
+	} else {
+		members := make([]raft.Peer, len(peers))
+
+		for i := range peers {
+			members[i] = raft.Peer{ID: uint64(i + 1)}
+		}
+
+		log.Info().Msgf("NaSe: starting member %d of new embedded NaSe with %d members", id, len(peers))
+		s.node = raft.StartNode(c, members)
+	}
+
+	go s.run()
+	go s.expire()
+
+	return s, nil
+}
+
+// run hands ticks to raft and processes everything that raft is ready with until the store is closed.
+func (s *Store) run() {
+	defer close(s.done)
+
+	t := time.NewTicker(tickInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			s.node.Stop()
+			return
+		case <-t.C:
+			s.node.Tick()
+		case rd := <-s.node.Ready():
+			if err := s.storage.save(rd.HardState, rd.Entries, rd.Snapshot); err != nil {
+				log.Fatal().Msgf("NaSe: could not persist raft state: %s", err.(*errors.Error).ErrorStack())
+			}
+
+			s.transport.send(rd.Messages)
+
+			if !raft.IsEmptySnap(rd.Snapshot) {
+				if err := s.restore(rd.Snapshot); err != nil {
+					log.Fatal().Msgf("NaSe: could not apply raft snapshot: %s", err.(*errors.Error).ErrorStack())
+				}
+			}
+
+			for _, e := range rd.CommittedEntries {
+				s.applyEntry(e)
+			}
+
+			for _, rs := range rd.ReadStates {
+				s.waitLock.Lock()
+				if ch, ok := s.reads[string(rs.RequestCtx)]; ok {
+					select {
+					case ch <- rs.Index:
+					default:
+					}
+				}
+				s.waitLock.Unlock()
+			}
+
+			s.maybeSnapshot()
+
+			s.node.Advance()
+		}
+	}
+}
+
+// applyEntry applies a committed raft entry to the state machine.
+func (s *Store) applyEntry(e raftpb.Entry) {
+	switch e.Type {
+	case raftpb.EntryNormal:
+		if len(e.Data) == 0 {
+			// new leaders commit an empty entry
+			break
+		}
+
+		var o op
+
+		if err := json.Unmarshal(e.Data, &o); err != nil {
+			log.Err(err).Msgf("NaSe: could not read entry %d", e.Index)
+			break
+		}
+
+		s.apply(o, e.Index)
+
+		s.waitLock.Lock()
+		if ch, ok := s.pending[o.ID]; ok {
+			close(ch)
+			delete(s.pending, o.ID)
+		}
+		s.waitLock.Unlock()
+	case raftpb.EntryConfChange:
+		var cc raftpb.ConfChange
+
+		if err := cc.Unmarshal(e.Data); err != nil {
+			log.Err(err).Msgf("NaSe: could not read configuration change %d", e.Index)
+			break
+		}
+
+		cs := s.node.ApplyConfChange(cc)
+
+		s.lock.Lock()
+		s.confState = *cs
+		s.lock.Unlock()
+	}
+
+	s.lock.Lock()
+	s.applied = e.Index
+	close(s.appliedCh)
+	s.appliedCh = make(chan struct{})
+	s.lock.Unlock()
+}
+
+// apply changes the state machine and tells the watchers about it. Proposals that have been applied before are
+// skipped. Renewing a lease without changing the value of its key is not a change that watchers are told about.
+func (s *Store) apply(o op, index uint64) {
+	events := make([]etcdnase.Event, 0)
+
+	s.lock.Lock()
+
+	if _, ok := s.recent[o.ID]; ok {
+		s.lock.Unlock()
+		return
+	}
+
+	s.recent[o.ID] = struct{}{}
+	s.order = append(s.order, o.ID)
+
+	if len(s.order) > recentOps {
+		delete(s.recent, s.order[0])
+		s.order = s.order[1:]
+	}
+
+	if o.Delete != nil {
+		for k := range s.kv {
+			if k == *o.Delete || (o.Prefix && strings.HasPrefix(k, *o.Delete)) {
+				delete(s.kv, k)
+				delete(s.leases, k)
+				delete(s.renewed, k)
+				events = append(events, etcdnase.Event{Key: k, Deleted: true})
+			}
+		}
+	}
+
+	// a lease that was renewed after it was found to be expired stays
+	for k, renewed := range o.Expire {
+		if l, ok := s.leases[k]; ok && l.Renewed == renewed {
+			delete(s.kv, k)
+			delete(s.leases, k)
+			delete(s.renewed, k)
+			events = append(events, etcdnase.Event{Key: k, Deleted: true})
+		}
+	}
+
+	for k, v := range o.Put {
+		old, ok := s.kv[k]
+
+		if o.Acquire && ok && old != v {
+			continue
+		}
+
+		s.kv[k] = v
+
+		if o.TTL > 0 {
+			s.leases[k] = lease{TTL: o.TTL, Renewed: index}
+			s.renewed[k] = time.Now()
+
+			if ok && old == v {
+				continue
+			}
+		} else {
+			delete(s.leases, k)
+			delete(s.renewed, k)
+		}
+
+		events = append(events, etcdnase.Event{Key: k, Value: v})
+	}
+
+	s.lock.Unlock()
+
+	s.notify(events)
+}
+
+// restore replaces the state machine with a snapshot. Watchers are told about every key that is different.
+func (s *Store) restore(snap raftpb.Snapshot) error {
+	var st state
+
+	if err := json.Unmarshal(snap.Data, &st); err != nil {
+		return errors.New(err)
+	}
+
+	kv := st.KV
+
+	if kv == nil {
+		kv = make(map[string]string)
+	}
+
+	events := make([]etcdnase.Event, 0)
+
+	s.lock.Lock()
+
+	for k := range s.kv {
+		if _, ok := kv[k]; !ok {
+			events = append(events, etcdnase.Event{Key: k, Deleted: true})
+		}
+	}
+
+	for k, v := range kv {
+		if old, ok := s.kv[k]; !ok || old != v {
+			events = append(events, etcdnase.Event{Key: k, Value: v})
+		}
+	}
+
+	s.kv = kv
+	s.leases = st.Leases
+
+	if s.leases == nil {
+		s.leases = make(map[string]lease)
+	}
+
+	// we don't know when the leases were last renewed, so they get their full time to live from now on
+	s.renewed = make(map[string]time.Time, len(s.leases))
+
+	for k := range s.leases {
+		s.renewed[k] = time.Now()
+	}
+
+	s.recent = make(map[uint64]struct{}, len(st.Recent))
+	s.order = st.Recent
+
+	for _, id := range st.Recent {
+		s.recent[id] = struct{}{}
+	}
+
+	s.applied = snap.Metadata.Index
+	s.snapshot = snap.Metadata.Index
+	s.confState = snap.Metadata.ConfState
+	close(s.appliedCh)
+	s.appliedCh = make(chan struct{})
+
+	s.lock.Unlock()
+
+	s.notify(events)
+
+	return nil
+}
+
+// maybeSnapshot snapshots the state machine once enough entries have been applied since the last snapshot.
+func (s *Store) maybeSnapshot() {
+	s.lock.RLock()
+
+	if s.applied-s.snapshot < snapshotEntries {
+		s.lock.RUnlock()
+		return
+	}
+
+	applied := s.applied
+	cs := s.confState
+	data, err := json.Marshal(state{
+		KV:     s.kv,
+		Recent: s.order,
+		Leases: s.leases,
+	})
+
+	s.lock.RUnlock()
+
+	if err != nil {
+		log.Err(err).Msg("NaSe: could not snapshot state")
+		return
+	}
+
+	if err := s.storage.snapshot(applied, cs, data, snapshotKeep); err != nil {
+		log.Err(err).Msg("NaSe: could not snapshot state")
+		return
+	}
+
+	s.lock.Lock()
+	s.snapshot = applied
+	s.lock.Unlock()
+
+	log.Debug().Msgf("NaSe: snapshotted state at index %d", applied)
+}
+
+// randomID returns a random identifier for proposals and reads.
+func randomID() uint64 {
+	var b [8]byte
+	_, _ = rand.Read(b[:])
+	return binary.BigEndian.Uint64(b[:])
+}
+
+// propose proposes a change to the state and waits until it has been applied on this member. If that takes too long,
+// the change is proposed again.
+func (s *Store) propose(ctx context.Context, o op) error {
+	o.ID = randomID()
+
+	data, err := json.Marshal(o)
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	ch := make(chan struct{})
+
+	s.waitLock.Lock()
+	s.pending[o.ID] = ch
+	s.waitLock.Unlock()
+
+	defer func() {
+		s.waitLock.Lock()
+		delete(s.pending, o.ID)
+		s.waitLock.Unlock()
+	}()
+
+	for {
+		wait := reproposeInterval
+
+		err := s.node.Propose(ctx, data)
+
+		// proposals are dropped while there is no leader
+		if err == raft.ErrProposalDropped {
+			wait = retryInterval
+		} else if err != nil {
+			return errors.New(err)
+		}
+
+		select {
+		case <-ch:
+			return nil
+		case <-ctx.Done():
+			return errors.New(ctx.Err())
+		case <-time.After(wait):
+		}
+	}
+}
+
+// linearize waits until this member has applied every change that was committed before it was called, so that reads
+// from its state machine see them.
+func (s *Store) linearize(ctx context.Context) error {
+	var rctx [8]byte
+	binary.BigEndian.PutUint64(rctx[:], randomID())
+
+	ch := make(chan uint64, 1)
+
+	s.waitLock.Lock()
+	s.reads[string(rctx[:])] = ch
+	s.waitLock.Unlock()
+
+	defer func() {
+		s.waitLock.Lock()
+		delete(s.reads, string(rctx[:]))
+		s.waitLock.Unlock()
+	}()
+
+	var index uint64
+
+	// read requests are dropped silently while there is no leader, so we ask again until we get an answer
+	for index == 0 {
+		if err := s.node.ReadIndex(ctx, rctx[:]); err != nil {
+			return errors.New(err)
+		}
+
+		select {
+		case index = <-ch:
+		case <-ctx.Done():
+			return errors.New(ctx.Err())
+		case <-time.After(retryInterval):
+		}
+	}
+
+	for {
+		s.lock.RLock()
+		applied := s.applied
+		next := s.appliedCh
+		s.lock.RUnlock()
+
+		if applied >= index {
+			return nil
+		}
+
+		select {
+		case <-next:
+		case <-ctx.Done():
+			return errors.New(ctx.Err())
+		}
+	}
+}
+
+// Get returns the value of a key or of all keys that start with it.
+func (s *Store) Get(ctx context.Context, key string, prefix bool) (map[string]string, error) {
+	if err := s.linearize(ctx); err != nil {
+		return nil, err
+	}
+
+	kv := make(map[string]string)
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if !prefix {
+		if v, ok := s.kv[key]; ok {
+			kv[key] = v
+		}
+
+		return kv, nil
+	}
+
+	for k, v := range s.kv {
+		if strings.HasPrefix(k, key) {
+			kv[k] = v
+		}
+	}
+
+	return kv, nil
+}
+
+// Put stores a value for a key.
+func (s *Store) Put(ctx context.Context, key string, value string) error {
+	return s.propose(ctx, op{Put: map[string]string{key: value}})
+}
+
+// PutAll stores several keys at once.
+func (s *Store) PutAll(ctx context.Context, kv map[string]string) error {
+	return s.propose(ctx, op{Put: kv})
+}
+
+// Delete removes a key or all keys that start with it.
+func (s *Store) Delete(ctx context.Context, key string, prefix bool) error {
+	return s.propose(ctx, op{Delete: &key, Prefix: prefix})
+}
+
+// Close stops this member. The other members carry on as long as a majority of them is left.
+func (s *Store) Close() error {
+	closed := false
+
+	s.once.Do(func() {
+		close(s.stop)
+		closed = true
+	})
+
+	if !closed {
+		return nil
+	}
+
+	<-s.done
+
+	s.transport.close()
+
+	s.watchLock.Lock()
+	for w := range s.watchers {
+		w.cancel()
+	}
+	s.watchLock.Unlock()
+
+	if err := s.storage.close(); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,310 @@
+package raftnase
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/etcdnase"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	certBasePath = "../../tests/certificates/"
+)
+
+// freeAddress returns a local address that nothing listens on.
+func freeAddress(t *testing.T) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addr := lis.Addr().String()
+	_ = lis.Close()
+
+	return addr
+}
+
+func start(t *testing.T, host string, peers []string, path string) *Store {
+	s, err := New(host, peers, path, certBasePath+"nodeA.crt", certBasePath+"nodeA.key", certBasePath+"ca.crt", false)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s
+}
+
+func TestStore(t *testing.T) {
+	peers := []string{freeAddress(t), freeAddress(t), freeAddress(t)}
+	paths := []string{t.TempDir(), t.TempDir(), t.TempDir()}
+
+	stores := make([]*Store, len(peers))
+
+	for i := range peers {
+		stores[i] = start(t, peers[i], peers, paths[i])
+	}
+
+	defer func() {
+		for _, s := range stores {
+			assert.NoError(t, s.Close())
+		}
+	}()
+
+	ctx, cncl := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cncl()
+
+	w := stores[1].Watch(ctx, "kg|a|", true)
+
+	// writes on one member can be read on all others right away
+	assert.NoError(t, stores[0].Put(ctx, "kg|a|status", "created"))
+
+	kv, err := stores[2].Get(ctx, "kg|a|status", false)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"kg|a|status": "created"}, kv)
+
+	assert.NoError(t, stores[2].Put(ctx, "kg|a|node|X", "ok"))
+	assert.NoError(t, stores[1].Put(ctx, "kg|b|status", "created"))
+
+	kv, err = stores[0].Get(ctx, "kg|a|", true)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"kg|a|status": "created", "kg|a|node|X": "ok"}, kv)
+
+	assert.NoError(t, stores[0].Delete(ctx, "kg|a|", true))
+
+	kv, err = stores[1].Get(ctx, "", true)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"kg|b|status": "created"}, kv)
+
+	// watchers get the changes to their prefix in order, but not those to other keys
+	events := make([]etcdnase.Event, 0)
+
+	for len(events) < 4 {
+		select {
+		case e := <-w:
+			events = append(events, e...)
+		case <-ctx.Done():
+			t.Fatal("missing watch events")
+		}
+	}
+
+	assert.Equal(t, etcdnase.Event{Key: "kg|a|status", Value: "created"}, events[0])
+	assert.Equal(t, etcdnase.Event{Key: "kg|a|node|X", Value: "ok"}, events[1])
+	assert.ElementsMatch(t, []etcdnase.Event{{Key: "kg|a|status", Deleted: true}, {Key: "kg|a|node|X", Deleted: true}}, events[2:])
+
+	// the others carry on without one member, which catches up once it is back
+	assert.NoError(t, stores[2].Close())
+
+	// FReD nodes that are not members can use any member that is left
+	c, err := NewClient(peers, certBasePath+"nodeB.crt", certBasePath+"nodeB.key", certBasePath+"ca.crt", false)
+	assert.NoError(t, err)
+
+	cw := c.Watch(ctx, "node|Y|", true)
+
+	assert.NoError(t, c.PutAll(ctx, map[string]string{"node|X|address": "1.2.3.4:5555", "node|Y|address": "1.2.3.5:5555"}))
+
+	kv, err = c.Get(ctx, "node|X|address", false)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"node|X|address": "1.2.3.4:5555"}, kv)
+
+	select {
+	case e := <-cw:
+		assert.Equal(t, []etcdnase.Event{{Key: "node|Y|address", Value: "1.2.3.5:5555"}}, e)
+	case <-ctx.Done():
+		t.Fatal("missing watch events")
+	}
+
+	assert.NoError(t, c.Close())
+
+	stores[2] = start(t, peers[2], peers, paths[2])
+
+	kv, err = stores[2].Get(ctx, "node|", true)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"node|X|address": "1.2.3.4:5555", "node|Y|address": "1.2.3.5:5555"}, kv)
+
+	kv, err = stores[2].Get(ctx, "kg|", true)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"kg|b|status": "created"}, kv)
+}
+
+func TestNotMember(t *testing.T) {
+	_, err := New("127.0.0.1:1", []string{"127.0.0.1:2"}, "", certBasePath+"nodeA.crt", certBasePath+"nodeA.key", certBasePath+"ca.crt", false)
+	assert.Error(t, err)
+}
+
+func TestSnapshot(t *testing.T) {
+	peers := []string{freeAddress(t)}
+	path := t.TempDir()
+
+	s := start(t, peers[0], peers, path)
+
+	ctx, cncl := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cncl()
+
+	// enough writes to snapshot the state and compact the log
+	for i := 0; i < snapshotEntries+snapshotKeep; i++ {
+		assert.NoError(t, s.Put(ctx, fmt.Sprintf("key|%d", i%10), fmt.Sprintf("%d", i)))
+	}
+
+	assert.NoError(t, s.Delete(ctx, "key|9", false))
+
+	first, err := s.storage.FirstIndex()
+	assert.NoError(t, err)
+	assert.Greater(t, first, uint64(1))
+
+	assert.NoError(t, s.Close())
+
+	s = start(t, peers[0], peers, path)
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	kv, err := s.Get(ctx, "key|", true)
+	assert.NoError(t, err)
+	assert.Len(t, kv, 9)
+	assert.Equal(t, fmt.Sprintf("%d", snapshotEntries+snapshotKeep-2), kv["key|8"])
+}
+
+func TestLease(t *testing.T) {
+	peers := []string{freeAddress(t), freeAddress(t), freeAddress(t)}
+
+	stores := make([]*Store, len(peers))
+
+	for i := range peers {
+		stores[i] = start(t, peers[i], peers, t.TempDir())
+	}
+
+	defer func() {
+		for _, s := range stores {
+			assert.NoError(t, s.Close())
+		}
+	}()
+
+	ctx, cncl := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cncl()
+
+	w := stores[1].Watch(ctx, "node|", true)
+
+	c, err := NewClient(peers, certBasePath+"nodeB.crt", certBasePath+"nodeB.key", certBasePath+"ca.crt", false)
+	assert.NoError(t, err)
+
+	defer func() {
+		assert.NoError(t, c.Close())
+	}()
+
+	lctx, lcncl := context.WithCancel(ctx)
+
+	assert.NoError(t, stores[0].Lease(ctx, "node|X|alive", "true", time.Second))
+	assert.NoError(t, c.Lease(lctx, "node|Y|alive", "true", time.Second))
+
+	// renewing the leases does not remove the keys or bother the watchers
+	time.Sleep(3 * time.Second)
+
+	kv, err := stores[2].Get(ctx, "node|", true)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"node|X|alive": "true", "node|Y|alive": "true"}, kv)
+
+	events := make([]etcdnase.Event, 0)
+
+	for len(events) < 2 {
+		select {
+		case e := <-w:
+			events = append(events, e...)
+		case <-ctx.Done():
+			t.Fatal("missing watch events")
+		}
+	}
+
+	assert.ElementsMatch(t, []etcdnase.Event{{Key: "node|X|alive", Value: "true"}, {Key: "node|Y|alive", Value: "true"}}, events)
+
+	// a lease that is given up removes its key
+	lcncl()
+
+	select {
+	case e := <-w:
+		assert.Equal(t, []etcdnase.Event{{Key: "node|Y|alive", Deleted: true}}, e)
+	case <-ctx.Done():
+		t.Fatal("missing watch events")
+	}
+
+	// a lease that is no longer renewed expires, even if its holder was the leader
+	assert.NoError(t, stores[0].Close())
+
+	select {
+	case e := <-w:
+		assert.Equal(t, []etcdnase.Event{{Key: "node|X|alive", Deleted: true}}, e)
+	case <-ctx.Done():
+		t.Fatal("missing watch events")
+	}
+
+	kv, err = stores[2].Get(ctx, "node|", true)
+	assert.NoError(t, err)
+	assert.Empty(t, kv)
+}
+
+func TestCampaign(t *testing.T) {
+	peers := []string{freeAddress(t), freeAddress(t), freeAddress(t)}
+
+	stores := make([]*Store, len(peers))
+
+	for i := range peers {
+		stores[i] = start(t, peers[i], peers, t.TempDir())
+	}
+
+	defer func() {
+		for _, s := range stores {
+			assert.NoError(t, s.Close())
+		}
+	}()
+
+	ctx, cncl := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cncl()
+
+	actx, acncl := context.WithCancel(ctx)
+
+	lostA, err := stores[0].Campaign(actx, "election|test", "A", time.Second)
+	assert.NoError(t, err)
+
+	// only one node is elected at a time
+	elected := make(chan struct{})
+
+	go func() {
+		defer close(elected)
+
+		_, err := stores[1].Campaign(ctx, "election|test", "B", time.Second)
+		assert.NoError(t, err)
+	}()
+
+	select {
+	case <-elected:
+		t.Fatal("B was elected while A is")
+	case <-time.After(3 * time.Second):
+	}
+
+	kv, err := stores[2].Get(ctx, "election|test", false)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"election|test": "A"}, kv)
+
+	// once A gives up, its key expires and B is elected
+	acncl()
+
+	select {
+	case <-lostA:
+	case <-ctx.Done():
+		t.Fatal("A was not told that it is no longer elected")
+	}
+
+	select {
+	case <-elected:
+	case <-ctx.Done():
+		t.Fatal("B was not elected")
+	}
+
+	kv, err = stores[2].Get(ctx, "election|test", false)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"election|test": "B"}, kv)
+}
@@ -0,0 +1,278 @@
+package raftnase
+
+import (
+	"encoding/binary"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/go-errors/errors"
+	"go.etcd.io/etcd/raft/v3"
+	"go.etcd.io/etcd/raft/v3/raftpb"
+)
+
+const (
+	hardStateKey   = "hardstate"
+	snapshotKey    = "snapshot"
+	entryKeyPrefix = "entry|"
+)
+
+// makeEntryKey creates the BadgerDB key for a log entry. The index is stored in big endian so that entries are
+// iterated in order.
+func makeEntryKey(index uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte(entryKeyPrefix), index)
+}
+
+// storage keeps the raft log, hard state and latest snapshot of a member in a BadgerDB database so that they survive a
+// restart. The raft library reads them from the embedded MemoryStorage.
+type storage struct {
+	*raft.MemoryStorage
+	db *badger.DB
+}
+
+// newStorage opens the storage at the given path, an empty path keeps everything in memory. It also returns whether
+// there was any state from an earlier run.
+func newStorage(path string) (*storage, bool, error) {
+	opts := badger.DefaultOptions(path).WithLoggingLevel(badger.ERROR)
+
+	if path == "" {
+		opts = opts.WithInMemory(true)
+	}
+
+	db, err := badger.Open(opts)
+
+	if err != nil {
+		return nil, false, errors.New(err)
+	}
+
+	s := &storage{
+		MemoryStorage: raft.NewMemoryStorage(),
+		db:            db,
+	}
+
+	existing, err := s.load()
+
+	if err != nil {
+		_ = db.Close()
+		return nil, false, err
+	}
+
+	return s, existing, nil
+}
+
+// load reads the snapshot, hard state and entries from the database into memory.
+func (s *storage) load() (bool, error) {
+	var snap raftpb.Snapshot
+	var hs raftpb.HardState
+	entries := make([]raftpb.Entry, 0)
+
+	err := s.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte(snapshotKey))
+
+		if err == nil {
+			err = item.Value(snap.Unmarshal)
+		}
+
+		if err != nil && err != badger.ErrKeyNotFound {
+			return err
+		}
+
+		item, err = txn.Get([]byte(hardStateKey))
+
+		if err == nil {
+			err = item.Value(hs.Unmarshal)
+		}
+
+		if err != nil && err != badger.ErrKeyNotFound {
+			return err
+		}
+
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = []byte(entryKeyPrefix)
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Seek(makeEntryKey(snap.Metadata.Index + 1)); it.ValidForPrefix(opts.Prefix); it.Next() {
+			var e raftpb.Entry
+
+			if err := it.Item().Value(e.Unmarshal); err != nil {
+				return err
+			}
+
+			entries = append(entries, e)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return false, errors.New(err)
+	}
+
+	if !raft.IsEmptySnap(snap) {
+		if err := s.MemoryStorage.ApplySnapshot(snap); err != nil {
+			return false, errors.New(err)
+		}
+	}
+
+	if !raft.IsEmptyHardState(hs) {
+		if err := s.MemoryStorage.SetHardState(hs); err != nil {
+			return false, errors.New(err)
+		}
+	}
+
+	if err := s.MemoryStorage.Append(entries); err != nil {
+		return false, errors.New(err)
+	}
+
+	return !raft.IsEmptySnap(snap) || !raft.IsEmptyHardState(hs) || len(entries) > 0, nil
+}
+
+// save persists what the raft library hands over in a Ready and then makes it available to the library. Entries
+// replace all entries with the same or a higher index.
+func (s *storage) save(hs raftpb.HardState, entries []raftpb.Entry, snap raftpb.Snapshot) error {
+	err := s.db.Update(func(txn *badger.Txn) error {
+		if !raft.IsEmptySnap(snap) {
+			b, err := snap.Marshal()
+
+			if err != nil {
+				return err
+			}
+
+			if err := txn.Set([]byte(snapshotKey), b); err != nil {
+				return err
+			}
+
+			if err := deleteEntries(txn, 0, snap.Metadata.Index+1); err != nil {
+				return err
+			}
+		}
+
+		if !raft.IsEmptyHardState(hs) {
+			b, err := hs.Marshal()
+
+			if err != nil {
+				return err
+			}
+
+			if err := txn.Set([]byte(hardStateKey), b); err != nil {
+				return err
+			}
+		}
+
+		for _, e := range entries {
+			b, err := e.Marshal()
+
+			if err != nil {
+				return err
+			}
+
+			if err := txn.Set(makeEntryKey(e.Index), b); err != nil {
+				return err
+			}
+		}
+
+		if len(entries) > 0 {
+			// a new leader may have replaced our uncommitted entries with a shorter log
+			return deleteEntries(txn, entries[len(entries)-1].Index+1, 0)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	if !raft.IsEmptySnap(snap) {
+		if err := s.MemoryStorage.ApplySnapshot(snap); err != nil {
+			return errors.New(err)
+		}
+	}
+
+	if !raft.IsEmptyHardState(hs) {
+		if err := s.MemoryStorage.SetHardState(hs); err != nil {
+			return errors.New(err)
+		}
+	}
+
+	if err := s.MemoryStorage.Append(entries); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+// snapshot creates a snapshot of the state machine at the given index and removes all but the last keep entries
+// before it from the log.
+func (s *storage) snapshot(index uint64, cs raftpb.ConfState, data []byte, keep uint64) error {
+	snap, err := s.MemoryStorage.CreateSnapshot(index, &cs, data)
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	compact := uint64(1)
+
+	if index > keep {
+		compact = index - keep
+	}
+
+	err = s.db.Update(func(txn *badger.Txn) error {
+		b, err := snap.Marshal()
+
+		if err != nil {
+			return err
+		}
+
+		if err := txn.Set([]byte(snapshotKey), b); err != nil {
+			return err
+		}
+
+		return deleteEntries(txn, 0, compact)
+	})
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	if err := s.MemoryStorage.Compact(compact); err != nil && err != raft.ErrCompacted {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+// deleteEntries removes the entries from index from up to but not including index to, 0 means all of them.
+func deleteEntries(txn *badger.Txn, from uint64, to uint64) error {
+	opts := badger.DefaultIteratorOptions
+	opts.Prefix = []byte(entryKeyPrefix)
+	opts.PrefetchValues = false
+
+	it := txn.NewIterator(opts)
+	defer it.Close()
+
+	keys := make([][]byte, 0)
+
+	for it.Seek(makeEntryKey(from)); it.ValidForPrefix(opts.Prefix); it.Next() {
+		key := it.Item().KeyCopy(nil)
+
+		if to != 0 && binary.BigEndian.Uint64(key[len(entryKeyPrefix):]) >= to {
+			break
+		}
+
+		keys = append(keys, key)
+	}
+
+	for _, key := range keys {
+		if err := txn.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// close closes the database.
+func (s *storage) close() error {
+	return s.db.Close()
+}
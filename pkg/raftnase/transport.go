@@ -0,0 +1,304 @@
+package raftnase
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"time"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/grpcutil"
+	"git.tu-berlin.de/mcc-fred/fred/proto/nase"
+	"github.com/go-errors/errors"
+	"github.com/rs/zerolog/log"
+	"go.etcd.io/etcd/raft/v3"
+	"go.etcd.io/etcd/raft/v3/raftpb"
+	"google.golang.org/grpc"
+)
+
+const (
+	// sendTimeout is how long sending messages to another member may take.
+	sendTimeout = time.Second
+	// queueSize is the number of batches of messages that are queued for each member before messages are dropped.
+	queueSize = 256
+)
+
+// peer is another member of the embedded NaSe that messages are sent to in order.
+type peer struct {
+	id     uint64
+	host   string
+	conn   *grpc.ClientConn
+	client nase.RaftClient
+	queue  chan []raftpb.Message
+}
+
+// transport exchanges raft messages between the members of an embedded NaSe over gRPC.
+type transport struct {
+	s      *Store
+	server *grpc.Server
+	peers  map[uint64]*peer
+	stop   chan struct{}
+}
+
+// newTransport starts the gRPC server of this member and connects to all others.
+func newTransport(s *Store, host string, peers []string, certFile string, keyFile string, caFile string, skipVerify bool) (*transport, error) {
+	serverCreds, _, err := grpcutil.GetCredsFromConfig(certFile, keyFile, []string{caFile}, false, skipVerify, &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert})
+
+	if err != nil {
+		return nil, errors.Errorf("error configuring certificates for the embedded NaSe: %v", err)
+	}
+
+	clientCreds, _, err := grpcutil.GetCreds(certFile, keyFile, []string{caFile}, false, skipVerify)
+
+	if err != nil {
+		return nil, errors.Errorf("error configuring certificates for the embedded NaSe: %v", err)
+	}
+
+	t := &transport{
+		s:      s,
+		server: grpc.NewServer(grpc.Creds(serverCreds)),
+		peers:  make(map[uint64]*peer),
+		stop:   make(chan struct{}),
+	}
+
+	for i, h := range peers {
+		id := uint64(i + 1)
+
+		if id == s.id {
+			continue
+		}
+
+		conn, err := grpc.Dial(h, grpc.WithTransportCredentials(clientCreds))
+
+		if err != nil {
+			t.close()
+			return nil, errors.New(err)
+		}
+
+		p := &peer{
+			id:     id,
+			host:   h,
+			conn:   conn,
+			client: nase.NewRaftClient(conn),
+			queue:  make(chan []raftpb.Message, queueSize),
+		}
+
+		t.peers[id] = p
+
+		go t.run(p)
+	}
+
+	lis, err := net.Listen("tcp", host)
+
+	if err != nil {
+		t.close()
+		return nil, errors.New(err)
+	}
+
+	nase.RegisterRaftServer(t.server, t)
+
+	log.Debug().Msgf("NaSe: embedded NaSe member is listening on %s", host)
+
+	go func() {
+		err := t.server.Serve(lis)
+
+		// if Serve returns without an error, we probably intentionally closed it
+		if err != nil {
+			log.Fatal().Msgf("NaSe: embedded NaSe member exited: %s", err.Error())
+		}
+	}()
+
+	return t, nil
+}
+
+// send queues messages for the members they are addressed to. If a member can't keep up, its messages are dropped,
+// which raft recovers from.
+func (t *transport) send(msgs []raftpb.Message) {
+	batches := make(map[uint64][]raftpb.Message)
+
+	for _, m := range msgs {
+		batches[m.To] = append(batches[m.To], m)
+	}
+
+	for to, batch := range batches {
+		p, ok := t.peers[to]
+
+		if !ok {
+			log.Warn().Msgf("NaSe: no embedded NaSe member with id %d", to)
+			continue
+		}
+
+		select {
+		case p.queue <- batch:
+		default:
+			t.failed(to, batch)
+		}
+	}
+}
+
+// run sends the queued messages to a member until the transport is closed.
+func (t *transport) run(p *peer) {
+	for {
+		select {
+		case <-t.stop:
+			return
+		case batch := <-p.queue:
+			req := &nase.Messages{
+				Messages: make([][]byte, 0, len(batch)),
+			}
+
+			for _, m := range batch {
+				b, err := m.Marshal()
+
+				if err != nil {
+					log.Err(err).Msg("NaSe: could not encode raft message")
+					continue
+				}
+
+				req.Messages = append(req.Messages, b)
+			}
+
+			ctx, cncl := context.WithTimeout(context.Background(), sendTimeout)
+			_, err := p.client.Send(ctx, req)
+			cncl()
+
+			if err != nil {
+				log.Debug().Msgf("NaSe: could not reach embedded NaSe member %d at %s: %s", p.id, p.host, err.Error())
+				t.failed(p.id, batch)
+				continue
+			}
+
+			for _, m := range batch {
+				if m.Type == raftpb.MsgSnap {
+					t.s.node.ReportSnapshot(p.id, raft.SnapshotFinish)
+				}
+			}
+		}
+	}
+}
+
+// failed tells raft that messages could not be delivered to a member.
+func (t *transport) failed(to uint64, batch []raftpb.Message) {
+	t.s.node.ReportUnreachable(to)
+
+	for _, m := range batch {
+		if m.Type == raftpb.MsgSnap {
+			t.s.node.ReportSnapshot(to, raft.SnapshotFailure)
+		}
+	}
+}
+
+// Send passes messages from another member on to raft.
+func (t *transport) Send(ctx context.Context, req *nase.Messages) (*nase.Empty, error) {
+	for _, b := range req.Messages {
+		var m raftpb.Message
+
+		if err := m.Unmarshal(b); err != nil {
+			return nil, errors.New(err)
+		}
+
+		if err := t.s.node.Step(ctx, m); err != nil {
+			return nil, errors.New(err)
+		}
+	}
+
+	return &nase.Empty{}, nil
+}
+
+// Get reads keys for a FReD node that is not a member.
+func (t *transport) Get(ctx context.Context, req *nase.GetRequest) (*nase.GetResponse, error) {
+	kv, err := t.s.Get(ctx, req.Key, req.Prefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &nase.GetResponse{
+		Kvs: make([]*nase.KeyValue, 0, len(kv)),
+	}
+
+	for k, v := range kv {
+		res.Kvs = append(res.Kvs, &nase.KeyValue{
+			Key:   k,
+			Value: v,
+		})
+	}
+
+	return res, nil
+}
+
+// Put stores keys for a FReD node that is not a member or for the migration tool.
+func (t *transport) Put(ctx context.Context, req *nase.PutRequest) (*nase.Empty, error) {
+	kv := make(map[string]string, len(req.Kvs))
+
+	for _, e := range req.Kvs {
+		kv[e.Key] = e.Value
+	}
+
+	if err := t.s.PutAll(ctx, kv); err != nil {
+		return nil, err
+	}
+
+	return &nase.Empty{}, nil
+}
+
+// Delete removes keys for a FReD node that is not a member.
+func (t *transport) Delete(ctx context.Context, req *nase.DeleteRequest) (*nase.Empty, error) {
+	if err := t.s.Delete(ctx, req.Key, req.Prefix); err != nil {
+		return nil, err
+	}
+
+	return &nase.Empty{}, nil
+}
+
+// Lease sets a key and renews its lease for a FReD node that is not a member, which renews it again in time.
+func (t *transport) Lease(ctx context.Context, req *nase.LeaseRequest) (*nase.Empty, error) {
+	if err := t.s.renew(ctx, req.Key, req.Value, time.Duration(req.Ttl)*time.Millisecond); err != nil {
+		return nil, err
+	}
+
+	return &nase.Empty{}, nil
+}
+
+// Acquire sets a key with a lease for a FReD node that is not a member if nobody else holds it.
+func (t *transport) Acquire(ctx context.Context, req *nase.LeaseRequest) (*nase.AcquireResponse, error) {
+	held, err := t.s.acquire(ctx, req.Key, req.Value, time.Duration(req.Ttl)*time.Millisecond)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &nase.AcquireResponse{Held: held}, nil
+}
+
+// Watch streams changes to a FReD node that is not a member until it cancels the stream.
+func (t *transport) Watch(req *nase.WatchRequest, stream nase.Raft_WatchServer) error {
+	for events := range t.s.Watch(stream.Context(), req.Key, req.Prefix) {
+		res := &nase.WatchResponse{
+			Events: make([]*nase.WatchEvent, len(events)),
+		}
+
+		for i, ev := range events {
+			res.Events[i] = &nase.WatchEvent{
+				Key:     ev.Key,
+				Value:   ev.Value,
+				Deleted: ev.Deleted,
+			}
+		}
+
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// close stops the server and closes all connections.
+func (t *transport) close() {
+	close(t.stop)
+	t.server.Stop()
+
+	for _, p := range t.peers {
+		_ = p.conn.Close()
+	}
+}
@@ -0,0 +1,117 @@
+package raftnase
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"git.tu-berlin.de/mcc-fred/fred/pkg/etcdnase"
+)
+
+// watcher queues the changes to the keys that a client watches so that a slow client never holds up raft.
+type watcher struct {
+	key    string
+	prefix bool
+	cancel context.CancelFunc
+
+	lock   sync.Mutex
+	queue  [][]etcdnase.Event
+	signal chan struct{}
+}
+
+// matches returns whether the watcher is interested in a key.
+func (w *watcher) matches(key string) bool {
+	if w.prefix {
+		return strings.HasPrefix(key, w.key)
+	}
+
+	return key == w.key
+}
+
+// Watch sends all changes to a key or to all keys that start with it until ctx is canceled, like an etcd watcher.
+func (s *Store) Watch(ctx context.Context, key string, prefix bool) <-chan []etcdnase.Event {
+	ctx, cancel := context.WithCancel(ctx)
+
+	w := &watcher{
+		key:    key,
+		prefix: prefix,
+		cancel: cancel,
+		queue:  make([][]etcdnase.Event, 0),
+		signal: make(chan struct{}, 1),
+	}
+
+	s.watchLock.Lock()
+	s.watchers[w] = struct{}{}
+	s.watchLock.Unlock()
+
+	out := make(chan []etcdnase.Event)
+
+	go func() {
+		defer close(out)
+		defer func() {
+			s.watchLock.Lock()
+			delete(s.watchers, w)
+			s.watchLock.Unlock()
+		}()
+
+		for {
+			w.lock.Lock()
+			if len(w.queue) == 0 {
+				w.lock.Unlock()
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-w.signal:
+				}
+
+				continue
+			}
+
+			events := w.queue[0]
+			w.queue = w.queue[1:]
+			w.lock.Unlock()
+
+			select {
+			case <-ctx.Done():
+				return
+			case out <- events:
+			}
+		}
+	}()
+
+	return out
+}
+
+// notify hands the events that were just applied to every watcher that is interested in them.
+func (s *Store) notify(events []etcdnase.Event) {
+	if len(events) == 0 {
+		return
+	}
+
+	s.watchLock.Lock()
+	defer s.watchLock.Unlock()
+
+	for w := range s.watchers {
+		matching := make([]etcdnase.Event, 0)
+
+		for _, ev := range events {
+			if w.matches(ev.Key) {
+				matching = append(matching, ev)
+			}
+		}
+
+		if len(matching) == 0 {
+			continue
+		}
+
+		w.lock.Lock()
+		w.queue = append(w.queue, matching)
+		w.lock.Unlock()
+
+		select {
+		case w.signal <- struct{}{}:
+		default:
+		}
+	}
+}
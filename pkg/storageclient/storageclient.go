@@ -45,31 +45,33 @@ func (c *Client) Close() error {
 }
 
 // Read calls the same method on the remote server
-func (c *Client) Read(kg, id string) ([]string, []vclock.VClock, bool, error) {
+func (c *Client) Read(kg, id string) ([]string, []bool, []vclock.VClock, bool, error) {
 	res, err := c.dbClient.Read(context.Background(), &storage.ReadRequest{Keygroup: kg, Id: id})
 	log.Debug().Err(err).Msgf("StorageClient: Read in: %+v %+v out: %+v", kg, id, res)
 
 	if err != nil {
-		return nil, nil, false, errors.New(err)
+		return nil, nil, nil, false, errors.New(err)
 	}
 
 	if len(res.Items) == 0 {
-		return []string{}, []vclock.VClock{}, false, nil
+		return []string{}, []bool{}, []vclock.VClock{}, false, nil
 	}
 
 	vals := make([]string, len(res.Items))
+	tombstones := make([]bool, len(res.Items))
 	vvectors := make([]vclock.VClock, len(res.Items))
 
 	for i, item := range res.Items {
-		vals[i] = item.Val
+		vals[i] = string(item.Val)
+		tombstones[i] = item.Tombstoned
 		vvectors[i] = item.Version
 	}
 
-	return vals, vvectors, true, nil
+	return vals, tombstones, vvectors, true, nil
 }
 
 // ReadSome calls the same method on the remote server
-func (c *Client) ReadSome(kg, id string, count uint64) ([]string, []string, []vclock.VClock, error) {
+func (c *Client) ReadSome(kg, id string, count uint64) ([]string, []string, []bool, []vclock.VClock, error) {
 	res, err := c.dbClient.Scan(context.Background(), &storage.ScanRequest{
 		Keygroup: kg,
 		Start:    id,
@@ -78,55 +80,119 @@ func (c *Client) ReadSome(kg, id string, count uint64) ([]string, []string, []vc
 
 	if err != nil {
 		log.Err(err).Msgf("StorageClient: Error in Scan in: %+v count %d", kg, count)
-		return nil, nil, nil, errors.New(err)
+		return nil, nil, nil, nil, errors.New(err)
 	}
 
 	keys := make([]string, len(res.Items))
 	vals := make([]string, len(res.Items))
+	tombstones := make([]bool, len(res.Items))
 	vvectors := make([]vclock.VClock, len(res.Items))
 
 	for i, item := range res.Items {
 		keys[i] = item.Id
-		vals[i] = item.Val
+		vals[i] = string(item.Val)
+		tombstones[i] = item.Tombstoned
 		vvectors[i] = item.Version
 	}
 
-	return keys, vals, vvectors, nil
+	return keys, vals, tombstones, vvectors, nil
+}
+
+// ReadPage calls the same method on the remote server
+func (c *Client) ReadPage(kg string, after string, count uint64) ([]string, []string, []bool, []vclock.VClock, error) {
+	res, err := c.dbClient.ReadPage(context.Background(), &storage.ReadPageRequest{
+		Keygroup: kg,
+		After:    after,
+		Count:    count,
+	})
+
+	if err != nil {
+		log.Err(err).Msgf("StorageClient: Error in ReadPage in: %+v after %s count %d", kg, after, count)
+		return nil, nil, nil, nil, errors.New(err)
+	}
+
+	keys := make([]string, len(res.Items))
+	vals := make([]string, len(res.Items))
+	tombstones := make([]bool, len(res.Items))
+	vvectors := make([]vclock.VClock, len(res.Items))
+
+	for i, item := range res.Items {
+		keys[i] = item.Id
+		vals[i] = string(item.Val)
+		tombstones[i] = item.Tombstoned
+		vvectors[i] = item.Version
+	}
+
+	return keys, vals, tombstones, vvectors, nil
+}
+
+// ReadRange calls the same method on the remote server
+func (c *Client) ReadRange(kg string, start string, end string, prefix string, reverse bool, count uint64) ([]string, []string, []bool, []vclock.VClock, error) {
+	res, err := c.dbClient.ReadRange(context.Background(), &storage.ReadRangeRequest{
+		Keygroup: kg,
+		Start:    start,
+		End:      end,
+		Prefix:   prefix,
+		Reverse:  reverse,
+		Count:    count,
+	})
+
+	if err != nil {
+		log.Err(err).Msgf("StorageClient: Error in ReadRange in: %+v from %s to %s prefix %s count %d", kg, start, end, prefix, count)
+		return nil, nil, nil, nil, errors.New(err)
+	}
+
+	keys := make([]string, len(res.Items))
+	vals := make([]string, len(res.Items))
+	tombstones := make([]bool, len(res.Items))
+	vvectors := make([]vclock.VClock, len(res.Items))
+
+	for i, item := range res.Items {
+		keys[i] = item.Id
+		vals[i] = string(item.Val)
+		tombstones[i] = item.Tombstoned
+		vvectors[i] = item.Version
+	}
+
+	return keys, vals, tombstones, vvectors, nil
 }
 
 // ReadAll calls the same method on the remote server
-func (c *Client) ReadAll(kg string) ([]string, []string, []vclock.VClock, error) {
+func (c *Client) ReadAll(kg string) ([]string, []string, []bool, []vclock.VClock, error) {
 	res, err := c.dbClient.ReadAll(context.Background(), &storage.ReadAllRequest{
 		Keygroup: kg,
 	})
 
 	if err != nil {
 		log.Err(err).Msgf("StorageClient: Error in ReadAll in: %+v", kg)
-		return nil, nil, nil, errors.New(err)
+		return nil, nil, nil, nil, errors.New(err)
 	}
 
 	keys := make([]string, len(res.Items))
 	vals := make([]string, len(res.Items))
+	tombstones := make([]bool, len(res.Items))
 	vvectors := make([]vclock.VClock, len(res.Items))
 
 	for i, item := range res.Items {
 		keys[i] = item.Id
-		vals[i] = item.Val
+		vals[i] = string(item.Val)
+		tombstones[i] = item.Tombstoned
 		vvectors[i] = item.Version
 	}
 
-	return keys, vals, vvectors, nil
+	return keys, vals, tombstones, vvectors, nil
 }
 
 // Update calls the same method on the remote server
 
-func (c *Client) Update(kg string, id string, val string, expiry int, vvector vclock.VClock) error {
+func (c *Client) Update(kg string, id string, val string, tombstoned bool, expiry int, vvector vclock.VClock) error {
 	response, err := c.dbClient.Update(context.Background(), &storage.UpdateRequest{
-		Keygroup: kg,
-		Val:      val,
-		Id:       id,
-		Expiry:   int64(expiry),
-		Version:  vvector.GetMap(),
+		Keygroup:   kg,
+		Val:        []byte(val),
+		Tombstoned: tombstoned,
+		Id:         id,
+		Expiry:     int64(expiry),
+		Version:    vvector.GetMap(),
 	})
 
 	log.Debug().Err(err).Msgf("StorageClient: Update in: %+v,%+v,%+v,%+v out: %+v", kg, id, val, vvector, response)
@@ -143,7 +209,7 @@ func (c *Client) Append(kg string, id string, val string, expiry int) error {
 	response, err := c.dbClient.Append(context.Background(), &storage.AppendRequest{
 		Keygroup: kg,
 		Id:       id,
-		Val:      val,
+		Val:      []byte(val),
 		Expiry:   int64(expiry)},
 	)
 	log.Debug().Err(err).Msgf("StorageClient: Append in: %+v,%+v out: %+v", kg, val, response)
@@ -155,6 +221,43 @@ func (c *Client) Append(kg string, id string, val string, expiry int) error {
 	return nil
 }
 
+// UpdateBatch calls the same method on the remote server
+func (c *Client) UpdateBatch(kg string, ids []string, vals []string, tombstones []bool, expiries []int, vvectors []vclock.VClock, replaced [][]vclock.VClock) error {
+	entries := make([]*storage.BatchEntry, len(ids))
+
+	for i := range ids {
+		r := make([]*storage.Version, len(replaced[i]))
+
+		for j, v := range replaced[i] {
+			r[j] = &storage.Version{
+				Version: v.GetMap(),
+			}
+		}
+
+		entries[i] = &storage.BatchEntry{
+			Id:         ids[i],
+			Val:        []byte(vals[i]),
+			Tombstoned: tombstones[i],
+			Expiry:     int64(expiries[i]),
+			Version:    vvectors[i].GetMap(),
+			Replaced:   r,
+		}
+	}
+
+	response, err := c.dbClient.UpdateBatch(context.Background(), &storage.UpdateBatchRequest{
+		Keygroup: kg,
+		Entries:  entries,
+	})
+
+	log.Debug().Err(err).Msgf("StorageClient: UpdateBatch in: %+v %+v %+v out: %+v", kg, ids, vvectors, response)
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
 // Delete calls the same method on the remote server
 func (c *Client) Delete(kg string, id string, vvector vclock.VClock) error {
 	response, err := c.dbClient.Delete(context.Background(), &storage.DeleteRequest{
@@ -294,3 +397,46 @@ func (c *Client) GetKeygroupTrigger(kg string) (map[string]string, error) {
 
 	return triggers, nil
 }
+
+// SetKeygroupHistory calls the same method on the remote server
+func (c *Client) SetKeygroupHistory(kg string, versions int, window int) error {
+	response, err := c.dbClient.SetKeygroupHistory(context.Background(), &storage.SetKeygroupHistoryRequest{
+		Keygroup: kg,
+		Versions: int64(versions),
+		Window:   int64(window),
+	})
+
+	log.Debug().Err(err).Msgf("StorageClient: SetKeygroupHistory in: %+v %+v %+v out: %+v", kg, versions, window, response)
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+// ReadHistory calls the same method on the remote server
+func (c *Client) ReadHistory(kg string, id string) ([]string, []bool, []vclock.VClock, []int64, error) {
+	res, err := c.dbClient.ReadHistory(context.Background(), &storage.ReadHistoryRequest{Keygroup: kg, Id: id})
+	log.Debug().Err(err).Msgf("StorageClient: ReadHistory in: %+v %+v out: %+v", kg, id, res)
+
+	if err != nil {
+		return nil, nil, nil, nil, errors.New(err)
+	}
+
+	if len(res.Replaced) != len(res.Items) {
+		return nil, nil, nil, nil, errors.Errorf("got %d replaced times for %d items", len(res.Replaced), len(res.Items))
+	}
+
+	vals := make([]string, len(res.Items))
+	tombstones := make([]bool, len(res.Items))
+	vvectors := make([]vclock.VClock, len(res.Items))
+
+	for i, item := range res.Items {
+		vals[i] = string(item.Val)
+		tombstones[i] = item.Tombstoned
+		vvectors[i] = item.Version
+	}
+
+	return vals, tombstones, vvectors, res.Replaced, nil
+}
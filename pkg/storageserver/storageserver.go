@@ -5,6 +5,7 @@ import (
 
 	"git.tu-berlin.de/mcc-fred/fred/pkg/fred"
 	"git.tu-berlin.de/mcc-fred/fred/proto/storage"
+	"git.tu-berlin.de/mcc-fred/vclock"
 	"github.com/rs/zerolog/log"
 )
 
@@ -23,7 +24,7 @@ func NewStorageServer(store *fred.Store) *Server {
 func (s *Server) Update(_ context.Context, req *storage.UpdateRequest) (*storage.UpdateResponse, error) {
 	log.Debug().Msgf("GRPCServer: Update in=%+v", req)
 
-	err := s.store.Update(req.Keygroup, req.Id, req.Val, int(req.Expiry), req.Version)
+	err := s.store.Update(req.Keygroup, req.Id, string(req.Val), req.Tombstoned, int(req.Expiry), req.Version)
 
 	if err != nil {
 		log.Err(err).Msgf("GRPCServer has encountered an error while updating item %+v", req)
@@ -36,7 +37,7 @@ func (s *Server) Update(_ context.Context, req *storage.UpdateRequest) (*storage
 func (s *Server) Append(_ context.Context, req *storage.AppendRequest) (*storage.AppendResponse, error) {
 	log.Debug().Msgf("GRPCServer: Append in=%+v", req)
 
-	err := s.store.Append(req.Keygroup, req.Id, req.Val, int(req.Expiry))
+	err := s.store.Append(req.Keygroup, req.Id, string(req.Val), int(req.Expiry))
 
 	if err != nil {
 		log.Err(err).Msgf("GRPCServer has encountered an error while appending item %+v", req)
@@ -46,6 +47,38 @@ func (s *Server) Append(_ context.Context, req *storage.AppendRequest) (*storage
 	return &storage.AppendResponse{}, nil
 }
 
+// UpdateBatch calls specific method of the storage interface
+func (s Server) UpdateBatch(_ context.Context, req *storage.UpdateBatchRequest) (*storage.UpdateBatchResponse, error) {
+	log.Debug().Msgf("GRPCServer: UpdateBatch in=%+v", req)
+
+	ids := make([]string, len(req.Entries))
+	vals := make([]string, len(req.Entries))
+	tombstones := make([]bool, len(req.Entries))
+	expiries := make([]int, len(req.Entries))
+	vvectors := make([]vclock.VClock, len(req.Entries))
+	replaced := make([][]vclock.VClock, len(req.Entries))
+
+	for i, e := range req.Entries {
+		ids[i] = e.Id
+		vals[i] = string(e.Val)
+		tombstones[i] = e.Tombstoned
+		expiries[i] = int(e.Expiry)
+		vvectors[i] = e.Version
+		replaced[i] = make([]vclock.VClock, len(e.Replaced))
+
+		for j, v := range e.Replaced {
+			replaced[i][j] = v.Version
+		}
+	}
+
+	err := s.store.UpdateBatch(req.Keygroup, ids, vals, tombstones, expiries, vvectors, replaced)
+	if err != nil {
+		log.Err(err).Msgf("GRPCServer has encountered an error while updating batch %+v", req)
+		return nil, err
+	}
+	return &storage.UpdateBatchResponse{}, nil
+}
+
 // Delete calls specific method of the storage interface
 func (s Server) Delete(_ context.Context, req *storage.DeleteRequest) (*storage.DeleteResponse, error) {
 	log.Debug().Msgf("GRPCServer: Delete in=%+v", req)
@@ -61,7 +94,7 @@ func (s Server) Delete(_ context.Context, req *storage.DeleteRequest) (*storage.
 // Read calls specific method of the storage interface
 func (s Server) Read(_ context.Context, req *storage.ReadRequest) (*storage.ReadResponse, error) {
 	log.Debug().Msgf("GRPCServer: Read in=%+v", req)
-	vals, vvectors, found, err := s.store.Read(req.Keygroup, req.Id)
+	vals, tombstones, vvectors, found, err := s.store.Read(req.Keygroup, req.Id)
 
 	if err != nil {
 		log.Err(err).Msgf("GRPCServer has encountered an error while reading item %+v", req)
@@ -78,10 +111,11 @@ func (s Server) Read(_ context.Context, req *storage.ReadRequest) (*storage.Read
 
 	for i := range vals {
 		items[i] = &storage.Item{
-			Keygroup: req.Keygroup,
-			Id:       req.Id,
-			Val:      vals[i],
-			Version:  vvectors[i],
+			Keygroup:   req.Keygroup,
+			Id:         req.Id,
+			Val:        []byte(vals[i]),
+			Tombstoned: tombstones[i],
+			Version:    vvectors[i],
 		}
 	}
 
@@ -95,7 +129,7 @@ func (s Server) Scan(_ context.Context, req *storage.ScanRequest) (*storage.Scan
 	// Stream: call server.send for every item, return if none left.
 	log.Debug().Msgf("GRPCServer: Scan in=%+v", req)
 
-	keys, vals, vvectors, err := s.store.ReadSome(req.Keygroup, req.Start, req.Count)
+	keys, vals, tombstones, vvectors, err := s.store.ReadSome(req.Keygroup, req.Start, req.Count)
 
 	if err != nil {
 		log.Err(err).Msgf("GRPCServer has encountered an error while scanning %d items from keygroup %+v", req.Count, req.Keygroup)
@@ -106,10 +140,11 @@ func (s Server) Scan(_ context.Context, req *storage.ScanRequest) (*storage.Scan
 
 	for i := range keys {
 		items = append(items, &storage.Item{
-			Keygroup: req.Keygroup,
-			Id:       keys[i],
-			Val:      vals[i],
-			Version:  vvectors[i],
+			Keygroup:   req.Keygroup,
+			Id:         keys[i],
+			Val:        []byte(vals[i]),
+			Tombstoned: tombstones[i],
+			Version:    vvectors[i],
 		})
 	}
 
@@ -118,11 +153,67 @@ func (s Server) Scan(_ context.Context, req *storage.ScanRequest) (*storage.Scan
 	}, nil
 }
 
+// ReadPage calls specific method of the storage interface
+func (s Server) ReadPage(_ context.Context, req *storage.ReadPageRequest) (*storage.ReadPageResponse, error) {
+	log.Debug().Msgf("GRPCServer: ReadPage in=%+v", req)
+
+	keys, vals, tombstones, vvectors, err := s.store.ReadPage(req.Keygroup, req.After, req.Count)
+
+	if err != nil {
+		log.Err(err).Msgf("GRPCServer has encountered an error while reading a page of %d items from keygroup %+v", req.Count, req.Keygroup)
+		return nil, err
+	}
+
+	items := make([]*storage.Item, 0, len(keys))
+
+	for i := range keys {
+		items = append(items, &storage.Item{
+			Keygroup:   req.Keygroup,
+			Id:         keys[i],
+			Val:        []byte(vals[i]),
+			Tombstoned: tombstones[i],
+			Version:    vvectors[i],
+		})
+	}
+
+	return &storage.ReadPageResponse{
+		Items: items,
+	}, nil
+}
+
+// ReadRange calls specific method of the storage interface
+func (s Server) ReadRange(_ context.Context, req *storage.ReadRangeRequest) (*storage.ReadRangeResponse, error) {
+	log.Debug().Msgf("GRPCServer: ReadRange in=%+v", req)
+
+	keys, vals, tombstones, vvectors, err := s.store.ReadRange(req.Keygroup, req.Start, req.End, req.Prefix, req.Reverse, req.Count)
+
+	if err != nil {
+		log.Err(err).Msgf("GRPCServer has encountered an error while reading a range of %d items from keygroup %+v", req.Count, req.Keygroup)
+		return nil, err
+	}
+
+	items := make([]*storage.Item, 0, len(keys))
+
+	for i := range keys {
+		items = append(items, &storage.Item{
+			Keygroup:   req.Keygroup,
+			Id:         keys[i],
+			Val:        []byte(vals[i]),
+			Tombstoned: tombstones[i],
+			Version:    vvectors[i],
+		})
+	}
+
+	return &storage.ReadRangeResponse{
+		Items: items,
+	}, nil
+}
+
 // ReadAll calls specific method of the storage interface
 func (s Server) ReadAll(_ context.Context, req *storage.ReadAllRequest) (*storage.ReadAllResponse, error) {
 	// Stream: call server.send for every item, return if none left.
 	log.Debug().Msgf("GRPCServer: ReadAll in=%+v", req)
-	keys, vals, vvectors, err := s.store.ReadAll(req.Keygroup)
+	keys, vals, tombstones, vvectors, err := s.store.ReadAll(req.Keygroup)
 	if err != nil {
 		log.Err(err).Msgf("GRPCServer has encountered an error while reading whole keygroup %+v", req)
 		return nil, err
@@ -132,10 +223,11 @@ func (s Server) ReadAll(_ context.Context, req *storage.ReadAllRequest) (*storag
 
 	for i := range keys {
 		items = append(items, &storage.Item{
-			Keygroup: req.Keygroup,
-			Id:       keys[i],
-			Val:      vals[i],
-			Version:  vvectors[i],
+			Keygroup:   req.Keygroup,
+			Id:         keys[i],
+			Val:        []byte(vals[i]),
+			Tombstoned: tombstones[i],
+			Version:    vvectors[i],
 		})
 	}
 
@@ -248,3 +340,44 @@ func (s *Server) GetKeygroupTrigger(_ context.Context, req *storage.GetKeygroupT
 
 	return &storage.GetKeygroupTriggerResponse{Triggers: triggers}, nil
 }
+
+// SetKeygroupHistory calls specific method of the storage interface.
+func (s *Server) SetKeygroupHistory(_ context.Context, req *storage.SetKeygroupHistoryRequest) (*storage.SetKeygroupHistoryResponse, error) {
+	log.Debug().Msgf("GRPCServer: SetKeygroupHistory in=%+v", req)
+	err := s.store.SetKeygroupHistory(req.Keygroup, int(req.Versions), int(req.Window))
+
+	if err != nil {
+		log.Err(err).Msgf("GRPCServer has encountered an error while setting history of keygroup %+v", req)
+		return nil, err
+	}
+
+	return &storage.SetKeygroupHistoryResponse{}, nil
+}
+
+// ReadHistory calls specific method of the storage interface.
+func (s *Server) ReadHistory(_ context.Context, req *storage.ReadHistoryRequest) (*storage.ReadHistoryResponse, error) {
+	log.Debug().Msgf("GRPCServer: ReadHistory in=%+v", req)
+	vals, tombstones, vvectors, replaced, err := s.store.ReadHistory(req.Keygroup, req.Id)
+
+	if err != nil {
+		log.Err(err).Msgf("GRPCServer has encountered an error while reading history of item %+v", req)
+		return nil, err
+	}
+
+	items := make([]*storage.Item, len(vals))
+
+	for i := range vals {
+		items[i] = &storage.Item{
+			Keygroup:   req.Keygroup,
+			Id:         req.Id,
+			Val:        []byte(vals[i]),
+			Tombstoned: tombstones[i],
+			Version:    vvectors[i],
+		}
+	}
+
+	return &storage.ReadHistoryResponse{
+		Items:    items,
+		Replaced: replaced,
+	}, nil
+}
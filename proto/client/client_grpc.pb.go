@@ -40,6 +40,27 @@ type ClientClient interface {
 	RemoveTrigger(ctx context.Context, in *RemoveTriggerRequest, opts ...grpc.CallOption) (*Empty, error)
 	AddUser(ctx context.Context, in *AddUserRequest, opts ...grpc.CallOption) (*Empty, error)
 	RemoveUser(ctx context.Context, in *RemoveUserRequest, opts ...grpc.CallOption) (*Empty, error)
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Client_WatchClient, error)
+	Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	QueryIndex(ctx context.Context, in *QueryIndexRequest, opts ...grpc.CallOption) (*QueryIndexResponse, error)
+	Increment(ctx context.Context, in *IncrementRequest, opts ...grpc.CallOption) (*IncrementResponse, error)
+	SetAdd(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error)
+	SetRemove(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error)
+	MapPut(ctx context.Context, in *MapPutRequest, opts ...grpc.CallOption) (*MapResponse, error)
+	MapRemove(ctx context.Context, in *MapRemoveRequest, opts ...grpc.CallOption) (*MapResponse, error)
+	ReadHistory(ctx context.Context, in *ReadHistoryRequest, opts ...grpc.CallOption) (*ReadHistoryResponse, error)
+	ReadAt(ctx context.Context, in *ReadAtRequest, opts ...grpc.CallOption) (*ReadResponse, error)
+	ExportKeygroup(ctx context.Context, in *ExportKeygroupRequest, opts ...grpc.CallOption) (Client_ExportKeygroupClient, error)
+	ImportKeygroup(ctx context.Context, opts ...grpc.CallOption) (Client_ImportKeygroupClient, error)
+	CloneKeygroup(ctx context.Context, in *CloneKeygroupRequest, opts ...grpc.CallOption) (*Empty, error)
+	RenameKeygroup(ctx context.Context, in *RenameKeygroupRequest, opts ...grpc.CallOption) (*Empty, error)
+	SetKeygroupQuota(ctx context.Context, in *SetKeygroupQuotaRequest, opts ...grpc.CallOption) (*Empty, error)
+	ReadChanges(ctx context.Context, in *ReadChangesRequest, opts ...grpc.CallOption) (Client_ReadChangesClient, error)
+	SetChangeCursor(ctx context.Context, in *SetChangeCursorRequest, opts ...grpc.CallOption) (*Empty, error)
+	SetKeygroupReplicationPolicy(ctx context.Context, in *SetKeygroupReplicationPolicyRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetNearestReplicas(ctx context.Context, in *GetNearestReplicasRequest, opts ...grpc.CallOption) (*GetNearestReplicasResponse, error)
+	ProposeReplicas(ctx context.Context, in *ProposeReplicasRequest, opts ...grpc.CallOption) (*ProposeReplicasResponse, error)
+	DecommissionNode(ctx context.Context, in *DecommissionNodeRequest, opts ...grpc.CallOption) (Client_DecommissionNodeClient, error)
 }
 
 type clientClient struct {
@@ -212,6 +233,312 @@ func (c *clientClient) RemoveUser(ctx context.Context, in *RemoveUserRequest, op
 	return out, nil
 }
 
+func (c *clientClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Client_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Client_ServiceDesc.Streams[0], "/mcc.fred.client.Client/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &clientWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Client_WatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type clientWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *clientWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *clientClient) Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, "/mcc.fred.client.Client/Batch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientClient) QueryIndex(ctx context.Context, in *QueryIndexRequest, opts ...grpc.CallOption) (*QueryIndexResponse, error) {
+	out := new(QueryIndexResponse)
+	err := c.cc.Invoke(ctx, "/mcc.fred.client.Client/QueryIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientClient) Increment(ctx context.Context, in *IncrementRequest, opts ...grpc.CallOption) (*IncrementResponse, error) {
+	out := new(IncrementResponse)
+	err := c.cc.Invoke(ctx, "/mcc.fred.client.Client/Increment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientClient) SetAdd(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error) {
+	out := new(SetResponse)
+	err := c.cc.Invoke(ctx, "/mcc.fred.client.Client/SetAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientClient) SetRemove(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error) {
+	out := new(SetResponse)
+	err := c.cc.Invoke(ctx, "/mcc.fred.client.Client/SetRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientClient) MapPut(ctx context.Context, in *MapPutRequest, opts ...grpc.CallOption) (*MapResponse, error) {
+	out := new(MapResponse)
+	err := c.cc.Invoke(ctx, "/mcc.fred.client.Client/MapPut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientClient) MapRemove(ctx context.Context, in *MapRemoveRequest, opts ...grpc.CallOption) (*MapResponse, error) {
+	out := new(MapResponse)
+	err := c.cc.Invoke(ctx, "/mcc.fred.client.Client/MapRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientClient) ReadHistory(ctx context.Context, in *ReadHistoryRequest, opts ...grpc.CallOption) (*ReadHistoryResponse, error) {
+	out := new(ReadHistoryResponse)
+	err := c.cc.Invoke(ctx, "/mcc.fred.client.Client/ReadHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientClient) ReadAt(ctx context.Context, in *ReadAtRequest, opts ...grpc.CallOption) (*ReadResponse, error) {
+	out := new(ReadResponse)
+	err := c.cc.Invoke(ctx, "/mcc.fred.client.Client/ReadAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientClient) ExportKeygroup(ctx context.Context, in *ExportKeygroupRequest, opts ...grpc.CallOption) (Client_ExportKeygroupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Client_ServiceDesc.Streams[1], "/mcc.fred.client.Client/ExportKeygroup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &clientExportKeygroupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Client_ExportKeygroupClient interface {
+	Recv() (*ArchiveChunk, error)
+	grpc.ClientStream
+}
+
+type clientExportKeygroupClient struct {
+	grpc.ClientStream
+}
+
+func (x *clientExportKeygroupClient) Recv() (*ArchiveChunk, error) {
+	m := new(ArchiveChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *clientClient) ImportKeygroup(ctx context.Context, opts ...grpc.CallOption) (Client_ImportKeygroupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Client_ServiceDesc.Streams[2], "/mcc.fred.client.Client/ImportKeygroup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &clientImportKeygroupClient{stream}
+	return x, nil
+}
+
+type Client_ImportKeygroupClient interface {
+	Send(*ImportKeygroupRequest) error
+	CloseAndRecv() (*ImportKeygroupResponse, error)
+	grpc.ClientStream
+}
+
+type clientImportKeygroupClient struct {
+	grpc.ClientStream
+}
+
+func (x *clientImportKeygroupClient) Send(m *ImportKeygroupRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *clientImportKeygroupClient) CloseAndRecv() (*ImportKeygroupResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportKeygroupResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *clientClient) CloneKeygroup(ctx context.Context, in *CloneKeygroupRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mcc.fred.client.Client/CloneKeygroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientClient) RenameKeygroup(ctx context.Context, in *RenameKeygroupRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mcc.fred.client.Client/RenameKeygroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientClient) SetKeygroupQuota(ctx context.Context, in *SetKeygroupQuotaRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mcc.fred.client.Client/SetKeygroupQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientClient) ReadChanges(ctx context.Context, in *ReadChangesRequest, opts ...grpc.CallOption) (Client_ReadChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Client_ServiceDesc.Streams[3], "/mcc.fred.client.Client/ReadChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &clientReadChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Client_ReadChangesClient interface {
+	Recv() (*Change, error)
+	grpc.ClientStream
+}
+
+type clientReadChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *clientReadChangesClient) Recv() (*Change, error) {
+	m := new(Change)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *clientClient) SetChangeCursor(ctx context.Context, in *SetChangeCursorRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mcc.fred.client.Client/SetChangeCursor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientClient) SetKeygroupReplicationPolicy(ctx context.Context, in *SetKeygroupReplicationPolicyRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mcc.fred.client.Client/SetKeygroupReplicationPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientClient) GetNearestReplicas(ctx context.Context, in *GetNearestReplicasRequest, opts ...grpc.CallOption) (*GetNearestReplicasResponse, error) {
+	out := new(GetNearestReplicasResponse)
+	err := c.cc.Invoke(ctx, "/mcc.fred.client.Client/GetNearestReplicas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientClient) ProposeReplicas(ctx context.Context, in *ProposeReplicasRequest, opts ...grpc.CallOption) (*ProposeReplicasResponse, error) {
+	out := new(ProposeReplicasResponse)
+	err := c.cc.Invoke(ctx, "/mcc.fred.client.Client/ProposeReplicas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientClient) DecommissionNode(ctx context.Context, in *DecommissionNodeRequest, opts ...grpc.CallOption) (Client_DecommissionNodeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Client_ServiceDesc.Streams[4], "/mcc.fred.client.Client/DecommissionNode", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &clientDecommissionNodeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Client_DecommissionNodeClient interface {
+	Recv() (*DecommissionProgress, error)
+	grpc.ClientStream
+}
+
+type clientDecommissionNodeClient struct {
+	grpc.ClientStream
+}
+
+func (x *clientDecommissionNodeClient) Recv() (*DecommissionProgress, error) {
+	m := new(DecommissionProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ClientServer is the server API for Client service.
 // All implementations should embed UnimplementedClientServer
 // for forward compatibility
@@ -234,6 +561,27 @@ type ClientServer interface {
 	RemoveTrigger(context.Context, *RemoveTriggerRequest) (*Empty, error)
 	AddUser(context.Context, *AddUserRequest) (*Empty, error)
 	RemoveUser(context.Context, *RemoveUserRequest) (*Empty, error)
+	Watch(*WatchRequest, Client_WatchServer) error
+	Batch(context.Context, *BatchRequest) (*BatchResponse, error)
+	QueryIndex(context.Context, *QueryIndexRequest) (*QueryIndexResponse, error)
+	Increment(context.Context, *IncrementRequest) (*IncrementResponse, error)
+	SetAdd(context.Context, *SetRequest) (*SetResponse, error)
+	SetRemove(context.Context, *SetRequest) (*SetResponse, error)
+	MapPut(context.Context, *MapPutRequest) (*MapResponse, error)
+	MapRemove(context.Context, *MapRemoveRequest) (*MapResponse, error)
+	ReadHistory(context.Context, *ReadHistoryRequest) (*ReadHistoryResponse, error)
+	ReadAt(context.Context, *ReadAtRequest) (*ReadResponse, error)
+	ExportKeygroup(*ExportKeygroupRequest, Client_ExportKeygroupServer) error
+	ImportKeygroup(Client_ImportKeygroupServer) error
+	CloneKeygroup(context.Context, *CloneKeygroupRequest) (*Empty, error)
+	RenameKeygroup(context.Context, *RenameKeygroupRequest) (*Empty, error)
+	SetKeygroupQuota(context.Context, *SetKeygroupQuotaRequest) (*Empty, error)
+	ReadChanges(*ReadChangesRequest, Client_ReadChangesServer) error
+	SetChangeCursor(context.Context, *SetChangeCursorRequest) (*Empty, error)
+	SetKeygroupReplicationPolicy(context.Context, *SetKeygroupReplicationPolicyRequest) (*Empty, error)
+	GetNearestReplicas(context.Context, *GetNearestReplicasRequest) (*GetNearestReplicasResponse, error)
+	ProposeReplicas(context.Context, *ProposeReplicasRequest) (*ProposeReplicasResponse, error)
+	DecommissionNode(*DecommissionNodeRequest, Client_DecommissionNodeServer) error
 }
 
 // UnimplementedClientServer should be embedded to have forward compatible implementations.
@@ -294,6 +642,69 @@ func (UnimplementedClientServer) AddUser(context.Context, *AddUserRequest) (*Emp
 func (UnimplementedClientServer) RemoveUser(context.Context, *RemoveUserRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveUser not implemented")
 }
+func (UnimplementedClientServer) Watch(*WatchRequest, Client_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedClientServer) Batch(context.Context, *BatchRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
+}
+func (UnimplementedClientServer) QueryIndex(context.Context, *QueryIndexRequest) (*QueryIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryIndex not implemented")
+}
+func (UnimplementedClientServer) Increment(context.Context, *IncrementRequest) (*IncrementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Increment not implemented")
+}
+func (UnimplementedClientServer) SetAdd(context.Context, *SetRequest) (*SetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAdd not implemented")
+}
+func (UnimplementedClientServer) SetRemove(context.Context, *SetRequest) (*SetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRemove not implemented")
+}
+func (UnimplementedClientServer) MapPut(context.Context, *MapPutRequest) (*MapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MapPut not implemented")
+}
+func (UnimplementedClientServer) MapRemove(context.Context, *MapRemoveRequest) (*MapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MapRemove not implemented")
+}
+func (UnimplementedClientServer) ReadHistory(context.Context, *ReadHistoryRequest) (*ReadHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadHistory not implemented")
+}
+func (UnimplementedClientServer) ReadAt(context.Context, *ReadAtRequest) (*ReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAt not implemented")
+}
+func (UnimplementedClientServer) ExportKeygroup(*ExportKeygroupRequest, Client_ExportKeygroupServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportKeygroup not implemented")
+}
+func (UnimplementedClientServer) ImportKeygroup(Client_ImportKeygroupServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportKeygroup not implemented")
+}
+func (UnimplementedClientServer) CloneKeygroup(context.Context, *CloneKeygroupRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneKeygroup not implemented")
+}
+func (UnimplementedClientServer) RenameKeygroup(context.Context, *RenameKeygroupRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameKeygroup not implemented")
+}
+func (UnimplementedClientServer) SetKeygroupQuota(context.Context, *SetKeygroupQuotaRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKeygroupQuota not implemented")
+}
+func (UnimplementedClientServer) ReadChanges(*ReadChangesRequest, Client_ReadChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadChanges not implemented")
+}
+func (UnimplementedClientServer) SetChangeCursor(context.Context, *SetChangeCursorRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChangeCursor not implemented")
+}
+func (UnimplementedClientServer) SetKeygroupReplicationPolicy(context.Context, *SetKeygroupReplicationPolicyRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKeygroupReplicationPolicy not implemented")
+}
+func (UnimplementedClientServer) GetNearestReplicas(context.Context, *GetNearestReplicasRequest) (*GetNearestReplicasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNearestReplicas not implemented")
+}
+func (UnimplementedClientServer) ProposeReplicas(context.Context, *ProposeReplicasRequest) (*ProposeReplicasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeReplicas not implemented")
+}
+func (UnimplementedClientServer) DecommissionNode(*DecommissionNodeRequest, Client_DecommissionNodeServer) error {
+	return status.Errorf(codes.Unimplemented, "method DecommissionNode not implemented")
+}
 
 // UnsafeClientServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ClientServer will
@@ -630,6 +1041,404 @@ func _Client_RemoveUser_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Client_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClientServer).Watch(m, &clientWatchServer{stream})
+}
+
+type Client_WatchServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
+}
+
+type clientWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *clientWatchServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Client_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServer).Batch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.client.Client/Batch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServer).Batch(ctx, req.(*BatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Client_QueryIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServer).QueryIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.client.Client/QueryIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServer).QueryIndex(ctx, req.(*QueryIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Client_Increment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServer).Increment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.client.Client/Increment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServer).Increment(ctx, req.(*IncrementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Client_SetAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServer).SetAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.client.Client/SetAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServer).SetAdd(ctx, req.(*SetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Client_SetRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServer).SetRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.client.Client/SetRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServer).SetRemove(ctx, req.(*SetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Client_MapPut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MapPutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServer).MapPut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.client.Client/MapPut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServer).MapPut(ctx, req.(*MapPutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Client_MapRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MapRemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServer).MapRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.client.Client/MapRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServer).MapRemove(ctx, req.(*MapRemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Client_ReadHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServer).ReadHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.client.Client/ReadHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServer).ReadHistory(ctx, req.(*ReadHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Client_ReadAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServer).ReadAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.client.Client/ReadAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServer).ReadAt(ctx, req.(*ReadAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Client_ExportKeygroup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportKeygroupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClientServer).ExportKeygroup(m, &clientExportKeygroupServer{stream})
+}
+
+type Client_ExportKeygroupServer interface {
+	Send(*ArchiveChunk) error
+	grpc.ServerStream
+}
+
+type clientExportKeygroupServer struct {
+	grpc.ServerStream
+}
+
+func (x *clientExportKeygroupServer) Send(m *ArchiveChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Client_ImportKeygroup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ClientServer).ImportKeygroup(&clientImportKeygroupServer{stream})
+}
+
+type Client_ImportKeygroupServer interface {
+	SendAndClose(*ImportKeygroupResponse) error
+	Recv() (*ImportKeygroupRequest, error)
+	grpc.ServerStream
+}
+
+type clientImportKeygroupServer struct {
+	grpc.ServerStream
+}
+
+func (x *clientImportKeygroupServer) SendAndClose(m *ImportKeygroupResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *clientImportKeygroupServer) Recv() (*ImportKeygroupRequest, error) {
+	m := new(ImportKeygroupRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Client_CloneKeygroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneKeygroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServer).CloneKeygroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.client.Client/CloneKeygroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServer).CloneKeygroup(ctx, req.(*CloneKeygroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Client_RenameKeygroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameKeygroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServer).RenameKeygroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.client.Client/RenameKeygroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServer).RenameKeygroup(ctx, req.(*RenameKeygroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Client_SetKeygroupQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetKeygroupQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServer).SetKeygroupQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.client.Client/SetKeygroupQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServer).SetKeygroupQuota(ctx, req.(*SetKeygroupQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Client_ReadChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClientServer).ReadChanges(m, &clientReadChangesServer{stream})
+}
+
+type Client_ReadChangesServer interface {
+	Send(*Change) error
+	grpc.ServerStream
+}
+
+type clientReadChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *clientReadChangesServer) Send(m *Change) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Client_SetChangeCursor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChangeCursorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServer).SetChangeCursor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.client.Client/SetChangeCursor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServer).SetChangeCursor(ctx, req.(*SetChangeCursorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Client_SetKeygroupReplicationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetKeygroupReplicationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServer).SetKeygroupReplicationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.client.Client/SetKeygroupReplicationPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServer).SetKeygroupReplicationPolicy(ctx, req.(*SetKeygroupReplicationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Client_GetNearestReplicas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNearestReplicasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServer).GetNearestReplicas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.client.Client/GetNearestReplicas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServer).GetNearestReplicas(ctx, req.(*GetNearestReplicasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Client_ProposeReplicas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposeReplicasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServer).ProposeReplicas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.client.Client/ProposeReplicas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServer).ProposeReplicas(ctx, req.(*ProposeReplicasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Client_DecommissionNode_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DecommissionNodeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClientServer).DecommissionNode(m, &clientDecommissionNodeServer{stream})
+}
+
+type Client_DecommissionNodeServer interface {
+	Send(*DecommissionProgress) error
+	grpc.ServerStream
+}
+
+type clientDecommissionNodeServer struct {
+	grpc.ServerStream
+}
+
+func (x *clientDecommissionNodeServer) Send(m *DecommissionProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Client_ServiceDesc is the grpc.ServiceDesc for Client service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -709,7 +1518,97 @@ var Client_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveUser",
 			Handler:    _Client_RemoveUser_Handler,
 		},
+		{
+			MethodName: "Batch",
+			Handler:    _Client_Batch_Handler,
+		},
+		{
+			MethodName: "QueryIndex",
+			Handler:    _Client_QueryIndex_Handler,
+		},
+		{
+			MethodName: "Increment",
+			Handler:    _Client_Increment_Handler,
+		},
+		{
+			MethodName: "SetAdd",
+			Handler:    _Client_SetAdd_Handler,
+		},
+		{
+			MethodName: "SetRemove",
+			Handler:    _Client_SetRemove_Handler,
+		},
+		{
+			MethodName: "MapPut",
+			Handler:    _Client_MapPut_Handler,
+		},
+		{
+			MethodName: "MapRemove",
+			Handler:    _Client_MapRemove_Handler,
+		},
+		{
+			MethodName: "ReadHistory",
+			Handler:    _Client_ReadHistory_Handler,
+		},
+		{
+			MethodName: "ReadAt",
+			Handler:    _Client_ReadAt_Handler,
+		},
+		{
+			MethodName: "CloneKeygroup",
+			Handler:    _Client_CloneKeygroup_Handler,
+		},
+		{
+			MethodName: "RenameKeygroup",
+			Handler:    _Client_RenameKeygroup_Handler,
+		},
+		{
+			MethodName: "SetKeygroupQuota",
+			Handler:    _Client_SetKeygroupQuota_Handler,
+		},
+		{
+			MethodName: "SetChangeCursor",
+			Handler:    _Client_SetChangeCursor_Handler,
+		},
+		{
+			MethodName: "SetKeygroupReplicationPolicy",
+			Handler:    _Client_SetKeygroupReplicationPolicy_Handler,
+		},
+		{
+			MethodName: "GetNearestReplicas",
+			Handler:    _Client_GetNearestReplicas_Handler,
+		},
+		{
+			MethodName: "ProposeReplicas",
+			Handler:    _Client_ProposeReplicas_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _Client_Watch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportKeygroup",
+			Handler:       _Client_ExportKeygroup_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportKeygroup",
+			Handler:       _Client_ImportKeygroup_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReadChanges",
+			Handler:       _Client_ReadChanges_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DecommissionNode",
+			Handler:       _Client_DecommissionNode_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "client.proto",
 }
@@ -13,7 +13,7 @@ _sym_db = _symbol_database.Default()
 
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x0c\x63lient.proto\x12\x0fmcc.fred.client\"\x07\n\x05\x45mpty\"q\n\x07Version\x12\x36\n\x07version\x18\x01 \x03(\x0b\x32%.mcc.fred.client.Version.VersionEntry\x1a.\n\x0cVersionEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x04:\x02\x38\x01\"J\n\x15\x43reateKeygroupRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x0f\n\x07mutable\x18\x02 \x01(\x08\x12\x0e\n\x06\x65xpiry\x18\x03 \x01(\x03\")\n\x15\x44\x65leteKeygroupRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\"W\n\x0bReadRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12*\n\x08versions\x18\x03 \x03(\x0b\x32\x18.mcc.fred.client.Version\"J\n\x04Item\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0b\n\x03val\x18\x02 \x01(\t\x12)\n\x07version\x18\x03 \x01(\x0b\x32\x18.mcc.fred.client.Version\"3\n\x0cReadResponse\x12#\n\x04\x64\x61ta\x18\x01 \x03(\x0b\x32\x15.mcc.fred.client.Item\":\n\x0bScanRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12\r\n\x05\x63ount\x18\x03 \x01(\x04\"3\n\x0cScanResponse\x12#\n\x04\x64\x61ta\x18\x01 \x03(\x0b\x32\x15.mcc.fred.client.Item\":\n\x0bKeysRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12\r\n\x05\x63ount\x18\x03 \x01(\x04\"<\n\x03Key\x12\n\n\x02id\x18\x01 \x01(\t\x12)\n\x07version\x18\x02 \x01(\x0b\x32\x18.mcc.fred.client.Version\"2\n\x0cKeysResponse\x12\"\n\x04keys\x18\x01 \x03(\x0b\x32\x14.mcc.fred.client.Key\"g\n\rUpdateRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12\x0c\n\x04\x64\x61ta\x18\x03 \x01(\t\x12*\n\x08versions\x18\x04 \x03(\x0b\x32\x18.mcc.fred.client.Version\";\n\x0eUpdateResponse\x12)\n\x07version\x18\x01 \x01(\x0b\x32\x18.mcc.fred.client.Version\";\n\rAppendRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\x04\x12\x0c\n\x04\x64\x61ta\x18\x03 \x01(\t\"\x1c\n\x0e\x41ppendResponse\x12\n\n\x02id\x18\x01 \x01(\t\"Y\n\rDeleteRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12*\n\x08versions\x18\x03 \x03(\x0b\x32\x18.mcc.fred.client.Version\";\n\x0e\x44\x65leteResponse\x12)\n\x07version\x18\x01 \x01(\x0b\x32\x18.mcc.fred.client.Version\"E\n\x11\x41\x64\x64ReplicaRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x0e\n\x06nodeId\x18\x02 \x01(\t\x12\x0e\n\x06\x65xpiry\x18\x03 \x01(\x03\"*\n\x16GetKeygroupInfoRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\"]\n\x17GetKeygroupInfoResponse\x12\x0f\n\x07mutable\x18\x01 \x01(\x08\x12\x31\n\x07replica\x18\x02 \x03(\x0b\x32 .mcc.fred.client.KeygroupReplica\"?\n\x0fKeygroupReplica\x12\x0e\n\x06nodeId\x18\x01 \x01(\t\x12\x0e\n\x06\x65xpiry\x18\x02 \x01(\x03\x12\x0c\n\x04host\x18\x03 \x01(\t\"8\n\x14RemoveReplicaRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x0e\n\x06nodeId\x18\x02 \x01(\t\"#\n\x11GetReplicaRequest\x12\x0e\n\x06nodeId\x18\x01 \x01(\t\"2\n\x12GetReplicaResponse\x12\x0e\n\x06nodeId\x18\x01 \x01(\t\x12\x0c\n\x04host\x18\x02 \x01(\t\"\'\n\x07Replica\x12\x0e\n\x06nodeId\x18\x01 \x01(\t\x12\x0c\n\x04host\x18\x02 \x01(\t\"C\n\x15GetAllReplicaResponse\x12*\n\x08replicas\x18\x01 \x03(\x0b\x32\x18.mcc.fred.client.Replica\"-\n\x19GetKeygroupTriggerRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\"H\n\x1aGetKeygroupTriggerResponse\x12*\n\x08triggers\x18\x01 \x03(\x0b\x32\x18.mcc.fred.client.Trigger\"#\n\x07Trigger\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04host\x18\x02 \x01(\t\"M\n\x11\x41\x64\x64TriggerRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x11\n\ttriggerId\x18\x02 \x01(\t\x12\x13\n\x0btriggerHost\x18\x03 \x01(\t\";\n\x14RemoveTriggerRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x11\n\ttriggerId\x18\x02 \x01(\t\"Y\n\x0e\x41\x64\x64UserRequest\x12\x0c\n\x04user\x18\x01 \x01(\t\x12\x10\n\x08keygroup\x18\x02 \x01(\t\x12\'\n\x04role\x18\x03 \x01(\x0e\x32\x19.mcc.fred.client.UserRole\"\\\n\x11RemoveUserRequest\x12\x0c\n\x04user\x18\x01 \x01(\t\x12\x10\n\x08keygroup\x18\x02 \x01(\t\x12\'\n\x04role\x18\x03 \x01(\x0e\x32\x19.mcc.fred.client.UserRole*s\n\x08UserRole\x12\x10\n\x0cReadKeygroup\x10\x00\x12\x11\n\rWriteKeygroup\x10\x01\x12\x14\n\x10\x43onfigureReplica\x10\x02\x12\x14\n\x10\x43onfigureTrigger\x10\x03\x12\x16\n\x12\x43onfigureKeygroups\x10\x04\x32\x9c\x0b\n\x06\x43lient\x12P\n\x0e\x43reateKeygroup\x12&.mcc.fred.client.CreateKeygroupRequest\x1a\x16.mcc.fred.client.Empty\x12P\n\x0e\x44\x65leteKeygroup\x12&.mcc.fred.client.DeleteKeygroupRequest\x1a\x16.mcc.fred.client.Empty\x12\x43\n\x04Read\x12\x1c.mcc.fred.client.ReadRequest\x1a\x1d.mcc.fred.client.ReadResponse\x12\x43\n\x04Scan\x12\x1c.mcc.fred.client.ScanRequest\x1a\x1d.mcc.fred.client.ScanResponse\x12\x43\n\x04Keys\x12\x1c.mcc.fred.client.KeysRequest\x1a\x1d.mcc.fred.client.KeysResponse\x12I\n\x06Update\x12\x1e.mcc.fred.client.UpdateRequest\x1a\x1f.mcc.fred.client.UpdateResponse\x12I\n\x06\x44\x65lete\x12\x1e.mcc.fred.client.DeleteRequest\x1a\x1f.mcc.fred.client.DeleteResponse\x12I\n\x06\x41ppend\x12\x1e.mcc.fred.client.AppendRequest\x1a\x1f.mcc.fred.client.AppendResponse\x12H\n\nAddReplica\x12\".mcc.fred.client.AddReplicaRequest\x1a\x16.mcc.fred.client.Empty\x12\x64\n\x0fGetKeygroupInfo\x12\'.mcc.fred.client.GetKeygroupInfoRequest\x1a(.mcc.fred.client.GetKeygroupInfoResponse\x12N\n\rRemoveReplica\x12%.mcc.fred.client.RemoveReplicaRequest\x1a\x16.mcc.fred.client.Empty\x12U\n\nGetReplica\x12\".mcc.fred.client.GetReplicaRequest\x1a#.mcc.fred.client.GetReplicaResponse\x12O\n\rGetAllReplica\x12\x16.mcc.fred.client.Empty\x1a&.mcc.fred.client.GetAllReplicaResponse\x12n\n\x13GetKeygroupTriggers\x12*.mcc.fred.client.GetKeygroupTriggerRequest\x1a+.mcc.fred.client.GetKeygroupTriggerResponse\x12H\n\nAddTrigger\x12\".mcc.fred.client.AddTriggerRequest\x1a\x16.mcc.fred.client.Empty\x12N\n\rRemoveTrigger\x12%.mcc.fred.client.RemoveTriggerRequest\x1a\x16.mcc.fred.client.Empty\x12\x42\n\x07\x41\x64\x64User\x12\x1f.mcc.fred.client.AddUserRequest\x1a\x16.mcc.fred.client.Empty\x12H\n\nRemoveUser\x12\".mcc.fred.client.RemoveUserRequest\x1a\x16.mcc.fred.client.EmptyB\nZ\x08.;clientb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x0c\x63lient.proto\x12\x0fmcc.fred.client\"\x07\n\x05\x45mpty\"q\n\x07Version\x12\x36\n\x07version\x18\x01 \x03(\x0b\x32%.mcc.fred.client.Version.VersionEntry\x1a.\n\x0cVersionEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x04:\x02\x38\x01\"\x95\x03\n\x15\x43reateKeygroupRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x0f\n\x07mutable\x18\x02 \x01(\x08\x12\x0e\n\x06\x65xpiry\x18\x03 \x01(\x03\x12\x44\n\x07indexes\x18\x04 \x03(\x0b\x32\x33.mcc.fred.client.CreateKeygroupRequest.IndexesEntry\x12\x17\n\x0f\x63onflict_policy\x18\x05 \x01(\t\x12\x0c\n\x04type\x18\x06 \x01(\t\x12\x18\n\x10history_versions\x18\x07 \x01(\x03\x12\x16\n\x0ehistory_window\x18\x08 \x01(\x03\x12%\n\x05quota\x18\t \x01(\x0b\x32\x16.mcc.fred.client.Quota\x12\x13\n\x0b\x63ompression\x18\n \x01(\t\x12>\n\x12replication_policy\x18\x0b \x01(\x0b\x32\".mcc.fred.client.ReplicationPolicy\x1a.\n\x0cIndexesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"`\n\x05Quota\x12\x11\n\tmax_bytes\x18\x01 \x01(\x03\x12\x11\n\tmax_items\x18\x02 \x01(\x03\x12\x16\n\x0emax_value_size\x18\x03 \x01(\x03\x12\x19\n\x11writes_per_second\x18\x04 \x01(\x03\"\xb0\x01\n\x11ReplicationPolicy\x12\x14\n\x0cmin_replicas\x18\x01 \x01(\x03\x12>\n\x06labels\x18\x02 \x03(\x0b\x32..mcc.fred.client.ReplicationPolicy.LabelsEntry\x12\x16\n\x0egeohash_prefix\x18\x03 \x01(\t\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"%\n\x05Usage\x12\r\n\x05\x62ytes\x18\x01 \x01(\x03\x12\r\n\x05items\x18\x02 \x01(\x03\")\n\x15\x44\x65leteKeygroupRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\"W\n\x0bReadRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12*\n\x08versions\x18\x03 \x03(\x0b\x32\x18.mcc.fred.client.Version\"J\n\x04Item\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0b\n\x03val\x18\x02 \x01(\x0c\x12)\n\x07version\x18\x03 \x01(\x0b\x32\x18.mcc.fred.client.Version\"3\n\x0cReadResponse\x12#\n\x04\x64\x61ta\x18\x01 \x03(\x0b\x32\x15.mcc.fred.client.Item\"2\n\x12ReadHistoryRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\"h\n\x08Revision\x12\x0b\n\x03val\x18\x01 \x01(\x0c\x12)\n\x07version\x18\x02 \x01(\x0b\x32\x18.mcc.fred.client.Version\x12\x12\n\ntombstoned\x18\x03 \x01(\x08\x12\x10\n\x08replaced\x18\x04 \x01(\x03\"C\n\x13ReadHistoryResponse\x12,\n\trevisions\x18\x01 \x03(\x0b\x32\x19.mcc.fred.client.Revision\"X\n\rReadAtRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12)\n\x07version\x18\x03 \x01(\x0b\x32\x18.mcc.fred.client.Version\"\x84\x01\n\x0bScanRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12\r\n\x05\x63ount\x18\x03 \x01(\x04\x12\x0b\n\x03\x65nd\x18\x04 \x01(\t\x12\x0e\n\x06prefix\x18\x05 \x01(\t\x12\x0f\n\x07reverse\x18\x06 \x01(\x08\x12\x1a\n\x12\x63ontinuation_token\x18\x07 \x01(\t\"O\n\x0cScanResponse\x12#\n\x04\x64\x61ta\x18\x01 \x03(\x0b\x32\x15.mcc.fred.client.Item\x12\x1a\n\x12\x63ontinuation_token\x18\x02 \x01(\t\"U\n\x0c\x42\x61tchRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x33\n\noperations\x18\x02 \x03(\x0b\x32\x1f.mcc.fred.client.BatchOperation\"F\n\x0e\x42\x61tchOperation\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0b\n\x03val\x18\x02 \x01(\x0c\x12\x0e\n\x06\x64\x65lete\x18\x03 \x01(\x08\x12\x0b\n\x03ttl\x18\x04 \x01(\x03\";\n\rBatchResponse\x12*\n\x08versions\x18\x01 \x03(\x0b\x32\x18.mcc.fred.client.Version\"C\n\x11QueryIndexRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\r\n\x05index\x18\x02 \x01(\t\x12\r\n\x05value\x18\x03 \x01(\t\"9\n\x12QueryIndexResponse\x12#\n\x04\x64\x61ta\x18\x01 \x03(\x0b\x32\x15.mcc.fred.client.Item\"?\n\x10IncrementRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12\r\n\x05\x64\x65lta\x18\x03 \x01(\x03\"M\n\x11IncrementResponse\x12\r\n\x05value\x18\x01 \x01(\x03\x12)\n\x07version\x18\x02 \x01(\x0b\x32\x18.mcc.fred.client.Version\"<\n\nSetRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12\x10\n\x08\x65lements\x18\x03 \x03(\t\"J\n\x0bSetResponse\x12\x10\n\x08\x65lements\x18\x01 \x03(\t\x12)\n\x07version\x18\x02 \x01(\x0b\x32\x18.mcc.fred.client.Version\"\x9b\x01\n\rMapPutRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12<\n\x07\x65ntries\x18\x03 \x03(\x0b\x32+.mcc.fred.client.MapPutRequest.EntriesEntry\x1a.\n\x0c\x45ntriesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\">\n\x10MapRemoveRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12\x0c\n\x04keys\x18\x03 \x03(\t\"\xa4\x01\n\x0bMapResponse\x12:\n\x07\x65ntries\x18\x01 \x03(\x0b\x32).mcc.fred.client.MapResponse.EntriesEntry\x12)\n\x07version\x18\x02 \x01(\x0b\x32\x18.mcc.fred.client.Version\x1a.\n\x0c\x45ntriesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"c\n\x0cWatchRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x11\n\tid_prefix\x18\x02 \x01(\t\x12.\n\x0c\x66rom_version\x18\x03 \x01(\x0b\x32\x18.mcc.fred.client.Version\"d\n\nWatchEvent\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0b\n\x03val\x18\x02 \x01(\x0c\x12)\n\x07version\x18\x03 \x01(\x0b\x32\x18.mcc.fred.client.Version\x12\x12\n\ntombstoned\x18\x04 \x01(\x08\":\n\x0bKeysRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12\r\n\x05\x63ount\x18\x03 \x01(\x04\"<\n\x03Key\x12\n\n\x02id\x18\x01 \x01(\t\x12)\n\x07version\x18\x02 \x01(\x0b\x32\x18.mcc.fred.client.Version\"2\n\x0cKeysResponse\x12\"\n\x04keys\x18\x01 \x03(\x0b\x32\x14.mcc.fred.client.Key\"\xa9\x01\n\rUpdateRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12\x0c\n\x04\x64\x61ta\x18\x03 \x01(\x0c\x12*\n\x08versions\x18\x04 \x03(\x0b\x32\x18.mcc.fred.client.Version\x12\x33\n\x0cprecondition\x18\x05 \x01(\x0b\x32\x1d.mcc.fred.client.Precondition\x12\x0b\n\x03ttl\x18\x06 \x01(\x03\";\n\x0eUpdateResponse\x12)\n\x07version\x18\x01 \x01(\x0b\x32\x18.mcc.fred.client.Version\"H\n\rAppendRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\x04\x12\x0c\n\x04\x64\x61ta\x18\x03 \x01(\x0c\x12\x0b\n\x03ttl\x18\x04 \x01(\x03\"\x1c\n\x0e\x41ppendResponse\x12\n\n\x02id\x18\x01 \x01(\t\"\x8e\x01\n\rDeleteRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12*\n\x08versions\x18\x03 \x03(\x0b\x32\x18.mcc.fred.client.Version\x12\x33\n\x0cprecondition\x18\x04 \x01(\x0b\x32\x1d.mcc.fred.client.Precondition\"f\n\x0cPrecondition\x12\x11\n\tif_absent\x18\x01 \x01(\x08\x12,\n\nif_version\x18\x02 \x01(\x0b\x32\x18.mcc.fred.client.Version\x12\x15\n\rif_value_hash\x18\x03 \x01(\t\";\n\x0e\x44\x65leteResponse\x12)\n\x07version\x18\x01 \x01(\x0b\x32\x18.mcc.fred.client.Version\"E\n\x11\x41\x64\x64ReplicaRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x0e\n\x06nodeId\x18\x02 \x01(\t\x12\x0e\n\x06\x65xpiry\x18\x03 \x01(\x03\"*\n\x16GetKeygroupInfoRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\"\xeb\x01\n\x17GetKeygroupInfoResponse\x12\x0f\n\x07mutable\x18\x01 \x01(\x08\x12\x31\n\x07replica\x18\x02 \x03(\x0b\x32 .mcc.fred.client.KeygroupReplica\x12%\n\x05quota\x18\x03 \x01(\x0b\x32\x16.mcc.fred.client.Quota\x12%\n\x05usage\x18\x04 \x01(\x0b\x32\x16.mcc.fred.client.Usage\x12>\n\x12replication_policy\x18\x05 \x01(\x0b\x32\".mcc.fred.client.ReplicationPolicy\"?\n\x0fKeygroupReplica\x12\x0e\n\x06nodeId\x18\x01 \x01(\t\x12\x0e\n\x06\x65xpiry\x18\x02 \x01(\x03\x12\x0c\n\x04host\x18\x03 \x01(\t\"8\n\x14RemoveReplicaRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x0e\n\x06nodeId\x18\x02 \x01(\t\"#\n\x11GetReplicaRequest\x12\x0e\n\x06nodeId\x18\x01 \x01(\t\"2\n\x12GetReplicaResponse\x12\x0e\n\x06nodeId\x18\x01 \x01(\t\x12\x0c\n\x04host\x18\x02 \x01(\t\"\'\n\x07Replica\x12\x0e\n\x06nodeId\x18\x01 \x01(\t\x12\x0c\n\x04host\x18\x02 \x01(\t\"C\n\x15GetAllReplicaResponse\x12*\n\x08replicas\x18\x01 \x03(\x0b\x32\x18.mcc.fred.client.Replica\"$\n\x08Location\x12\x0b\n\x03lat\x18\x01 \x01(\x01\x12\x0b\n\x03lng\x18\x02 \x01(\x01\"R\n\x19GetNearestReplicasRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x0b\n\x03lat\x18\x02 \x01(\x01\x12\x0b\n\x03lng\x18\x03 \x01(\x01\x12\t\n\x01n\x18\x04 \x01(\x03\"P\n\x0eNearestReplica\x12\x0e\n\x06nodeId\x18\x01 \x01(\t\x12\x0c\n\x04host\x18\x02 \x01(\t\x12\x0e\n\x06\x65xpiry\x18\x03 \x01(\x03\x12\x10\n\x08\x64istance\x18\x04 \x01(\x01\"O\n\x1aGetNearestReplicasResponse\x12\x31\n\x08replicas\x18\x01 \x03(\x0b\x32\x1f.mcc.fred.client.NearestReplica\"c\n\x16ProposeReplicasRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12,\n\tlocations\x18\x02 \x03(\x0b\x32\x19.mcc.fred.client.Location\x12\t\n\x01n\x18\x03 \x01(\x03\"\\\n\x17ProposeReplicasResponse\x12*\n\x08replicas\x18\x01 \x03(\x0b\x32\x18.mcc.fred.client.Replica\x12\x15\n\rmean_distance\x18\x02 \x01(\x01\")\n\x17\x44\x65\x63ommissionNodeRequest\x12\x0e\n\x06nodeId\x18\x01 \x01(\t\"\x87\x01\n\x14\x44\x65\x63ommissionProgress\x12/\n\x04step\x18\x01 \x01(\x0e\x32!.mcc.fred.client.DecommissionStep\x12\x10\n\x08keygroup\x18\x02 \x01(\t\x12\x0f\n\x07replica\x18\x03 \x01(\t\x12\x0c\n\x04\x64one\x18\x04 \x01(\x03\x12\r\n\x05total\x18\x05 \x01(\x03\"-\n\x19GetKeygroupTriggerRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\"H\n\x1aGetKeygroupTriggerResponse\x12*\n\x08triggers\x18\x01 \x03(\x0b\x32\x18.mcc.fred.client.Trigger\"#\n\x07Trigger\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04host\x18\x02 \x01(\t\"M\n\x11\x41\x64\x64TriggerRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x11\n\ttriggerId\x18\x02 \x01(\t\x12\x13\n\x0btriggerHost\x18\x03 \x01(\t\";\n\x14RemoveTriggerRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x11\n\ttriggerId\x18\x02 \x01(\t\"Y\n\x0e\x41\x64\x64UserRequest\x12\x0c\n\x04user\x18\x01 \x01(\t\x12\x10\n\x08keygroup\x18\x02 \x01(\t\x12\'\n\x04role\x18\x03 \x01(\x0e\x32\x19.mcc.fred.client.UserRole\"\\\n\x11RemoveUserRequest\x12\x0c\n\x04user\x18\x01 \x01(\t\x12\x10\n\x08keygroup\x18\x02 \x01(\t\x12\'\n\x04role\x18\x03 \x01(\x0e\x32\x19.mcc.fred.client.UserRole\"\xb0\x01\n\x14\x43loneKeygroupRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x0e\n\x06target\x18\x02 \x01(\t\x12\x45\n\x08replicas\x18\x03 \x03(\x0b\x32\x33.mcc.fred.client.CloneKeygroupRequest.ReplicasEntry\x1a/\n\rReplicasEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x03:\x02\x38\x01\"9\n\x15RenameKeygroupRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x0e\n\x06target\x18\x02 \x01(\t\"R\n\x17SetKeygroupQuotaRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12%\n\x05quota\x18\x02 \x01(\x0b\x32\x16.mcc.fred.client.Quota\"w\n#SetKeygroupReplicationPolicyRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12>\n\x12replication_policy\x18\x02 \x01(\x0b\x32\".mcc.fred.client.ReplicationPolicy\")\n\x15\x45xportKeygroupRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\"y\n\x0c\x41rchiveChunk\x12\x30\n\x06header\x18\x01 \x01(\x0b\x32\x1e.mcc.fred.client.ArchiveHeaderH\x00\x12.\n\x05items\x18\x02 \x01(\x0b\x32\x1d.mcc.fred.client.ArchiveItemsH\x00\x42\x07\n\x05\x63hunk\"\xcf\x04\n\rArchiveHeader\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x0f\n\x07mutable\x18\x02 \x01(\x08\x12\x0e\n\x06source\x18\x03 \x01(\t\x12>\n\x08\x65xpiries\x18\x04 \x03(\x0b\x32,.mcc.fred.client.ArchiveHeader.ExpiriesEntry\x12<\n\x07indexes\x18\x05 \x03(\x0b\x32+.mcc.fred.client.ArchiveHeader.IndexesEntry\x12\x17\n\x0f\x63onflict_policy\x18\x06 \x01(\t\x12\x0c\n\x04type\x18\x07 \x01(\t\x12\x18\n\x10history_versions\x18\x08 \x01(\x03\x12\x16\n\x0ehistory_window\x18\t \x01(\x03\x12*\n\x08triggers\x18\n \x03(\x0b\x32\x18.mcc.fred.client.Trigger\x12+\n\x05users\x18\x0b \x03(\x0b\x32\x1c.mcc.fred.client.ArchiveUser\x12%\n\x05quota\x18\x0c \x01(\x0b\x32\x16.mcc.fred.client.Quota\x12\x13\n\x0b\x63ompression\x18\r \x01(\t\x12>\n\x12replication_policy\x18\x0e \x01(\x0b\x32\".mcc.fred.client.ReplicationPolicy\x1a/\n\rExpiriesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x03:\x02\x38\x01\x1a.\n\x0cIndexesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\",\n\x0b\x41rchiveUser\x12\x0c\n\x04user\x18\x01 \x01(\t\x12\x0f\n\x07methods\x18\x02 \x03(\t\";\n\x0c\x41rchiveItems\x12+\n\x05items\x18\x01 \x03(\x0b\x32\x1c.mcc.fred.client.ArchiveItem\"e\n\x0b\x41rchiveItem\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0b\n\x03val\x18\x02 \x01(\x0c\x12)\n\x07version\x18\x03 \x01(\x0b\x32\x18.mcc.fred.client.Version\x12\x12\n\ntombstoned\x18\x04 \x01(\x08\"h\n\x15ImportKeygroupRequest\x12,\n\x05\x63hunk\x18\x01 \x01(\x0b\x32\x1d.mcc.fred.client.ArchiveChunk\x12\x0f\n\x07replace\x18\x02 \x01(\x08\x12\x10\n\x08keygroup\x18\x03 \x01(\t\"^\n\x16ImportKeygroupResponse\x12\x10\n\x08versions\x18\x01 \x01(\x04\x12\x0f\n\x07\x64\x65leted\x18\x02 \x01(\x04\x12\x10\n\x08replicas\x18\x03 \x03(\t\x12\x0f\n\x07skipped\x18\x04 \x03(\t\"J\n\x12ReadChangesRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x10\n\x08\x66rom_seq\x18\x02 \x01(\x04\x12\x10\n\x08\x63onsumer\x18\x03 \x01(\t\"\x9b\x01\n\x06\x43hange\x12\x0b\n\x03seq\x18\x01 \x01(\x04\x12\n\n\x02id\x18\x02 \x01(\t\x12\x0b\n\x03val\x18\x03 \x01(\x0c\x12)\n\x07version\x18\x04 \x01(\x0b\x32\x18.mcc.fred.client.Version\x12\x12\n\ntombstoned\x18\x05 \x01(\x08\x12\x0e\n\x06\x61ppend\x18\x06 \x01(\x08\x12\x0e\n\x06origin\x18\x07 \x01(\t\x12\x0c\n\x04time\x18\x08 \x01(\x03\"I\n\x16SetChangeCursorRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x10\n\x08\x63onsumer\x18\x02 \x01(\t\x12\x0b\n\x03seq\x18\x03 \x01(\x04*s\n\x08UserRole\x12\x10\n\x0cReadKeygroup\x10\x00\x12\x11\n\rWriteKeygroup\x10\x01\x12\x14\n\x10\x43onfigureReplica\x10\x02\x12\x14\n\x10\x43onfigureTrigger\x10\x03\x12\x16\n\x12\x43onfigureKeygroups\x10\x04*W\n\x10\x44\x65\x63ommissionStep\x12\x0b\n\x07Started\x10\x00\x12\x10\n\x0cReplicaAdded\x10\x01\x12\x12\n\x0eReplicaRemoved\x10\x02\x12\x10\n\x0c\x44\x65registered\x10\x03\x32\xa1\x19\n\x06\x43lient\x12P\n\x0e\x43reateKeygroup\x12&.mcc.fred.client.CreateKeygroupRequest\x1a\x16.mcc.fred.client.Empty\x12P\n\x0e\x44\x65leteKeygroup\x12&.mcc.fred.client.DeleteKeygroupRequest\x1a\x16.mcc.fred.client.Empty\x12\x43\n\x04Read\x12\x1c.mcc.fred.client.ReadRequest\x1a\x1d.mcc.fred.client.ReadResponse\x12\x43\n\x04Scan\x12\x1c.mcc.fred.client.ScanRequest\x1a\x1d.mcc.fred.client.ScanResponse\x12\x43\n\x04Keys\x12\x1c.mcc.fred.client.KeysRequest\x1a\x1d.mcc.fred.client.KeysResponse\x12I\n\x06Update\x12\x1e.mcc.fred.client.UpdateRequest\x1a\x1f.mcc.fred.client.UpdateResponse\x12I\n\x06\x44\x65lete\x12\x1e.mcc.fred.client.DeleteRequest\x1a\x1f.mcc.fred.client.DeleteResponse\x12I\n\x06\x41ppend\x12\x1e.mcc.fred.client.AppendRequest\x1a\x1f.mcc.fred.client.AppendResponse\x12H\n\nAddReplica\x12\".mcc.fred.client.AddReplicaRequest\x1a\x16.mcc.fred.client.Empty\x12\x64\n\x0fGetKeygroupInfo\x12\'.mcc.fred.client.GetKeygroupInfoRequest\x1a(.mcc.fred.client.GetKeygroupInfoResponse\x12N\n\rRemoveReplica\x12%.mcc.fred.client.RemoveReplicaRequest\x1a\x16.mcc.fred.client.Empty\x12U\n\nGetReplica\x12\".mcc.fred.client.GetReplicaRequest\x1a#.mcc.fred.client.GetReplicaResponse\x12O\n\rGetAllReplica\x12\x16.mcc.fred.client.Empty\x1a&.mcc.fred.client.GetAllReplicaResponse\x12n\n\x13GetKeygroupTriggers\x12*.mcc.fred.client.GetKeygroupTriggerRequest\x1a+.mcc.fred.client.GetKeygroupTriggerResponse\x12H\n\nAddTrigger\x12\".mcc.fred.client.AddTriggerRequest\x1a\x16.mcc.fred.client.Empty\x12N\n\rRemoveTrigger\x12%.mcc.fred.client.RemoveTriggerRequest\x1a\x16.mcc.fred.client.Empty\x12\x42\n\x07\x41\x64\x64User\x12\x1f.mcc.fred.client.AddUserRequest\x1a\x16.mcc.fred.client.Empty\x12H\n\nRemoveUser\x12\".mcc.fred.client.RemoveUserRequest\x1a\x16.mcc.fred.client.Empty\x12\x45\n\x05Watch\x12\x1d.mcc.fred.client.WatchRequest\x1a\x1b.mcc.fred.client.WatchEvent0\x01\x12\x46\n\x05\x42\x61tch\x12\x1d.mcc.fred.client.BatchRequest\x1a\x1e.mcc.fred.client.BatchResponse\x12U\n\nQueryIndex\x12\".mcc.fred.client.QueryIndexRequest\x1a#.mcc.fred.client.QueryIndexResponse\x12R\n\tIncrement\x12!.mcc.fred.client.IncrementRequest\x1a\".mcc.fred.client.IncrementResponse\x12\x43\n\x06SetAdd\x12\x1b.mcc.fred.client.SetRequest\x1a\x1c.mcc.fred.client.SetResponse\x12\x46\n\tSetRemove\x12\x1b.mcc.fred.client.SetRequest\x1a\x1c.mcc.fred.client.SetResponse\x12\x46\n\x06MapPut\x12\x1e.mcc.fred.client.MapPutRequest\x1a\x1c.mcc.fred.client.MapResponse\x12L\n\tMapRemove\x12!.mcc.fred.client.MapRemoveRequest\x1a\x1c.mcc.fred.client.MapResponse\x12X\n\x0bReadHistory\x12#.mcc.fred.client.ReadHistoryRequest\x1a$.mcc.fred.client.ReadHistoryResponse\x12G\n\x06ReadAt\x12\x1e.mcc.fred.client.ReadAtRequest\x1a\x1d.mcc.fred.client.ReadResponse\x12Y\n\x0e\x45xportKeygroup\x12&.mcc.fred.client.ExportKeygroupRequest\x1a\x1d.mcc.fred.client.ArchiveChunk0\x01\x12\x63\n\x0eImportKeygroup\x12&.mcc.fred.client.ImportKeygroupRequest\x1a\'.mcc.fred.client.ImportKeygroupResponse(\x01\x12N\n\rCloneKeygroup\x12%.mcc.fred.client.CloneKeygroupRequest\x1a\x16.mcc.fred.client.Empty\x12P\n\x0eRenameKeygroup\x12&.mcc.fred.client.RenameKeygroupRequest\x1a\x16.mcc.fred.client.Empty\x12T\n\x10SetKeygroupQuota\x12(.mcc.fred.client.SetKeygroupQuotaRequest\x1a\x16.mcc.fred.client.Empty\x12M\n\x0bReadChanges\x12#.mcc.fred.client.ReadChangesRequest\x1a\x17.mcc.fred.client.Change0\x01\x12R\n\x0fSetChangeCursor\x12\'.mcc.fred.client.SetChangeCursorRequest\x1a\x16.mcc.fred.client.Empty\x12l\n\x1cSetKeygroupReplicationPolicy\x12\x34.mcc.fred.client.SetKeygroupReplicationPolicyRequest\x1a\x16.mcc.fred.client.Empty\x12m\n\x12GetNearestReplicas\x12*.mcc.fred.client.GetNearestReplicasRequest\x1a+.mcc.fred.client.GetNearestReplicasResponse\x12\x64\n\x0fProposeReplicas\x12\'.mcc.fred.client.ProposeReplicasRequest\x1a(.mcc.fred.client.ProposeReplicasResponse\x12\x65\n\x10\x44\x65\x63ommissionNode\x12(.mcc.fred.client.DecommissionNodeRequest\x1a%.mcc.fred.client.DecommissionProgress0\x01\x42\nZ\x08.;clientb\x06proto3')
 
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, globals())
 _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'client_pb2', globals())
@@ -23,78 +23,198 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   DESCRIPTOR._serialized_options = b'Z\010.;client'
   _VERSION_VERSIONENTRY._options = None
   _VERSION_VERSIONENTRY._serialized_options = b'8\001'
-  _USERROLE._serialized_start=2205
-  _USERROLE._serialized_end=2320
+  _CREATEKEYGROUPREQUEST_INDEXESENTRY._options = None
+  _CREATEKEYGROUPREQUEST_INDEXESENTRY._serialized_options = b'8\001'
+  _REPLICATIONPOLICY_LABELSENTRY._options = None
+  _REPLICATIONPOLICY_LABELSENTRY._serialized_options = b'8\001'
+  _MAPPUTREQUEST_ENTRIESENTRY._options = None
+  _MAPPUTREQUEST_ENTRIESENTRY._serialized_options = b'8\001'
+  _MAPRESPONSE_ENTRIESENTRY._options = None
+  _MAPRESPONSE_ENTRIESENTRY._serialized_options = b'8\001'
+  _CLONEKEYGROUPREQUEST_REPLICASENTRY._options = None
+  _CLONEKEYGROUPREQUEST_REPLICASENTRY._serialized_options = b'8\001'
+  _ARCHIVEHEADER_EXPIRIESENTRY._options = None
+  _ARCHIVEHEADER_EXPIRIESENTRY._serialized_options = b'8\001'
+  _ARCHIVEHEADER_INDEXESENTRY._options = None
+  _ARCHIVEHEADER_INDEXESENTRY._serialized_options = b'8\001'
+  _USERROLE._serialized_start=7461
+  _USERROLE._serialized_end=7576
+  _DECOMMISSIONSTEP._serialized_start=7578
+  _DECOMMISSIONSTEP._serialized_end=7665
   _EMPTY._serialized_start=33
   _EMPTY._serialized_end=40
   _VERSION._serialized_start=42
   _VERSION._serialized_end=155
   _VERSION_VERSIONENTRY._serialized_start=109
   _VERSION_VERSIONENTRY._serialized_end=155
-  _CREATEKEYGROUPREQUEST._serialized_start=157
-  _CREATEKEYGROUPREQUEST._serialized_end=231
-  _DELETEKEYGROUPREQUEST._serialized_start=233
-  _DELETEKEYGROUPREQUEST._serialized_end=274
-  _READREQUEST._serialized_start=276
-  _READREQUEST._serialized_end=363
-  _ITEM._serialized_start=365
-  _ITEM._serialized_end=439
-  _READRESPONSE._serialized_start=441
-  _READRESPONSE._serialized_end=492
-  _SCANREQUEST._serialized_start=494
-  _SCANREQUEST._serialized_end=552
-  _SCANRESPONSE._serialized_start=554
-  _SCANRESPONSE._serialized_end=605
-  _KEYSREQUEST._serialized_start=607
-  _KEYSREQUEST._serialized_end=665
-  _KEY._serialized_start=667
-  _KEY._serialized_end=727
-  _KEYSRESPONSE._serialized_start=729
-  _KEYSRESPONSE._serialized_end=779
-  _UPDATEREQUEST._serialized_start=781
-  _UPDATEREQUEST._serialized_end=884
-  _UPDATERESPONSE._serialized_start=886
-  _UPDATERESPONSE._serialized_end=945
-  _APPENDREQUEST._serialized_start=947
-  _APPENDREQUEST._serialized_end=1006
-  _APPENDRESPONSE._serialized_start=1008
-  _APPENDRESPONSE._serialized_end=1036
-  _DELETEREQUEST._serialized_start=1038
-  _DELETEREQUEST._serialized_end=1127
-  _DELETERESPONSE._serialized_start=1129
-  _DELETERESPONSE._serialized_end=1188
-  _ADDREPLICAREQUEST._serialized_start=1190
-  _ADDREPLICAREQUEST._serialized_end=1259
-  _GETKEYGROUPINFOREQUEST._serialized_start=1261
-  _GETKEYGROUPINFOREQUEST._serialized_end=1303
-  _GETKEYGROUPINFORESPONSE._serialized_start=1305
-  _GETKEYGROUPINFORESPONSE._serialized_end=1398
-  _KEYGROUPREPLICA._serialized_start=1400
-  _KEYGROUPREPLICA._serialized_end=1463
-  _REMOVEREPLICAREQUEST._serialized_start=1465
-  _REMOVEREPLICAREQUEST._serialized_end=1521
-  _GETREPLICAREQUEST._serialized_start=1523
-  _GETREPLICAREQUEST._serialized_end=1558
-  _GETREPLICARESPONSE._serialized_start=1560
-  _GETREPLICARESPONSE._serialized_end=1610
-  _REPLICA._serialized_start=1612
-  _REPLICA._serialized_end=1651
-  _GETALLREPLICARESPONSE._serialized_start=1653
-  _GETALLREPLICARESPONSE._serialized_end=1720
-  _GETKEYGROUPTRIGGERREQUEST._serialized_start=1722
-  _GETKEYGROUPTRIGGERREQUEST._serialized_end=1767
-  _GETKEYGROUPTRIGGERRESPONSE._serialized_start=1769
-  _GETKEYGROUPTRIGGERRESPONSE._serialized_end=1841
-  _TRIGGER._serialized_start=1843
-  _TRIGGER._serialized_end=1878
-  _ADDTRIGGERREQUEST._serialized_start=1880
-  _ADDTRIGGERREQUEST._serialized_end=1957
-  _REMOVETRIGGERREQUEST._serialized_start=1959
-  _REMOVETRIGGERREQUEST._serialized_end=2018
-  _ADDUSERREQUEST._serialized_start=2020
-  _ADDUSERREQUEST._serialized_end=2109
-  _REMOVEUSERREQUEST._serialized_start=2111
-  _REMOVEUSERREQUEST._serialized_end=2203
-  _CLIENT._serialized_start=2323
-  _CLIENT._serialized_end=3759
+  _CREATEKEYGROUPREQUEST._serialized_start=158
+  _CREATEKEYGROUPREQUEST._serialized_end=563
+  _CREATEKEYGROUPREQUEST_INDEXESENTRY._serialized_start=517
+  _CREATEKEYGROUPREQUEST_INDEXESENTRY._serialized_end=563
+  _QUOTA._serialized_start=565
+  _QUOTA._serialized_end=661
+  _REPLICATIONPOLICY._serialized_start=664
+  _REPLICATIONPOLICY._serialized_end=840
+  _REPLICATIONPOLICY_LABELSENTRY._serialized_start=795
+  _REPLICATIONPOLICY_LABELSENTRY._serialized_end=840
+  _USAGE._serialized_start=842
+  _USAGE._serialized_end=879
+  _DELETEKEYGROUPREQUEST._serialized_start=881
+  _DELETEKEYGROUPREQUEST._serialized_end=922
+  _READREQUEST._serialized_start=924
+  _READREQUEST._serialized_end=1011
+  _ITEM._serialized_start=1013
+  _ITEM._serialized_end=1087
+  _READRESPONSE._serialized_start=1089
+  _READRESPONSE._serialized_end=1140
+  _READHISTORYREQUEST._serialized_start=1142
+  _READHISTORYREQUEST._serialized_end=1192
+  _REVISION._serialized_start=1194
+  _REVISION._serialized_end=1298
+  _READHISTORYRESPONSE._serialized_start=1300
+  _READHISTORYRESPONSE._serialized_end=1367
+  _READATREQUEST._serialized_start=1369
+  _READATREQUEST._serialized_end=1457
+  _SCANREQUEST._serialized_start=1460
+  _SCANREQUEST._serialized_end=1592
+  _SCANRESPONSE._serialized_start=1594
+  _SCANRESPONSE._serialized_end=1673
+  _BATCHREQUEST._serialized_start=1675
+  _BATCHREQUEST._serialized_end=1760
+  _BATCHOPERATION._serialized_start=1762
+  _BATCHOPERATION._serialized_end=1832
+  _BATCHRESPONSE._serialized_start=1834
+  _BATCHRESPONSE._serialized_end=1893
+  _QUERYINDEXREQUEST._serialized_start=1895
+  _QUERYINDEXREQUEST._serialized_end=1962
+  _QUERYINDEXRESPONSE._serialized_start=1964
+  _QUERYINDEXRESPONSE._serialized_end=2021
+  _INCREMENTREQUEST._serialized_start=2023
+  _INCREMENTREQUEST._serialized_end=2086
+  _INCREMENTRESPONSE._serialized_start=2088
+  _INCREMENTRESPONSE._serialized_end=2165
+  _SETREQUEST._serialized_start=2167
+  _SETREQUEST._serialized_end=2227
+  _SETRESPONSE._serialized_start=2229
+  _SETRESPONSE._serialized_end=2303
+  _MAPPUTREQUEST._serialized_start=2306
+  _MAPPUTREQUEST._serialized_end=2461
+  _MAPPUTREQUEST_ENTRIESENTRY._serialized_start=2415
+  _MAPPUTREQUEST_ENTRIESENTRY._serialized_end=2461
+  _MAPREMOVEREQUEST._serialized_start=2463
+  _MAPREMOVEREQUEST._serialized_end=2525
+  _MAPRESPONSE._serialized_start=2528
+  _MAPRESPONSE._serialized_end=2692
+  _MAPRESPONSE_ENTRIESENTRY._serialized_start=2415
+  _MAPRESPONSE_ENTRIESENTRY._serialized_end=2461
+  _WATCHREQUEST._serialized_start=2694
+  _WATCHREQUEST._serialized_end=2793
+  _WATCHEVENT._serialized_start=2795
+  _WATCHEVENT._serialized_end=2895
+  _KEYSREQUEST._serialized_start=2897
+  _KEYSREQUEST._serialized_end=2955
+  _KEY._serialized_start=2957
+  _KEY._serialized_end=3017
+  _KEYSRESPONSE._serialized_start=3019
+  _KEYSRESPONSE._serialized_end=3069
+  _UPDATEREQUEST._serialized_start=3072
+  _UPDATEREQUEST._serialized_end=3241
+  _UPDATERESPONSE._serialized_start=3243
+  _UPDATERESPONSE._serialized_end=3302
+  _APPENDREQUEST._serialized_start=3304
+  _APPENDREQUEST._serialized_end=3376
+  _APPENDRESPONSE._serialized_start=3378
+  _APPENDRESPONSE._serialized_end=3406
+  _DELETEREQUEST._serialized_start=3409
+  _DELETEREQUEST._serialized_end=3551
+  _PRECONDITION._serialized_start=3553
+  _PRECONDITION._serialized_end=3655
+  _DELETERESPONSE._serialized_start=3657
+  _DELETERESPONSE._serialized_end=3716
+  _ADDREPLICAREQUEST._serialized_start=3718
+  _ADDREPLICAREQUEST._serialized_end=3787
+  _GETKEYGROUPINFOREQUEST._serialized_start=3789
+  _GETKEYGROUPINFOREQUEST._serialized_end=3831
+  _GETKEYGROUPINFORESPONSE._serialized_start=3834
+  _GETKEYGROUPINFORESPONSE._serialized_end=4069
+  _KEYGROUPREPLICA._serialized_start=4071
+  _KEYGROUPREPLICA._serialized_end=4134
+  _REMOVEREPLICAREQUEST._serialized_start=4136
+  _REMOVEREPLICAREQUEST._serialized_end=4192
+  _GETREPLICAREQUEST._serialized_start=4194
+  _GETREPLICAREQUEST._serialized_end=4229
+  _GETREPLICARESPONSE._serialized_start=4231
+  _GETREPLICARESPONSE._serialized_end=4281
+  _REPLICA._serialized_start=4283
+  _REPLICA._serialized_end=4322
+  _GETALLREPLICARESPONSE._serialized_start=4324
+  _GETALLREPLICARESPONSE._serialized_end=4391
+  _LOCATION._serialized_start=4393
+  _LOCATION._serialized_end=4429
+  _GETNEARESTREPLICASREQUEST._serialized_start=4431
+  _GETNEARESTREPLICASREQUEST._serialized_end=4513
+  _NEARESTREPLICA._serialized_start=4515
+  _NEARESTREPLICA._serialized_end=4595
+  _GETNEARESTREPLICASRESPONSE._serialized_start=4597
+  _GETNEARESTREPLICASRESPONSE._serialized_end=4676
+  _PROPOSEREPLICASREQUEST._serialized_start=4678
+  _PROPOSEREPLICASREQUEST._serialized_end=4777
+  _PROPOSEREPLICASRESPONSE._serialized_start=4779
+  _PROPOSEREPLICASRESPONSE._serialized_end=4871
+  _DECOMMISSIONNODEREQUEST._serialized_start=4873
+  _DECOMMISSIONNODEREQUEST._serialized_end=4914
+  _DECOMMISSIONPROGRESS._serialized_start=4917
+  _DECOMMISSIONPROGRESS._serialized_end=5052
+  _GETKEYGROUPTRIGGERREQUEST._serialized_start=5054
+  _GETKEYGROUPTRIGGERREQUEST._serialized_end=5099
+  _GETKEYGROUPTRIGGERRESPONSE._serialized_start=5101
+  _GETKEYGROUPTRIGGERRESPONSE._serialized_end=5173
+  _TRIGGER._serialized_start=5175
+  _TRIGGER._serialized_end=5210
+  _ADDTRIGGERREQUEST._serialized_start=5212
+  _ADDTRIGGERREQUEST._serialized_end=5289
+  _REMOVETRIGGERREQUEST._serialized_start=5291
+  _REMOVETRIGGERREQUEST._serialized_end=5350
+  _ADDUSERREQUEST._serialized_start=5352
+  _ADDUSERREQUEST._serialized_end=5441
+  _REMOVEUSERREQUEST._serialized_start=5443
+  _REMOVEUSERREQUEST._serialized_end=5535
+  _CLONEKEYGROUPREQUEST._serialized_start=5538
+  _CLONEKEYGROUPREQUEST._serialized_end=5714
+  _CLONEKEYGROUPREQUEST_REPLICASENTRY._serialized_start=5667
+  _CLONEKEYGROUPREQUEST_REPLICASENTRY._serialized_end=5714
+  _RENAMEKEYGROUPREQUEST._serialized_start=5716
+  _RENAMEKEYGROUPREQUEST._serialized_end=5773
+  _SETKEYGROUPQUOTAREQUEST._serialized_start=5775
+  _SETKEYGROUPQUOTAREQUEST._serialized_end=5857
+  _SETKEYGROUPREPLICATIONPOLICYREQUEST._serialized_start=5859
+  _SETKEYGROUPREPLICATIONPOLICYREQUEST._serialized_end=5978
+  _EXPORTKEYGROUPREQUEST._serialized_start=5980
+  _EXPORTKEYGROUPREQUEST._serialized_end=6021
+  _ARCHIVECHUNK._serialized_start=6023
+  _ARCHIVECHUNK._serialized_end=6144
+  _ARCHIVEHEADER._serialized_start=6147
+  _ARCHIVEHEADER._serialized_end=6738
+  _ARCHIVEHEADER_EXPIRIESENTRY._serialized_start=6643
+  _ARCHIVEHEADER_EXPIRIESENTRY._serialized_end=6690
+  _ARCHIVEHEADER_INDEXESENTRY._serialized_start=517
+  _ARCHIVEHEADER_INDEXESENTRY._serialized_end=563
+  _ARCHIVEUSER._serialized_start=6740
+  _ARCHIVEUSER._serialized_end=6784
+  _ARCHIVEITEMS._serialized_start=6786
+  _ARCHIVEITEMS._serialized_end=6845
+  _ARCHIVEITEM._serialized_start=6847
+  _ARCHIVEITEM._serialized_end=6948
+  _IMPORTKEYGROUPREQUEST._serialized_start=6950
+  _IMPORTKEYGROUPREQUEST._serialized_end=7054
+  _IMPORTKEYGROUPRESPONSE._serialized_start=7056
+  _IMPORTKEYGROUPRESPONSE._serialized_end=7150
+  _READCHANGESREQUEST._serialized_start=7152
+  _READCHANGESREQUEST._serialized_end=7226
+  _CHANGE._serialized_start=7229
+  _CHANGE._serialized_end=7384
+  _SETCHANGECURSORREQUEST._serialized_start=7386
+  _SETCHANGECURSORREQUEST._serialized_end=7459
+  _CLIENT._serialized_start=7668
+  _CLIENT._serialized_end=10901
 # @@protoc_insertion_point(module_scope)
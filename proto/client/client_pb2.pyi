@@ -39,6 +39,25 @@ ConfigureTrigger: UserRole.ValueType  # 3
 ConfigureKeygroups: UserRole.ValueType  # 4
 global___UserRole = UserRole
 
+class _DecommissionStep:
+    ValueType = typing.NewType("ValueType", builtins.int)
+    V: typing_extensions.TypeAlias = ValueType
+
+class _DecommissionStepEnumTypeWrapper(google.protobuf.internal.enum_type_wrapper._EnumTypeWrapper[_DecommissionStep.ValueType], builtins.type):
+    DESCRIPTOR: google.protobuf.descriptor.EnumDescriptor
+    Started: _DecommissionStep.ValueType  # 0
+    ReplicaAdded: _DecommissionStep.ValueType  # 1
+    ReplicaRemoved: _DecommissionStep.ValueType  # 2
+    Deregistered: _DecommissionStep.ValueType  # 3
+
+class DecommissionStep(_DecommissionStep, metaclass=_DecommissionStepEnumTypeWrapper): ...
+
+Started: DecommissionStep.ValueType  # 0
+ReplicaAdded: DecommissionStep.ValueType  # 1
+ReplicaRemoved: DecommissionStep.ValueType  # 2
+Deregistered: DecommissionStep.ValueType  # 3
+global___DecommissionStep = DecommissionStep
+
 @typing_extensions.final
 class Empty(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
@@ -85,23 +104,147 @@ global___Version = Version
 class CreateKeygroupRequest(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
+    @typing_extensions.final
+    class IndexesEntry(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        KEY_FIELD_NUMBER: builtins.int
+        VALUE_FIELD_NUMBER: builtins.int
+        key: builtins.str
+        value: builtins.str
+        def __init__(
+            self,
+            *,
+            key: builtins.str = ...,
+            value: builtins.str = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
+
     KEYGROUP_FIELD_NUMBER: builtins.int
     MUTABLE_FIELD_NUMBER: builtins.int
     EXPIRY_FIELD_NUMBER: builtins.int
+    INDEXES_FIELD_NUMBER: builtins.int
+    CONFLICT_POLICY_FIELD_NUMBER: builtins.int
+    TYPE_FIELD_NUMBER: builtins.int
+    HISTORY_VERSIONS_FIELD_NUMBER: builtins.int
+    HISTORY_WINDOW_FIELD_NUMBER: builtins.int
+    QUOTA_FIELD_NUMBER: builtins.int
+    COMPRESSION_FIELD_NUMBER: builtins.int
+    REPLICATION_POLICY_FIELD_NUMBER: builtins.int
     keygroup: builtins.str
     mutable: builtins.bool
     expiry: builtins.int
+    @property
+    def indexes(self) -> google.protobuf.internal.containers.ScalarMap[builtins.str, builtins.str]: ...
+    conflict_policy: builtins.str
+    type: builtins.str
+    history_versions: builtins.int
+    history_window: builtins.int
+    @property
+    def quota(self) -> global___Quota: ...
+    compression: builtins.str
+    @property
+    def replication_policy(self) -> global___ReplicationPolicy: ...
     def __init__(
         self,
         *,
         keygroup: builtins.str = ...,
         mutable: builtins.bool = ...,
         expiry: builtins.int = ...,
+        indexes: collections.abc.Mapping[builtins.str, builtins.str] | None = ...,
+        conflict_policy: builtins.str = ...,
+        type: builtins.str = ...,
+        history_versions: builtins.int = ...,
+        history_window: builtins.int = ...,
+        quota: global___Quota | None = ...,
+        compression: builtins.str = ...,
+        replication_policy: global___ReplicationPolicy | None = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["expiry", b"expiry", "keygroup", b"keygroup", "mutable", b"mutable"]) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["quota", b"quota", "replication_policy", b"replication_policy"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["compression", b"compression", "conflict_policy", b"conflict_policy", "expiry", b"expiry", "history_versions", b"history_versions", "history_window", b"history_window", "indexes", b"indexes", "keygroup", b"keygroup", "mutable", b"mutable", "quota", b"quota", "replication_policy", b"replication_policy", "type", b"type"]) -> None: ...
 
 global___CreateKeygroupRequest = CreateKeygroupRequest
 
+@typing_extensions.final
+class Quota(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    MAX_BYTES_FIELD_NUMBER: builtins.int
+    MAX_ITEMS_FIELD_NUMBER: builtins.int
+    MAX_VALUE_SIZE_FIELD_NUMBER: builtins.int
+    WRITES_PER_SECOND_FIELD_NUMBER: builtins.int
+    max_bytes: builtins.int
+    max_items: builtins.int
+    max_value_size: builtins.int
+    writes_per_second: builtins.int
+    def __init__(
+        self,
+        *,
+        max_bytes: builtins.int = ...,
+        max_items: builtins.int = ...,
+        max_value_size: builtins.int = ...,
+        writes_per_second: builtins.int = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["max_bytes", b"max_bytes", "max_items", b"max_items", "max_value_size", b"max_value_size", "writes_per_second", b"writes_per_second"]) -> None: ...
+
+global___Quota = Quota
+
+@typing_extensions.final
+class ReplicationPolicy(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    @typing_extensions.final
+    class LabelsEntry(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        KEY_FIELD_NUMBER: builtins.int
+        VALUE_FIELD_NUMBER: builtins.int
+        key: builtins.str
+        value: builtins.str
+        def __init__(
+            self,
+            *,
+            key: builtins.str = ...,
+            value: builtins.str = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
+
+    MIN_REPLICAS_FIELD_NUMBER: builtins.int
+    LABELS_FIELD_NUMBER: builtins.int
+    GEOHASH_PREFIX_FIELD_NUMBER: builtins.int
+    min_replicas: builtins.int
+    @property
+    def labels(self) -> google.protobuf.internal.containers.ScalarMap[builtins.str, builtins.str]: ...
+    geohash_prefix: builtins.str
+    def __init__(
+        self,
+        *,
+        min_replicas: builtins.int = ...,
+        labels: collections.abc.Mapping[builtins.str, builtins.str] | None = ...,
+        geohash_prefix: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["geohash_prefix", b"geohash_prefix", "labels", b"labels", "min_replicas", b"min_replicas"]) -> None: ...
+
+global___ReplicationPolicy = ReplicationPolicy
+
+@typing_extensions.final
+class Usage(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    BYTES_FIELD_NUMBER: builtins.int
+    ITEMS_FIELD_NUMBER: builtins.int
+    bytes: builtins.int
+    items: builtins.int
+    def __init__(
+        self,
+        *,
+        bytes: builtins.int = ...,
+        items: builtins.int = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["bytes", b"bytes", "items", b"items"]) -> None: ...
+
+global___Usage = Usage
+
 @typing_extensions.final
 class DeleteKeygroupRequest(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
@@ -147,14 +290,14 @@ class Item(google.protobuf.message.Message):
     VAL_FIELD_NUMBER: builtins.int
     VERSION_FIELD_NUMBER: builtins.int
     id: builtins.str
-    val: builtins.str
+    val: builtins.bytes
     @property
     def version(self) -> global___Version: ...
     def __init__(
         self,
         *,
         id: builtins.str = ...,
-        val: builtins.str = ...,
+        val: builtins.bytes = ...,
         version: global___Version | None = ...,
     ) -> None: ...
     def HasField(self, field_name: typing_extensions.Literal["version", b"version"]) -> builtins.bool: ...
@@ -179,335 +322,811 @@ class ReadResponse(google.protobuf.message.Message):
 global___ReadResponse = ReadResponse
 
 @typing_extensions.final
-class ScanRequest(google.protobuf.message.Message):
+class ReadHistoryRequest(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
     KEYGROUP_FIELD_NUMBER: builtins.int
     ID_FIELD_NUMBER: builtins.int
-    COUNT_FIELD_NUMBER: builtins.int
     keygroup: builtins.str
     id: builtins.str
-    count: builtins.int
     def __init__(
         self,
         *,
         keygroup: builtins.str = ...,
         id: builtins.str = ...,
-        count: builtins.int = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["count", b"count", "id", b"id", "keygroup", b"keygroup"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["id", b"id", "keygroup", b"keygroup"]) -> None: ...
 
-global___ScanRequest = ScanRequest
+global___ReadHistoryRequest = ReadHistoryRequest
 
 @typing_extensions.final
-class ScanResponse(google.protobuf.message.Message):
+class Revision(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
-    DATA_FIELD_NUMBER: builtins.int
+    VAL_FIELD_NUMBER: builtins.int
+    VERSION_FIELD_NUMBER: builtins.int
+    TOMBSTONED_FIELD_NUMBER: builtins.int
+    REPLACED_FIELD_NUMBER: builtins.int
+    val: builtins.bytes
     @property
-    def data(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___Item]: ...
+    def version(self) -> global___Version: ...
+    tombstoned: builtins.bool
+    replaced: builtins.int
     def __init__(
         self,
         *,
-        data: collections.abc.Iterable[global___Item] | None = ...,
+        val: builtins.bytes = ...,
+        version: global___Version | None = ...,
+        tombstoned: builtins.bool = ...,
+        replaced: builtins.int = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["data", b"data"]) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["version", b"version"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["replaced", b"replaced", "tombstoned", b"tombstoned", "val", b"val", "version", b"version"]) -> None: ...
 
-global___ScanResponse = ScanResponse
+global___Revision = Revision
 
 @typing_extensions.final
-class KeysRequest(google.protobuf.message.Message):
+class ReadHistoryResponse(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
-    KEYGROUP_FIELD_NUMBER: builtins.int
-    ID_FIELD_NUMBER: builtins.int
-    COUNT_FIELD_NUMBER: builtins.int
-    keygroup: builtins.str
-    id: builtins.str
-    count: builtins.int
+    REVISIONS_FIELD_NUMBER: builtins.int
+    @property
+    def revisions(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___Revision]: ...
     def __init__(
         self,
         *,
-        keygroup: builtins.str = ...,
-        id: builtins.str = ...,
-        count: builtins.int = ...,
+        revisions: collections.abc.Iterable[global___Revision] | None = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["count", b"count", "id", b"id", "keygroup", b"keygroup"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["revisions", b"revisions"]) -> None: ...
 
-global___KeysRequest = KeysRequest
+global___ReadHistoryResponse = ReadHistoryResponse
 
 @typing_extensions.final
-class Key(google.protobuf.message.Message):
+class ReadAtRequest(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
+    KEYGROUP_FIELD_NUMBER: builtins.int
     ID_FIELD_NUMBER: builtins.int
     VERSION_FIELD_NUMBER: builtins.int
+    keygroup: builtins.str
     id: builtins.str
     @property
     def version(self) -> global___Version: ...
     def __init__(
         self,
         *,
+        keygroup: builtins.str = ...,
         id: builtins.str = ...,
         version: global___Version | None = ...,
     ) -> None: ...
     def HasField(self, field_name: typing_extensions.Literal["version", b"version"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["id", b"id", "version", b"version"]) -> None: ...
-
-global___Key = Key
-
-@typing_extensions.final
-class KeysResponse(google.protobuf.message.Message):
-    DESCRIPTOR: google.protobuf.descriptor.Descriptor
-
-    KEYS_FIELD_NUMBER: builtins.int
-    @property
-    def keys(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___Key]: ...
-    def __init__(
-        self,
-        *,
-        keys: collections.abc.Iterable[global___Key] | None = ...,
-    ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["keys", b"keys"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["id", b"id", "keygroup", b"keygroup", "version", b"version"]) -> None: ...
 
-global___KeysResponse = KeysResponse
+global___ReadAtRequest = ReadAtRequest
 
 @typing_extensions.final
-class UpdateRequest(google.protobuf.message.Message):
+class ScanRequest(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
     KEYGROUP_FIELD_NUMBER: builtins.int
     ID_FIELD_NUMBER: builtins.int
-    DATA_FIELD_NUMBER: builtins.int
-    VERSIONS_FIELD_NUMBER: builtins.int
+    COUNT_FIELD_NUMBER: builtins.int
+    END_FIELD_NUMBER: builtins.int
+    PREFIX_FIELD_NUMBER: builtins.int
+    REVERSE_FIELD_NUMBER: builtins.int
+    CONTINUATION_TOKEN_FIELD_NUMBER: builtins.int
     keygroup: builtins.str
     id: builtins.str
-    data: builtins.str
-    @property
-    def versions(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___Version]: ...
+    count: builtins.int
+    end: builtins.str
+    prefix: builtins.str
+    reverse: builtins.bool
+    continuation_token: builtins.str
     def __init__(
         self,
         *,
         keygroup: builtins.str = ...,
         id: builtins.str = ...,
-        data: builtins.str = ...,
-        versions: collections.abc.Iterable[global___Version] | None = ...,
+        count: builtins.int = ...,
+        end: builtins.str = ...,
+        prefix: builtins.str = ...,
+        reverse: builtins.bool = ...,
+        continuation_token: builtins.str = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["data", b"data", "id", b"id", "keygroup", b"keygroup", "versions", b"versions"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["continuation_token", b"continuation_token", "count", b"count", "end", b"end", "id", b"id", "keygroup", b"keygroup", "prefix", b"prefix", "reverse", b"reverse"]) -> None: ...
 
-global___UpdateRequest = UpdateRequest
+global___ScanRequest = ScanRequest
 
 @typing_extensions.final
-class UpdateResponse(google.protobuf.message.Message):
+class ScanResponse(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
-    VERSION_FIELD_NUMBER: builtins.int
+    DATA_FIELD_NUMBER: builtins.int
+    CONTINUATION_TOKEN_FIELD_NUMBER: builtins.int
     @property
-    def version(self) -> global___Version: ...
+    def data(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___Item]: ...
+    continuation_token: builtins.str
     def __init__(
         self,
         *,
-        version: global___Version | None = ...,
+        data: collections.abc.Iterable[global___Item] | None = ...,
+        continuation_token: builtins.str = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["version", b"version"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["version", b"version"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["continuation_token", b"continuation_token", "data", b"data"]) -> None: ...
 
-global___UpdateResponse = UpdateResponse
+global___ScanResponse = ScanResponse
 
 @typing_extensions.final
-class AppendRequest(google.protobuf.message.Message):
+class BatchRequest(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
     KEYGROUP_FIELD_NUMBER: builtins.int
-    ID_FIELD_NUMBER: builtins.int
-    DATA_FIELD_NUMBER: builtins.int
+    OPERATIONS_FIELD_NUMBER: builtins.int
     keygroup: builtins.str
-    id: builtins.int
-    data: builtins.str
+    @property
+    def operations(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___BatchOperation]: ...
     def __init__(
         self,
         *,
         keygroup: builtins.str = ...,
-        id: builtins.int = ...,
-        data: builtins.str = ...,
+        operations: collections.abc.Iterable[global___BatchOperation] | None = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["data", b"data", "id", b"id", "keygroup", b"keygroup"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["keygroup", b"keygroup", "operations", b"operations"]) -> None: ...
 
-global___AppendRequest = AppendRequest
+global___BatchRequest = BatchRequest
 
 @typing_extensions.final
-class AppendResponse(google.protobuf.message.Message):
+class BatchOperation(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
     ID_FIELD_NUMBER: builtins.int
+    VAL_FIELD_NUMBER: builtins.int
+    DELETE_FIELD_NUMBER: builtins.int
+    TTL_FIELD_NUMBER: builtins.int
     id: builtins.str
+    val: builtins.bytes
+    delete: builtins.bool
+    ttl: builtins.int
     def __init__(
         self,
         *,
         id: builtins.str = ...,
+        val: builtins.bytes = ...,
+        delete: builtins.bool = ...,
+        ttl: builtins.int = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["id", b"id"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["delete", b"delete", "id", b"id", "ttl", b"ttl", "val", b"val"]) -> None: ...
 
-global___AppendResponse = AppendResponse
+global___BatchOperation = BatchOperation
 
 @typing_extensions.final
-class DeleteRequest(google.protobuf.message.Message):
+class BatchResponse(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
-    KEYGROUP_FIELD_NUMBER: builtins.int
-    ID_FIELD_NUMBER: builtins.int
     VERSIONS_FIELD_NUMBER: builtins.int
-    keygroup: builtins.str
-    id: builtins.str
     @property
     def versions(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___Version]: ...
     def __init__(
         self,
         *,
-        keygroup: builtins.str = ...,
-        id: builtins.str = ...,
         versions: collections.abc.Iterable[global___Version] | None = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["id", b"id", "keygroup", b"keygroup", "versions", b"versions"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["versions", b"versions"]) -> None: ...
 
-global___DeleteRequest = DeleteRequest
+global___BatchResponse = BatchResponse
 
 @typing_extensions.final
-class DeleteResponse(google.protobuf.message.Message):
+class QueryIndexRequest(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
-    VERSION_FIELD_NUMBER: builtins.int
-    @property
-    def version(self) -> global___Version: ...
+    KEYGROUP_FIELD_NUMBER: builtins.int
+    INDEX_FIELD_NUMBER: builtins.int
+    VALUE_FIELD_NUMBER: builtins.int
+    keygroup: builtins.str
+    index: builtins.str
+    value: builtins.str
     def __init__(
         self,
         *,
-        version: global___Version | None = ...,
+        keygroup: builtins.str = ...,
+        index: builtins.str = ...,
+        value: builtins.str = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["version", b"version"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["version", b"version"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["index", b"index", "keygroup", b"keygroup", "value", b"value"]) -> None: ...
 
-global___DeleteResponse = DeleteResponse
+global___QueryIndexRequest = QueryIndexRequest
 
 @typing_extensions.final
-class AddReplicaRequest(google.protobuf.message.Message):
+class QueryIndexResponse(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
-    KEYGROUP_FIELD_NUMBER: builtins.int
-    NODEID_FIELD_NUMBER: builtins.int
-    EXPIRY_FIELD_NUMBER: builtins.int
-    keygroup: builtins.str
-    nodeId: builtins.str
-    expiry: builtins.int
+    DATA_FIELD_NUMBER: builtins.int
+    @property
+    def data(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___Item]: ...
     def __init__(
         self,
         *,
-        keygroup: builtins.str = ...,
-        nodeId: builtins.str = ...,
-        expiry: builtins.int = ...,
+        data: collections.abc.Iterable[global___Item] | None = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["expiry", b"expiry", "keygroup", b"keygroup", "nodeId", b"nodeId"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["data", b"data"]) -> None: ...
 
-global___AddReplicaRequest = AddReplicaRequest
+global___QueryIndexResponse = QueryIndexResponse
 
 @typing_extensions.final
-class GetKeygroupInfoRequest(google.protobuf.message.Message):
+class IncrementRequest(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
     KEYGROUP_FIELD_NUMBER: builtins.int
+    ID_FIELD_NUMBER: builtins.int
+    DELTA_FIELD_NUMBER: builtins.int
     keygroup: builtins.str
+    id: builtins.str
+    delta: builtins.int
     def __init__(
         self,
         *,
         keygroup: builtins.str = ...,
+        id: builtins.str = ...,
+        delta: builtins.int = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["keygroup", b"keygroup"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["delta", b"delta", "id", b"id", "keygroup", b"keygroup"]) -> None: ...
 
-global___GetKeygroupInfoRequest = GetKeygroupInfoRequest
+global___IncrementRequest = IncrementRequest
 
 @typing_extensions.final
-class GetKeygroupInfoResponse(google.protobuf.message.Message):
+class IncrementResponse(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
-    MUTABLE_FIELD_NUMBER: builtins.int
-    REPLICA_FIELD_NUMBER: builtins.int
-    mutable: builtins.bool
+    VALUE_FIELD_NUMBER: builtins.int
+    VERSION_FIELD_NUMBER: builtins.int
+    value: builtins.int
     @property
-    def replica(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___KeygroupReplica]: ...
-    def __init__(
-        self,
-        *,
-        mutable: builtins.bool = ...,
-        replica: collections.abc.Iterable[global___KeygroupReplica] | None = ...,
-    ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["mutable", b"mutable", "replica", b"replica"]) -> None: ...
-
-global___GetKeygroupInfoResponse = GetKeygroupInfoResponse
-
-@typing_extensions.final
-class KeygroupReplica(google.protobuf.message.Message):
-    DESCRIPTOR: google.protobuf.descriptor.Descriptor
-
-    NODEID_FIELD_NUMBER: builtins.int
-    EXPIRY_FIELD_NUMBER: builtins.int
-    HOST_FIELD_NUMBER: builtins.int
-    nodeId: builtins.str
-    expiry: builtins.int
-    host: builtins.str
+    def version(self) -> global___Version: ...
     def __init__(
         self,
         *,
-        nodeId: builtins.str = ...,
-        expiry: builtins.int = ...,
-        host: builtins.str = ...,
+        value: builtins.int = ...,
+        version: global___Version | None = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["expiry", b"expiry", "host", b"host", "nodeId", b"nodeId"]) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["version", b"version"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["value", b"value", "version", b"version"]) -> None: ...
 
-global___KeygroupReplica = KeygroupReplica
+global___IncrementResponse = IncrementResponse
 
 @typing_extensions.final
-class RemoveReplicaRequest(google.protobuf.message.Message):
+class SetRequest(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
     KEYGROUP_FIELD_NUMBER: builtins.int
-    NODEID_FIELD_NUMBER: builtins.int
+    ID_FIELD_NUMBER: builtins.int
+    ELEMENTS_FIELD_NUMBER: builtins.int
     keygroup: builtins.str
-    nodeId: builtins.str
+    id: builtins.str
+    @property
+    def elements(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]: ...
     def __init__(
         self,
         *,
         keygroup: builtins.str = ...,
-        nodeId: builtins.str = ...,
+        id: builtins.str = ...,
+        elements: collections.abc.Iterable[builtins.str] | None = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["keygroup", b"keygroup", "nodeId", b"nodeId"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["elements", b"elements", "id", b"id", "keygroup", b"keygroup"]) -> None: ...
 
-global___RemoveReplicaRequest = RemoveReplicaRequest
+global___SetRequest = SetRequest
 
 @typing_extensions.final
-class GetReplicaRequest(google.protobuf.message.Message):
+class SetResponse(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
-    NODEID_FIELD_NUMBER: builtins.int
-    nodeId: builtins.str
+    ELEMENTS_FIELD_NUMBER: builtins.int
+    VERSION_FIELD_NUMBER: builtins.int
+    @property
+    def elements(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]: ...
+    @property
+    def version(self) -> global___Version: ...
     def __init__(
         self,
         *,
-        nodeId: builtins.str = ...,
+        elements: collections.abc.Iterable[builtins.str] | None = ...,
+        version: global___Version | None = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["nodeId", b"nodeId"]) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["version", b"version"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["elements", b"elements", "version", b"version"]) -> None: ...
 
-global___GetReplicaRequest = GetReplicaRequest
+global___SetResponse = SetResponse
 
 @typing_extensions.final
-class GetReplicaResponse(google.protobuf.message.Message):
+class MapPutRequest(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
-    NODEID_FIELD_NUMBER: builtins.int
-    HOST_FIELD_NUMBER: builtins.int
-    nodeId: builtins.str
-    host: builtins.str
-    def __init__(
-        self,
+    @typing_extensions.final
+    class EntriesEntry(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        KEY_FIELD_NUMBER: builtins.int
+        VALUE_FIELD_NUMBER: builtins.int
+        key: builtins.str
+        value: builtins.str
+        def __init__(
+            self,
+            *,
+            key: builtins.str = ...,
+            value: builtins.str = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
+
+    KEYGROUP_FIELD_NUMBER: builtins.int
+    ID_FIELD_NUMBER: builtins.int
+    ENTRIES_FIELD_NUMBER: builtins.int
+    keygroup: builtins.str
+    id: builtins.str
+    @property
+    def entries(self) -> google.protobuf.internal.containers.ScalarMap[builtins.str, builtins.str]: ...
+    def __init__(
+        self,
+        *,
+        keygroup: builtins.str = ...,
+        id: builtins.str = ...,
+        entries: collections.abc.Mapping[builtins.str, builtins.str] | None = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["entries", b"entries", "id", b"id", "keygroup", b"keygroup"]) -> None: ...
+
+global___MapPutRequest = MapPutRequest
+
+@typing_extensions.final
+class MapRemoveRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEYGROUP_FIELD_NUMBER: builtins.int
+    ID_FIELD_NUMBER: builtins.int
+    KEYS_FIELD_NUMBER: builtins.int
+    keygroup: builtins.str
+    id: builtins.str
+    @property
+    def keys(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]: ...
+    def __init__(
+        self,
+        *,
+        keygroup: builtins.str = ...,
+        id: builtins.str = ...,
+        keys: collections.abc.Iterable[builtins.str] | None = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["id", b"id", "keygroup", b"keygroup", "keys", b"keys"]) -> None: ...
+
+global___MapRemoveRequest = MapRemoveRequest
+
+@typing_extensions.final
+class MapResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    @typing_extensions.final
+    class EntriesEntry(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        KEY_FIELD_NUMBER: builtins.int
+        VALUE_FIELD_NUMBER: builtins.int
+        key: builtins.str
+        value: builtins.str
+        def __init__(
+            self,
+            *,
+            key: builtins.str = ...,
+            value: builtins.str = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
+
+    ENTRIES_FIELD_NUMBER: builtins.int
+    VERSION_FIELD_NUMBER: builtins.int
+    @property
+    def entries(self) -> google.protobuf.internal.containers.ScalarMap[builtins.str, builtins.str]: ...
+    @property
+    def version(self) -> global___Version: ...
+    def __init__(
+        self,
+        *,
+        entries: collections.abc.Mapping[builtins.str, builtins.str] | None = ...,
+        version: global___Version | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["version", b"version"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["entries", b"entries", "version", b"version"]) -> None: ...
+
+global___MapResponse = MapResponse
+
+@typing_extensions.final
+class WatchRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEYGROUP_FIELD_NUMBER: builtins.int
+    ID_PREFIX_FIELD_NUMBER: builtins.int
+    FROM_VERSION_FIELD_NUMBER: builtins.int
+    keygroup: builtins.str
+    id_prefix: builtins.str
+    @property
+    def from_version(self) -> global___Version: ...
+    def __init__(
+        self,
+        *,
+        keygroup: builtins.str = ...,
+        id_prefix: builtins.str = ...,
+        from_version: global___Version | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["from_version", b"from_version"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["from_version", b"from_version", "id_prefix", b"id_prefix", "keygroup", b"keygroup"]) -> None: ...
+
+global___WatchRequest = WatchRequest
+
+@typing_extensions.final
+class WatchEvent(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    ID_FIELD_NUMBER: builtins.int
+    VAL_FIELD_NUMBER: builtins.int
+    VERSION_FIELD_NUMBER: builtins.int
+    TOMBSTONED_FIELD_NUMBER: builtins.int
+    id: builtins.str
+    val: builtins.bytes
+    @property
+    def version(self) -> global___Version: ...
+    tombstoned: builtins.bool
+    def __init__(
+        self,
+        *,
+        id: builtins.str = ...,
+        val: builtins.bytes = ...,
+        version: global___Version | None = ...,
+        tombstoned: builtins.bool = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["version", b"version"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["id", b"id", "tombstoned", b"tombstoned", "val", b"val", "version", b"version"]) -> None: ...
+
+global___WatchEvent = WatchEvent
+
+@typing_extensions.final
+class KeysRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEYGROUP_FIELD_NUMBER: builtins.int
+    ID_FIELD_NUMBER: builtins.int
+    COUNT_FIELD_NUMBER: builtins.int
+    keygroup: builtins.str
+    id: builtins.str
+    count: builtins.int
+    def __init__(
+        self,
+        *,
+        keygroup: builtins.str = ...,
+        id: builtins.str = ...,
+        count: builtins.int = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["count", b"count", "id", b"id", "keygroup", b"keygroup"]) -> None: ...
+
+global___KeysRequest = KeysRequest
+
+@typing_extensions.final
+class Key(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    ID_FIELD_NUMBER: builtins.int
+    VERSION_FIELD_NUMBER: builtins.int
+    id: builtins.str
+    @property
+    def version(self) -> global___Version: ...
+    def __init__(
+        self,
+        *,
+        id: builtins.str = ...,
+        version: global___Version | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["version", b"version"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["id", b"id", "version", b"version"]) -> None: ...
+
+global___Key = Key
+
+@typing_extensions.final
+class KeysResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEYS_FIELD_NUMBER: builtins.int
+    @property
+    def keys(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___Key]: ...
+    def __init__(
+        self,
+        *,
+        keys: collections.abc.Iterable[global___Key] | None = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["keys", b"keys"]) -> None: ...
+
+global___KeysResponse = KeysResponse
+
+@typing_extensions.final
+class UpdateRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEYGROUP_FIELD_NUMBER: builtins.int
+    ID_FIELD_NUMBER: builtins.int
+    DATA_FIELD_NUMBER: builtins.int
+    VERSIONS_FIELD_NUMBER: builtins.int
+    PRECONDITION_FIELD_NUMBER: builtins.int
+    TTL_FIELD_NUMBER: builtins.int
+    keygroup: builtins.str
+    id: builtins.str
+    data: builtins.bytes
+    @property
+    def versions(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___Version]: ...
+    @property
+    def precondition(self) -> global___Precondition: ...
+    ttl: builtins.int
+    def __init__(
+        self,
+        *,
+        keygroup: builtins.str = ...,
+        id: builtins.str = ...,
+        data: builtins.bytes = ...,
+        versions: collections.abc.Iterable[global___Version] | None = ...,
+        precondition: global___Precondition | None = ...,
+        ttl: builtins.int = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["precondition", b"precondition"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["data", b"data", "id", b"id", "keygroup", b"keygroup", "precondition", b"precondition", "ttl", b"ttl", "versions", b"versions"]) -> None: ...
+
+global___UpdateRequest = UpdateRequest
+
+@typing_extensions.final
+class UpdateResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    VERSION_FIELD_NUMBER: builtins.int
+    @property
+    def version(self) -> global___Version: ...
+    def __init__(
+        self,
+        *,
+        version: global___Version | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["version", b"version"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["version", b"version"]) -> None: ...
+
+global___UpdateResponse = UpdateResponse
+
+@typing_extensions.final
+class AppendRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEYGROUP_FIELD_NUMBER: builtins.int
+    ID_FIELD_NUMBER: builtins.int
+    DATA_FIELD_NUMBER: builtins.int
+    TTL_FIELD_NUMBER: builtins.int
+    keygroup: builtins.str
+    id: builtins.int
+    data: builtins.bytes
+    ttl: builtins.int
+    def __init__(
+        self,
+        *,
+        keygroup: builtins.str = ...,
+        id: builtins.int = ...,
+        data: builtins.bytes = ...,
+        ttl: builtins.int = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["data", b"data", "id", b"id", "keygroup", b"keygroup", "ttl", b"ttl"]) -> None: ...
+
+global___AppendRequest = AppendRequest
+
+@typing_extensions.final
+class AppendResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    ID_FIELD_NUMBER: builtins.int
+    id: builtins.str
+    def __init__(
+        self,
+        *,
+        id: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["id", b"id"]) -> None: ...
+
+global___AppendResponse = AppendResponse
+
+@typing_extensions.final
+class DeleteRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEYGROUP_FIELD_NUMBER: builtins.int
+    ID_FIELD_NUMBER: builtins.int
+    VERSIONS_FIELD_NUMBER: builtins.int
+    PRECONDITION_FIELD_NUMBER: builtins.int
+    keygroup: builtins.str
+    id: builtins.str
+    @property
+    def versions(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___Version]: ...
+    @property
+    def precondition(self) -> global___Precondition: ...
+    def __init__(
+        self,
+        *,
+        keygroup: builtins.str = ...,
+        id: builtins.str = ...,
+        versions: collections.abc.Iterable[global___Version] | None = ...,
+        precondition: global___Precondition | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["precondition", b"precondition"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["id", b"id", "keygroup", b"keygroup", "precondition", b"precondition", "versions", b"versions"]) -> None: ...
+
+global___DeleteRequest = DeleteRequest
+
+@typing_extensions.final
+class Precondition(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    IF_ABSENT_FIELD_NUMBER: builtins.int
+    IF_VERSION_FIELD_NUMBER: builtins.int
+    IF_VALUE_HASH_FIELD_NUMBER: builtins.int
+    if_absent: builtins.bool
+    @property
+    def if_version(self) -> global___Version: ...
+    if_value_hash: builtins.str
+    def __init__(
+        self,
+        *,
+        if_absent: builtins.bool = ...,
+        if_version: global___Version | None = ...,
+        if_value_hash: builtins.str = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["if_version", b"if_version"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["if_absent", b"if_absent", "if_value_hash", b"if_value_hash", "if_version", b"if_version"]) -> None: ...
+
+global___Precondition = Precondition
+
+@typing_extensions.final
+class DeleteResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    VERSION_FIELD_NUMBER: builtins.int
+    @property
+    def version(self) -> global___Version: ...
+    def __init__(
+        self,
+        *,
+        version: global___Version | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["version", b"version"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["version", b"version"]) -> None: ...
+
+global___DeleteResponse = DeleteResponse
+
+@typing_extensions.final
+class AddReplicaRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEYGROUP_FIELD_NUMBER: builtins.int
+    NODEID_FIELD_NUMBER: builtins.int
+    EXPIRY_FIELD_NUMBER: builtins.int
+    keygroup: builtins.str
+    nodeId: builtins.str
+    expiry: builtins.int
+    def __init__(
+        self,
+        *,
+        keygroup: builtins.str = ...,
+        nodeId: builtins.str = ...,
+        expiry: builtins.int = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["expiry", b"expiry", "keygroup", b"keygroup", "nodeId", b"nodeId"]) -> None: ...
+
+global___AddReplicaRequest = AddReplicaRequest
+
+@typing_extensions.final
+class GetKeygroupInfoRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEYGROUP_FIELD_NUMBER: builtins.int
+    keygroup: builtins.str
+    def __init__(
+        self,
+        *,
+        keygroup: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["keygroup", b"keygroup"]) -> None: ...
+
+global___GetKeygroupInfoRequest = GetKeygroupInfoRequest
+
+@typing_extensions.final
+class GetKeygroupInfoResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    MUTABLE_FIELD_NUMBER: builtins.int
+    REPLICA_FIELD_NUMBER: builtins.int
+    QUOTA_FIELD_NUMBER: builtins.int
+    USAGE_FIELD_NUMBER: builtins.int
+    REPLICATION_POLICY_FIELD_NUMBER: builtins.int
+    mutable: builtins.bool
+    @property
+    def replica(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___KeygroupReplica]: ...
+    @property
+    def quota(self) -> global___Quota: ...
+    @property
+    def usage(self) -> global___Usage: ...
+    @property
+    def replication_policy(self) -> global___ReplicationPolicy: ...
+    def __init__(
+        self,
+        *,
+        mutable: builtins.bool = ...,
+        replica: collections.abc.Iterable[global___KeygroupReplica] | None = ...,
+        quota: global___Quota | None = ...,
+        usage: global___Usage | None = ...,
+        replication_policy: global___ReplicationPolicy | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["quota", b"quota", "replication_policy", b"replication_policy", "usage", b"usage"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["mutable", b"mutable", "quota", b"quota", "replica", b"replica", "replication_policy", b"replication_policy", "usage", b"usage"]) -> None: ...
+
+global___GetKeygroupInfoResponse = GetKeygroupInfoResponse
+
+@typing_extensions.final
+class KeygroupReplica(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    NODEID_FIELD_NUMBER: builtins.int
+    EXPIRY_FIELD_NUMBER: builtins.int
+    HOST_FIELD_NUMBER: builtins.int
+    nodeId: builtins.str
+    expiry: builtins.int
+    host: builtins.str
+    def __init__(
+        self,
+        *,
+        nodeId: builtins.str = ...,
+        expiry: builtins.int = ...,
+        host: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["expiry", b"expiry", "host", b"host", "nodeId", b"nodeId"]) -> None: ...
+
+global___KeygroupReplica = KeygroupReplica
+
+@typing_extensions.final
+class RemoveReplicaRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEYGROUP_FIELD_NUMBER: builtins.int
+    NODEID_FIELD_NUMBER: builtins.int
+    keygroup: builtins.str
+    nodeId: builtins.str
+    def __init__(
+        self,
+        *,
+        keygroup: builtins.str = ...,
+        nodeId: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["keygroup", b"keygroup", "nodeId", b"nodeId"]) -> None: ...
+
+global___RemoveReplicaRequest = RemoveReplicaRequest
+
+@typing_extensions.final
+class GetReplicaRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    NODEID_FIELD_NUMBER: builtins.int
+    nodeId: builtins.str
+    def __init__(
+        self,
+        *,
+        nodeId: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["nodeId", b"nodeId"]) -> None: ...
+
+global___GetReplicaRequest = GetReplicaRequest
+
+@typing_extensions.final
+class GetReplicaResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    NODEID_FIELD_NUMBER: builtins.int
+    HOST_FIELD_NUMBER: builtins.int
+    nodeId: builtins.str
+    host: builtins.str
+    def __init__(
+        self,
         *,
         nodeId: builtins.str = ...,
         host: builtins.str = ...,
@@ -544,11 +1163,176 @@ class GetAllReplicaResponse(google.protobuf.message.Message):
     def __init__(
         self,
         *,
-        replicas: collections.abc.Iterable[global___Replica] | None = ...,
+        replicas: collections.abc.Iterable[global___Replica] | None = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["replicas", b"replicas"]) -> None: ...
+
+global___GetAllReplicaResponse = GetAllReplicaResponse
+
+@typing_extensions.final
+class Location(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    LAT_FIELD_NUMBER: builtins.int
+    LNG_FIELD_NUMBER: builtins.int
+    lat: builtins.float
+    lng: builtins.float
+    def __init__(
+        self,
+        *,
+        lat: builtins.float = ...,
+        lng: builtins.float = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["lat", b"lat", "lng", b"lng"]) -> None: ...
+
+global___Location = Location
+
+@typing_extensions.final
+class GetNearestReplicasRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEYGROUP_FIELD_NUMBER: builtins.int
+    LAT_FIELD_NUMBER: builtins.int
+    LNG_FIELD_NUMBER: builtins.int
+    N_FIELD_NUMBER: builtins.int
+    keygroup: builtins.str
+    lat: builtins.float
+    lng: builtins.float
+    n: builtins.int
+    def __init__(
+        self,
+        *,
+        keygroup: builtins.str = ...,
+        lat: builtins.float = ...,
+        lng: builtins.float = ...,
+        n: builtins.int = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["keygroup", b"keygroup", "lat", b"lat", "lng", b"lng", "n", b"n"]) -> None: ...
+
+global___GetNearestReplicasRequest = GetNearestReplicasRequest
+
+@typing_extensions.final
+class NearestReplica(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    NODEID_FIELD_NUMBER: builtins.int
+    HOST_FIELD_NUMBER: builtins.int
+    EXPIRY_FIELD_NUMBER: builtins.int
+    DISTANCE_FIELD_NUMBER: builtins.int
+    nodeId: builtins.str
+    host: builtins.str
+    expiry: builtins.int
+    distance: builtins.float
+    def __init__(
+        self,
+        *,
+        nodeId: builtins.str = ...,
+        host: builtins.str = ...,
+        expiry: builtins.int = ...,
+        distance: builtins.float = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["distance", b"distance", "expiry", b"expiry", "host", b"host", "nodeId", b"nodeId"]) -> None: ...
+
+global___NearestReplica = NearestReplica
+
+@typing_extensions.final
+class GetNearestReplicasResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    REPLICAS_FIELD_NUMBER: builtins.int
+    @property
+    def replicas(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___NearestReplica]: ...
+    def __init__(
+        self,
+        *,
+        replicas: collections.abc.Iterable[global___NearestReplica] | None = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["replicas", b"replicas"]) -> None: ...
+
+global___GetNearestReplicasResponse = GetNearestReplicasResponse
+
+@typing_extensions.final
+class ProposeReplicasRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEYGROUP_FIELD_NUMBER: builtins.int
+    LOCATIONS_FIELD_NUMBER: builtins.int
+    N_FIELD_NUMBER: builtins.int
+    keygroup: builtins.str
+    @property
+    def locations(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___Location]: ...
+    n: builtins.int
+    def __init__(
+        self,
+        *,
+        keygroup: builtins.str = ...,
+        locations: collections.abc.Iterable[global___Location] | None = ...,
+        n: builtins.int = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["keygroup", b"keygroup", "locations", b"locations", "n", b"n"]) -> None: ...
+
+global___ProposeReplicasRequest = ProposeReplicasRequest
+
+@typing_extensions.final
+class ProposeReplicasResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    REPLICAS_FIELD_NUMBER: builtins.int
+    MEAN_DISTANCE_FIELD_NUMBER: builtins.int
+    @property
+    def replicas(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___Replica]: ...
+    mean_distance: builtins.float
+    def __init__(
+        self,
+        *,
+        replicas: collections.abc.Iterable[global___Replica] | None = ...,
+        mean_distance: builtins.float = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["mean_distance", b"mean_distance", "replicas", b"replicas"]) -> None: ...
+
+global___ProposeReplicasResponse = ProposeReplicasResponse
+
+@typing_extensions.final
+class DecommissionNodeRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    NODEID_FIELD_NUMBER: builtins.int
+    nodeId: builtins.str
+    def __init__(
+        self,
+        *,
+        nodeId: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["nodeId", b"nodeId"]) -> None: ...
+
+global___DecommissionNodeRequest = DecommissionNodeRequest
+
+@typing_extensions.final
+class DecommissionProgress(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    STEP_FIELD_NUMBER: builtins.int
+    KEYGROUP_FIELD_NUMBER: builtins.int
+    REPLICA_FIELD_NUMBER: builtins.int
+    DONE_FIELD_NUMBER: builtins.int
+    TOTAL_FIELD_NUMBER: builtins.int
+    step: global___DecommissionStep.ValueType
+    keygroup: builtins.str
+    replica: builtins.str
+    done: builtins.int
+    total: builtins.int
+    def __init__(
+        self,
+        *,
+        step: global___DecommissionStep.ValueType = ...,
+        keygroup: builtins.str = ...,
+        replica: builtins.str = ...,
+        done: builtins.int = ...,
+        total: builtins.int = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["replicas", b"replicas"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["done", b"done", "keygroup", b"keygroup", "replica", b"replica", "step", b"step", "total", b"total"]) -> None: ...
 
-global___GetAllReplicaResponse = GetAllReplicaResponse
+global___DecommissionProgress = DecommissionProgress
 
 @typing_extensions.final
 class GetKeygroupTriggerRequest(google.protobuf.message.Message):
@@ -679,3 +1463,418 @@ class RemoveUserRequest(google.protobuf.message.Message):
     def ClearField(self, field_name: typing_extensions.Literal["keygroup", b"keygroup", "role", b"role", "user", b"user"]) -> None: ...
 
 global___RemoveUserRequest = RemoveUserRequest
+
+@typing_extensions.final
+class CloneKeygroupRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    @typing_extensions.final
+    class ReplicasEntry(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        KEY_FIELD_NUMBER: builtins.int
+        VALUE_FIELD_NUMBER: builtins.int
+        key: builtins.str
+        value: builtins.int
+        def __init__(
+            self,
+            *,
+            key: builtins.str = ...,
+            value: builtins.int = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
+
+    KEYGROUP_FIELD_NUMBER: builtins.int
+    TARGET_FIELD_NUMBER: builtins.int
+    REPLICAS_FIELD_NUMBER: builtins.int
+    keygroup: builtins.str
+    target: builtins.str
+    @property
+    def replicas(self) -> google.protobuf.internal.containers.ScalarMap[builtins.str, builtins.int]: ...
+    def __init__(
+        self,
+        *,
+        keygroup: builtins.str = ...,
+        target: builtins.str = ...,
+        replicas: collections.abc.Mapping[builtins.str, builtins.int] | None = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["keygroup", b"keygroup", "replicas", b"replicas", "target", b"target"]) -> None: ...
+
+global___CloneKeygroupRequest = CloneKeygroupRequest
+
+@typing_extensions.final
+class RenameKeygroupRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEYGROUP_FIELD_NUMBER: builtins.int
+    TARGET_FIELD_NUMBER: builtins.int
+    keygroup: builtins.str
+    target: builtins.str
+    def __init__(
+        self,
+        *,
+        keygroup: builtins.str = ...,
+        target: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["keygroup", b"keygroup", "target", b"target"]) -> None: ...
+
+global___RenameKeygroupRequest = RenameKeygroupRequest
+
+@typing_extensions.final
+class SetKeygroupQuotaRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEYGROUP_FIELD_NUMBER: builtins.int
+    QUOTA_FIELD_NUMBER: builtins.int
+    keygroup: builtins.str
+    @property
+    def quota(self) -> global___Quota: ...
+    def __init__(
+        self,
+        *,
+        keygroup: builtins.str = ...,
+        quota: global___Quota | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["quota", b"quota"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["keygroup", b"keygroup", "quota", b"quota"]) -> None: ...
+
+global___SetKeygroupQuotaRequest = SetKeygroupQuotaRequest
+
+@typing_extensions.final
+class SetKeygroupReplicationPolicyRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEYGROUP_FIELD_NUMBER: builtins.int
+    REPLICATION_POLICY_FIELD_NUMBER: builtins.int
+    keygroup: builtins.str
+    @property
+    def replication_policy(self) -> global___ReplicationPolicy: ...
+    def __init__(
+        self,
+        *,
+        keygroup: builtins.str = ...,
+        replication_policy: global___ReplicationPolicy | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["replication_policy", b"replication_policy"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["keygroup", b"keygroup", "replication_policy", b"replication_policy"]) -> None: ...
+
+global___SetKeygroupReplicationPolicyRequest = SetKeygroupReplicationPolicyRequest
+
+@typing_extensions.final
+class ExportKeygroupRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEYGROUP_FIELD_NUMBER: builtins.int
+    keygroup: builtins.str
+    def __init__(
+        self,
+        *,
+        keygroup: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["keygroup", b"keygroup"]) -> None: ...
+
+global___ExportKeygroupRequest = ExportKeygroupRequest
+
+@typing_extensions.final
+class ArchiveChunk(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    HEADER_FIELD_NUMBER: builtins.int
+    ITEMS_FIELD_NUMBER: builtins.int
+    @property
+    def header(self) -> global___ArchiveHeader: ...
+    @property
+    def items(self) -> global___ArchiveItems: ...
+    def __init__(
+        self,
+        *,
+        header: global___ArchiveHeader | None = ...,
+        items: global___ArchiveItems | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["header", b"header", "items", b"items"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["header", b"header", "items", b"items"]) -> None: ...
+
+global___ArchiveChunk = ArchiveChunk
+
+@typing_extensions.final
+class ArchiveHeader(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    @typing_extensions.final
+    class ExpiriesEntry(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        KEY_FIELD_NUMBER: builtins.int
+        VALUE_FIELD_NUMBER: builtins.int
+        key: builtins.str
+        value: builtins.int
+        def __init__(
+            self,
+            *,
+            key: builtins.str = ...,
+            value: builtins.int = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
+
+    @typing_extensions.final
+    class IndexesEntry(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        KEY_FIELD_NUMBER: builtins.int
+        VALUE_FIELD_NUMBER: builtins.int
+        key: builtins.str
+        value: builtins.str
+        def __init__(
+            self,
+            *,
+            key: builtins.str = ...,
+            value: builtins.str = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
+
+    KEYGROUP_FIELD_NUMBER: builtins.int
+    MUTABLE_FIELD_NUMBER: builtins.int
+    SOURCE_FIELD_NUMBER: builtins.int
+    EXPIRIES_FIELD_NUMBER: builtins.int
+    INDEXES_FIELD_NUMBER: builtins.int
+    CONFLICT_POLICY_FIELD_NUMBER: builtins.int
+    TYPE_FIELD_NUMBER: builtins.int
+    HISTORY_VERSIONS_FIELD_NUMBER: builtins.int
+    HISTORY_WINDOW_FIELD_NUMBER: builtins.int
+    TRIGGERS_FIELD_NUMBER: builtins.int
+    USERS_FIELD_NUMBER: builtins.int
+    QUOTA_FIELD_NUMBER: builtins.int
+    COMPRESSION_FIELD_NUMBER: builtins.int
+    REPLICATION_POLICY_FIELD_NUMBER: builtins.int
+    keygroup: builtins.str
+    mutable: builtins.bool
+    source: builtins.str
+    @property
+    def expiries(self) -> google.protobuf.internal.containers.ScalarMap[builtins.str, builtins.int]: ...
+    @property
+    def indexes(self) -> google.protobuf.internal.containers.ScalarMap[builtins.str, builtins.str]: ...
+    conflict_policy: builtins.str
+    type: builtins.str
+    history_versions: builtins.int
+    history_window: builtins.int
+    @property
+    def triggers(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___Trigger]: ...
+    @property
+    def users(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___ArchiveUser]: ...
+    @property
+    def quota(self) -> global___Quota: ...
+    compression: builtins.str
+    @property
+    def replication_policy(self) -> global___ReplicationPolicy: ...
+    def __init__(
+        self,
+        *,
+        keygroup: builtins.str = ...,
+        mutable: builtins.bool = ...,
+        source: builtins.str = ...,
+        expiries: collections.abc.Mapping[builtins.str, builtins.int] | None = ...,
+        indexes: collections.abc.Mapping[builtins.str, builtins.str] | None = ...,
+        conflict_policy: builtins.str = ...,
+        type: builtins.str = ...,
+        history_versions: builtins.int = ...,
+        history_window: builtins.int = ...,
+        triggers: collections.abc.Iterable[global___Trigger] | None = ...,
+        users: collections.abc.Iterable[global___ArchiveUser] | None = ...,
+        quota: global___Quota | None = ...,
+        compression: builtins.str = ...,
+        replication_policy: global___ReplicationPolicy | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["quota", b"quota", "replication_policy", b"replication_policy"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["compression", b"compression", "conflict_policy", b"conflict_policy", "expiries", b"expiries", "history_versions", b"history_versions", "history_window", b"history_window", "indexes", b"indexes", "keygroup", b"keygroup", "mutable", b"mutable", "quota", b"quota", "replication_policy", b"replication_policy", "source", b"source", "triggers", b"triggers", "type", b"type", "users", b"users"]) -> None: ...
+
+global___ArchiveHeader = ArchiveHeader
+
+@typing_extensions.final
+class ArchiveUser(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    USER_FIELD_NUMBER: builtins.int
+    METHODS_FIELD_NUMBER: builtins.int
+    user: builtins.str
+    @property
+    def methods(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]: ...
+    def __init__(
+        self,
+        *,
+        user: builtins.str = ...,
+        methods: collections.abc.Iterable[builtins.str] | None = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["methods", b"methods", "user", b"user"]) -> None: ...
+
+global___ArchiveUser = ArchiveUser
+
+@typing_extensions.final
+class ArchiveItems(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    ITEMS_FIELD_NUMBER: builtins.int
+    @property
+    def items(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___ArchiveItem]: ...
+    def __init__(
+        self,
+        *,
+        items: collections.abc.Iterable[global___ArchiveItem] | None = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["items", b"items"]) -> None: ...
+
+global___ArchiveItems = ArchiveItems
+
+@typing_extensions.final
+class ArchiveItem(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    ID_FIELD_NUMBER: builtins.int
+    VAL_FIELD_NUMBER: builtins.int
+    VERSION_FIELD_NUMBER: builtins.int
+    TOMBSTONED_FIELD_NUMBER: builtins.int
+    id: builtins.str
+    val: builtins.bytes
+    @property
+    def version(self) -> global___Version: ...
+    tombstoned: builtins.bool
+    def __init__(
+        self,
+        *,
+        id: builtins.str = ...,
+        val: builtins.bytes = ...,
+        version: global___Version | None = ...,
+        tombstoned: builtins.bool = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["version", b"version"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["id", b"id", "tombstoned", b"tombstoned", "val", b"val", "version", b"version"]) -> None: ...
+
+global___ArchiveItem = ArchiveItem
+
+@typing_extensions.final
+class ImportKeygroupRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    CHUNK_FIELD_NUMBER: builtins.int
+    REPLACE_FIELD_NUMBER: builtins.int
+    KEYGROUP_FIELD_NUMBER: builtins.int
+    @property
+    def chunk(self) -> global___ArchiveChunk: ...
+    replace: builtins.bool
+    keygroup: builtins.str
+    def __init__(
+        self,
+        *,
+        chunk: global___ArchiveChunk | None = ...,
+        replace: builtins.bool = ...,
+        keygroup: builtins.str = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["chunk", b"chunk"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["chunk", b"chunk", "keygroup", b"keygroup", "replace", b"replace"]) -> None: ...
+
+global___ImportKeygroupRequest = ImportKeygroupRequest
+
+@typing_extensions.final
+class ImportKeygroupResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    VERSIONS_FIELD_NUMBER: builtins.int
+    DELETED_FIELD_NUMBER: builtins.int
+    REPLICAS_FIELD_NUMBER: builtins.int
+    SKIPPED_FIELD_NUMBER: builtins.int
+    versions: builtins.int
+    deleted: builtins.int
+    @property
+    def replicas(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]: ...
+    @property
+    def skipped(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]: ...
+    def __init__(
+        self,
+        *,
+        versions: builtins.int = ...,
+        deleted: builtins.int = ...,
+        replicas: collections.abc.Iterable[builtins.str] | None = ...,
+        skipped: collections.abc.Iterable[builtins.str] | None = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["deleted", b"deleted", "replicas", b"replicas", "skipped", b"skipped", "versions", b"versions"]) -> None: ...
+
+global___ImportKeygroupResponse = ImportKeygroupResponse
+
+@typing_extensions.final
+class ReadChangesRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEYGROUP_FIELD_NUMBER: builtins.int
+    FROM_SEQ_FIELD_NUMBER: builtins.int
+    CONSUMER_FIELD_NUMBER: builtins.int
+    keygroup: builtins.str
+    from_seq: builtins.int
+    consumer: builtins.str
+    def __init__(
+        self,
+        *,
+        keygroup: builtins.str = ...,
+        from_seq: builtins.int = ...,
+        consumer: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["consumer", b"consumer", "from_seq", b"from_seq", "keygroup", b"keygroup"]) -> None: ...
+
+global___ReadChangesRequest = ReadChangesRequest
+
+@typing_extensions.final
+class Change(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    SEQ_FIELD_NUMBER: builtins.int
+    ID_FIELD_NUMBER: builtins.int
+    VAL_FIELD_NUMBER: builtins.int
+    VERSION_FIELD_NUMBER: builtins.int
+    TOMBSTONED_FIELD_NUMBER: builtins.int
+    APPEND_FIELD_NUMBER: builtins.int
+    ORIGIN_FIELD_NUMBER: builtins.int
+    TIME_FIELD_NUMBER: builtins.int
+    seq: builtins.int
+    id: builtins.str
+    val: builtins.bytes
+    @property
+    def version(self) -> global___Version: ...
+    tombstoned: builtins.bool
+    append: builtins.bool
+    origin: builtins.str
+    time: builtins.int
+    def __init__(
+        self,
+        *,
+        seq: builtins.int = ...,
+        id: builtins.str = ...,
+        val: builtins.bytes = ...,
+        version: global___Version | None = ...,
+        tombstoned: builtins.bool = ...,
+        append: builtins.bool = ...,
+        origin: builtins.str = ...,
+        time: builtins.int = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["version", b"version"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["append", b"append", "id", b"id", "origin", b"origin", "seq", b"seq", "time", b"time", "tombstoned", b"tombstoned", "val", b"val", "version", b"version"]) -> None: ...
+
+global___Change = Change
+
+@typing_extensions.final
+class SetChangeCursorRequest(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEYGROUP_FIELD_NUMBER: builtins.int
+    CONSUMER_FIELD_NUMBER: builtins.int
+    SEQ_FIELD_NUMBER: builtins.int
+    keygroup: builtins.str
+    consumer: builtins.str
+    seq: builtins.int
+    def __init__(
+        self,
+        *,
+        keygroup: builtins.str = ...,
+        consumer: builtins.str = ...,
+        seq: builtins.int = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["consumer", b"consumer", "keygroup", b"keygroup", "seq", b"seq"]) -> None: ...
+
+global___SetChangeCursorRequest = SetChangeCursorRequest
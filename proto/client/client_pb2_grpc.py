@@ -105,6 +105,111 @@ class ClientStub(object):
                 request_serializer=client__pb2.RemoveUserRequest.SerializeToString,
                 response_deserializer=client__pb2.Empty.FromString,
                 )
+        self.Watch = channel.unary_stream(
+                '/mcc.fred.client.Client/Watch',
+                request_serializer=client__pb2.WatchRequest.SerializeToString,
+                response_deserializer=client__pb2.WatchEvent.FromString,
+                )
+        self.Batch = channel.unary_unary(
+                '/mcc.fred.client.Client/Batch',
+                request_serializer=client__pb2.BatchRequest.SerializeToString,
+                response_deserializer=client__pb2.BatchResponse.FromString,
+                )
+        self.QueryIndex = channel.unary_unary(
+                '/mcc.fred.client.Client/QueryIndex',
+                request_serializer=client__pb2.QueryIndexRequest.SerializeToString,
+                response_deserializer=client__pb2.QueryIndexResponse.FromString,
+                )
+        self.Increment = channel.unary_unary(
+                '/mcc.fred.client.Client/Increment',
+                request_serializer=client__pb2.IncrementRequest.SerializeToString,
+                response_deserializer=client__pb2.IncrementResponse.FromString,
+                )
+        self.SetAdd = channel.unary_unary(
+                '/mcc.fred.client.Client/SetAdd',
+                request_serializer=client__pb2.SetRequest.SerializeToString,
+                response_deserializer=client__pb2.SetResponse.FromString,
+                )
+        self.SetRemove = channel.unary_unary(
+                '/mcc.fred.client.Client/SetRemove',
+                request_serializer=client__pb2.SetRequest.SerializeToString,
+                response_deserializer=client__pb2.SetResponse.FromString,
+                )
+        self.MapPut = channel.unary_unary(
+                '/mcc.fred.client.Client/MapPut',
+                request_serializer=client__pb2.MapPutRequest.SerializeToString,
+                response_deserializer=client__pb2.MapResponse.FromString,
+                )
+        self.MapRemove = channel.unary_unary(
+                '/mcc.fred.client.Client/MapRemove',
+                request_serializer=client__pb2.MapRemoveRequest.SerializeToString,
+                response_deserializer=client__pb2.MapResponse.FromString,
+                )
+        self.ReadHistory = channel.unary_unary(
+                '/mcc.fred.client.Client/ReadHistory',
+                request_serializer=client__pb2.ReadHistoryRequest.SerializeToString,
+                response_deserializer=client__pb2.ReadHistoryResponse.FromString,
+                )
+        self.ReadAt = channel.unary_unary(
+                '/mcc.fred.client.Client/ReadAt',
+                request_serializer=client__pb2.ReadAtRequest.SerializeToString,
+                response_deserializer=client__pb2.ReadResponse.FromString,
+                )
+        self.ExportKeygroup = channel.unary_stream(
+                '/mcc.fred.client.Client/ExportKeygroup',
+                request_serializer=client__pb2.ExportKeygroupRequest.SerializeToString,
+                response_deserializer=client__pb2.ArchiveChunk.FromString,
+                )
+        self.ImportKeygroup = channel.stream_unary(
+                '/mcc.fred.client.Client/ImportKeygroup',
+                request_serializer=client__pb2.ImportKeygroupRequest.SerializeToString,
+                response_deserializer=client__pb2.ImportKeygroupResponse.FromString,
+                )
+        self.CloneKeygroup = channel.unary_unary(
+                '/mcc.fred.client.Client/CloneKeygroup',
+                request_serializer=client__pb2.CloneKeygroupRequest.SerializeToString,
+                response_deserializer=client__pb2.Empty.FromString,
+                )
+        self.RenameKeygroup = channel.unary_unary(
+                '/mcc.fred.client.Client/RenameKeygroup',
+                request_serializer=client__pb2.RenameKeygroupRequest.SerializeToString,
+                response_deserializer=client__pb2.Empty.FromString,
+                )
+        self.SetKeygroupQuota = channel.unary_unary(
+                '/mcc.fred.client.Client/SetKeygroupQuota',
+                request_serializer=client__pb2.SetKeygroupQuotaRequest.SerializeToString,
+                response_deserializer=client__pb2.Empty.FromString,
+                )
+        self.ReadChanges = channel.unary_stream(
+                '/mcc.fred.client.Client/ReadChanges',
+                request_serializer=client__pb2.ReadChangesRequest.SerializeToString,
+                response_deserializer=client__pb2.Change.FromString,
+                )
+        self.SetChangeCursor = channel.unary_unary(
+                '/mcc.fred.client.Client/SetChangeCursor',
+                request_serializer=client__pb2.SetChangeCursorRequest.SerializeToString,
+                response_deserializer=client__pb2.Empty.FromString,
+                )
+        self.SetKeygroupReplicationPolicy = channel.unary_unary(
+                '/mcc.fred.client.Client/SetKeygroupReplicationPolicy',
+                request_serializer=client__pb2.SetKeygroupReplicationPolicyRequest.SerializeToString,
+                response_deserializer=client__pb2.Empty.FromString,
+                )
+        self.GetNearestReplicas = channel.unary_unary(
+                '/mcc.fred.client.Client/GetNearestReplicas',
+                request_serializer=client__pb2.GetNearestReplicasRequest.SerializeToString,
+                response_deserializer=client__pb2.GetNearestReplicasResponse.FromString,
+                )
+        self.ProposeReplicas = channel.unary_unary(
+                '/mcc.fred.client.Client/ProposeReplicas',
+                request_serializer=client__pb2.ProposeReplicasRequest.SerializeToString,
+                response_deserializer=client__pb2.ProposeReplicasResponse.FromString,
+                )
+        self.DecommissionNode = channel.unary_stream(
+                '/mcc.fred.client.Client/DecommissionNode',
+                request_serializer=client__pb2.DecommissionNodeRequest.SerializeToString,
+                response_deserializer=client__pb2.DecommissionProgress.FromString,
+                )
 
 
 class ClientServicer(object):
@@ -219,6 +324,132 @@ class ClientServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def Watch(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Batch(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def QueryIndex(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Increment(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SetAdd(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SetRemove(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def MapPut(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def MapRemove(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReadHistory(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReadAt(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ExportKeygroup(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ImportKeygroup(self, request_iterator, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CloneKeygroup(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RenameKeygroup(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SetKeygroupQuota(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReadChanges(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SetChangeCursor(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SetKeygroupReplicationPolicy(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetNearestReplicas(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ProposeReplicas(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DecommissionNode(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_ClientServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -312,6 +543,111 @@ def add_ClientServicer_to_server(servicer, server):
                     request_deserializer=client__pb2.RemoveUserRequest.FromString,
                     response_serializer=client__pb2.Empty.SerializeToString,
             ),
+            'Watch': grpc.unary_stream_rpc_method_handler(
+                    servicer.Watch,
+                    request_deserializer=client__pb2.WatchRequest.FromString,
+                    response_serializer=client__pb2.WatchEvent.SerializeToString,
+            ),
+            'Batch': grpc.unary_unary_rpc_method_handler(
+                    servicer.Batch,
+                    request_deserializer=client__pb2.BatchRequest.FromString,
+                    response_serializer=client__pb2.BatchResponse.SerializeToString,
+            ),
+            'QueryIndex': grpc.unary_unary_rpc_method_handler(
+                    servicer.QueryIndex,
+                    request_deserializer=client__pb2.QueryIndexRequest.FromString,
+                    response_serializer=client__pb2.QueryIndexResponse.SerializeToString,
+            ),
+            'Increment': grpc.unary_unary_rpc_method_handler(
+                    servicer.Increment,
+                    request_deserializer=client__pb2.IncrementRequest.FromString,
+                    response_serializer=client__pb2.IncrementResponse.SerializeToString,
+            ),
+            'SetAdd': grpc.unary_unary_rpc_method_handler(
+                    servicer.SetAdd,
+                    request_deserializer=client__pb2.SetRequest.FromString,
+                    response_serializer=client__pb2.SetResponse.SerializeToString,
+            ),
+            'SetRemove': grpc.unary_unary_rpc_method_handler(
+                    servicer.SetRemove,
+                    request_deserializer=client__pb2.SetRequest.FromString,
+                    response_serializer=client__pb2.SetResponse.SerializeToString,
+            ),
+            'MapPut': grpc.unary_unary_rpc_method_handler(
+                    servicer.MapPut,
+                    request_deserializer=client__pb2.MapPutRequest.FromString,
+                    response_serializer=client__pb2.MapResponse.SerializeToString,
+            ),
+            'MapRemove': grpc.unary_unary_rpc_method_handler(
+                    servicer.MapRemove,
+                    request_deserializer=client__pb2.MapRemoveRequest.FromString,
+                    response_serializer=client__pb2.MapResponse.SerializeToString,
+            ),
+            'ReadHistory': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReadHistory,
+                    request_deserializer=client__pb2.ReadHistoryRequest.FromString,
+                    response_serializer=client__pb2.ReadHistoryResponse.SerializeToString,
+            ),
+            'ReadAt': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReadAt,
+                    request_deserializer=client__pb2.ReadAtRequest.FromString,
+                    response_serializer=client__pb2.ReadResponse.SerializeToString,
+            ),
+            'ExportKeygroup': grpc.unary_stream_rpc_method_handler(
+                    servicer.ExportKeygroup,
+                    request_deserializer=client__pb2.ExportKeygroupRequest.FromString,
+                    response_serializer=client__pb2.ArchiveChunk.SerializeToString,
+            ),
+            'ImportKeygroup': grpc.stream_unary_rpc_method_handler(
+                    servicer.ImportKeygroup,
+                    request_deserializer=client__pb2.ImportKeygroupRequest.FromString,
+                    response_serializer=client__pb2.ImportKeygroupResponse.SerializeToString,
+            ),
+            'CloneKeygroup': grpc.unary_unary_rpc_method_handler(
+                    servicer.CloneKeygroup,
+                    request_deserializer=client__pb2.CloneKeygroupRequest.FromString,
+                    response_serializer=client__pb2.Empty.SerializeToString,
+            ),
+            'RenameKeygroup': grpc.unary_unary_rpc_method_handler(
+                    servicer.RenameKeygroup,
+                    request_deserializer=client__pb2.RenameKeygroupRequest.FromString,
+                    response_serializer=client__pb2.Empty.SerializeToString,
+            ),
+            'SetKeygroupQuota': grpc.unary_unary_rpc_method_handler(
+                    servicer.SetKeygroupQuota,
+                    request_deserializer=client__pb2.SetKeygroupQuotaRequest.FromString,
+                    response_serializer=client__pb2.Empty.SerializeToString,
+            ),
+            'ReadChanges': grpc.unary_stream_rpc_method_handler(
+                    servicer.ReadChanges,
+                    request_deserializer=client__pb2.ReadChangesRequest.FromString,
+                    response_serializer=client__pb2.Change.SerializeToString,
+            ),
+            'SetChangeCursor': grpc.unary_unary_rpc_method_handler(
+                    servicer.SetChangeCursor,
+                    request_deserializer=client__pb2.SetChangeCursorRequest.FromString,
+                    response_serializer=client__pb2.Empty.SerializeToString,
+            ),
+            'SetKeygroupReplicationPolicy': grpc.unary_unary_rpc_method_handler(
+                    servicer.SetKeygroupReplicationPolicy,
+                    request_deserializer=client__pb2.SetKeygroupReplicationPolicyRequest.FromString,
+                    response_serializer=client__pb2.Empty.SerializeToString,
+            ),
+            'GetNearestReplicas': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetNearestReplicas,
+                    request_deserializer=client__pb2.GetNearestReplicasRequest.FromString,
+                    response_serializer=client__pb2.GetNearestReplicasResponse.SerializeToString,
+            ),
+            'ProposeReplicas': grpc.unary_unary_rpc_method_handler(
+                    servicer.ProposeReplicas,
+                    request_deserializer=client__pb2.ProposeReplicasRequest.FromString,
+                    response_serializer=client__pb2.ProposeReplicasResponse.SerializeToString,
+            ),
+            'DecommissionNode': grpc.unary_stream_rpc_method_handler(
+                    servicer.DecommissionNode,
+                    request_deserializer=client__pb2.DecommissionNodeRequest.FromString,
+                    response_serializer=client__pb2.DecommissionProgress.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'mcc.fred.client.Client', rpc_method_handlers)
@@ -628,3 +964,360 @@ class Client(object):
             client__pb2.Empty.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def Watch(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/mcc.fred.client.Client/Watch',
+            client__pb2.WatchRequest.SerializeToString,
+            client__pb2.WatchEvent.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def Batch(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/mcc.fred.client.Client/Batch',
+            client__pb2.BatchRequest.SerializeToString,
+            client__pb2.BatchResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def QueryIndex(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/mcc.fred.client.Client/QueryIndex',
+            client__pb2.QueryIndexRequest.SerializeToString,
+            client__pb2.QueryIndexResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def Increment(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/mcc.fred.client.Client/Increment',
+            client__pb2.IncrementRequest.SerializeToString,
+            client__pb2.IncrementResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def SetAdd(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/mcc.fred.client.Client/SetAdd',
+            client__pb2.SetRequest.SerializeToString,
+            client__pb2.SetResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def SetRemove(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/mcc.fred.client.Client/SetRemove',
+            client__pb2.SetRequest.SerializeToString,
+            client__pb2.SetResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def MapPut(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/mcc.fred.client.Client/MapPut',
+            client__pb2.MapPutRequest.SerializeToString,
+            client__pb2.MapResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def MapRemove(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/mcc.fred.client.Client/MapRemove',
+            client__pb2.MapRemoveRequest.SerializeToString,
+            client__pb2.MapResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ReadHistory(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/mcc.fred.client.Client/ReadHistory',
+            client__pb2.ReadHistoryRequest.SerializeToString,
+            client__pb2.ReadHistoryResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ReadAt(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/mcc.fred.client.Client/ReadAt',
+            client__pb2.ReadAtRequest.SerializeToString,
+            client__pb2.ReadResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ExportKeygroup(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/mcc.fred.client.Client/ExportKeygroup',
+            client__pb2.ExportKeygroupRequest.SerializeToString,
+            client__pb2.ArchiveChunk.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ImportKeygroup(request_iterator,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.stream_unary(request_iterator, target, '/mcc.fred.client.Client/ImportKeygroup',
+            client__pb2.ImportKeygroupRequest.SerializeToString,
+            client__pb2.ImportKeygroupResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def CloneKeygroup(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/mcc.fred.client.Client/CloneKeygroup',
+            client__pb2.CloneKeygroupRequest.SerializeToString,
+            client__pb2.Empty.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def RenameKeygroup(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/mcc.fred.client.Client/RenameKeygroup',
+            client__pb2.RenameKeygroupRequest.SerializeToString,
+            client__pb2.Empty.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def SetKeygroupQuota(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/mcc.fred.client.Client/SetKeygroupQuota',
+            client__pb2.SetKeygroupQuotaRequest.SerializeToString,
+            client__pb2.Empty.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ReadChanges(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/mcc.fred.client.Client/ReadChanges',
+            client__pb2.ReadChangesRequest.SerializeToString,
+            client__pb2.Change.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def SetChangeCursor(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/mcc.fred.client.Client/SetChangeCursor',
+            client__pb2.SetChangeCursorRequest.SerializeToString,
+            client__pb2.Empty.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def SetKeygroupReplicationPolicy(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/mcc.fred.client.Client/SetKeygroupReplicationPolicy',
+            client__pb2.SetKeygroupReplicationPolicyRequest.SerializeToString,
+            client__pb2.Empty.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetNearestReplicas(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/mcc.fred.client.Client/GetNearestReplicas',
+            client__pb2.GetNearestReplicasRequest.SerializeToString,
+            client__pb2.GetNearestReplicasResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ProposeReplicas(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/mcc.fred.client.Client/ProposeReplicas',
+            client__pb2.ProposeReplicasRequest.SerializeToString,
+            client__pb2.ProposeReplicasResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DecommissionNode(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/mcc.fred.client.Client/DecommissionNode',
+            client__pb2.DecommissionNodeRequest.SerializeToString,
+            client__pb2.DecommissionProgress.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
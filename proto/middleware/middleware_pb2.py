@@ -13,7 +13,7 @@ _sym_db = _symbol_database.Default()
 
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x10middleware.proto\x12\x13mcc.fred.middleware\"\x07\n\x05\x45mpty\"_\n\x15\x43reateKeygroupRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x0f\n\x07mutable\x18\x02 \x01(\x08\x12\x0e\n\x06\x65xpiry\x18\x03 \x01(\x03\x12\x13\n\x0b\x66irstNodeId\x18\x04 \x01(\t\")\n\x15\x44\x65leteKeygroupRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\"\x88\x01\n\x04Item\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0b\n\x03val\x18\x02 \x01(\t\x12\x37\n\x07version\x18\x03 \x03(\x0b\x32&.mcc.fred.middleware.Item.VersionEntry\x1a.\n\x0cVersionEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x04:\x02\x38\x01\">\n\x0bReadRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12\x11\n\tminExpiry\x18\x03 \x01(\x03\"8\n\x0cReadResponse\x12(\n\x05items\x18\x01 \x03(\x0b\x32\x19.mcc.fred.middleware.Item\":\n\x0bScanRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12\r\n\x05\x63ount\x18\x03 \x01(\x04\"7\n\x0cScanResponse\x12\'\n\x04\x64\x61ta\x18\x01 \x03(\x0b\x32\x19.mcc.fred.middleware.Data\":\n\x0bKeysRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12\r\n\x05\x63ount\x18\x03 \x01(\x04\"6\n\x0cKeysResponse\x12&\n\x04keys\x18\x01 \x03(\x0b\x32\x18.mcc.fred.middleware.Key\"\x11\n\x03Key\x12\n\n\x02id\x18\x01 \x01(\t\" \n\x04\x44\x61ta\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04\x64\x61ta\x18\x02 \x01(\t\";\n\rUpdateRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12\x0c\n\x04\x64\x61ta\x18\x03 \x01(\t\"/\n\rAppendRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x0c\n\x04\x64\x61ta\x18\x02 \x01(\t\"\x1c\n\x0e\x41ppendResponse\x12\n\n\x02id\x18\x01 \x01(\t\"\x9f\x01\n\rNotifyRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12@\n\x07version\x18\x03 \x03(\x0b\x32/.mcc.fred.middleware.NotifyRequest.VersionEntry\x1a.\n\x0cVersionEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x04:\x02\x38\x01\"8\n\x14\x43hooseReplicaRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x0e\n\x06nodeId\x18\x02 \x01(\t\"-\n\rDeleteRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\"E\n\x11\x41\x64\x64ReplicaRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x0e\n\x06nodeId\x18\x02 \x01(\t\x12\x0e\n\x06\x65xpiry\x18\x03 \x01(\x03\"*\n\x16GetKeygroupInfoRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\"a\n\x17GetKeygroupInfoResponse\x12\x0f\n\x07mutable\x18\x01 \x01(\x08\x12\x35\n\x07replica\x18\x02 \x03(\x0b\x32$.mcc.fred.middleware.KeygroupReplica\"?\n\x0fKeygroupReplica\x12\x0e\n\x06nodeId\x18\x01 \x01(\t\x12\x0e\n\x06\x65xpiry\x18\x02 \x01(\x03\x12\x0c\n\x04host\x18\x03 \x01(\t\"8\n\x14RemoveReplicaRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x0e\n\x06nodeId\x18\x02 \x01(\t\"#\n\x11GetReplicaRequest\x12\x0e\n\x06nodeId\x18\x01 \x01(\t\"2\n\x12GetReplicaResponse\x12\x0e\n\x06nodeId\x18\x01 \x01(\t\x12\x0c\n\x04host\x18\x02 \x01(\t\"\x16\n\x14GetAllReplicaRequest\"R\n\x15GetAllReplicaResponse\x12\x39\n\x08replicas\x18\x01 \x03(\x0b\x32\'.mcc.fred.middleware.GetReplicaResponse\"-\n\x19GetKeygroupTriggerRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\"L\n\x1aGetKeygroupTriggerResponse\x12.\n\x08triggers\x18\x01 \x03(\x0b\x32\x1c.mcc.fred.middleware.Trigger\"#\n\x07Trigger\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04host\x18\x02 \x01(\t\"M\n\x11\x41\x64\x64TriggerRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x11\n\ttriggerId\x18\x02 \x01(\t\x12\x13\n\x0btriggerHost\x18\x03 \x01(\t\";\n\x14RemoveTriggerRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x11\n\ttriggerId\x18\x02 \x01(\t\"Z\n\x0bUserRequest\x12\x0c\n\x04user\x18\x01 \x01(\t\x12\x10\n\x08keygroup\x18\x02 \x01(\t\x12+\n\x04role\x18\x03 \x01(\x0e\x32\x1d.mcc.fred.middleware.UserRole*s\n\x08UserRole\x12\x10\n\x0cReadKeygroup\x10\x00\x12\x11\n\rWriteKeygroup\x10\x01\x12\x14\n\x10\x43onfigureReplica\x10\x02\x12\x14\n\x10\x43onfigureTrigger\x10\x03\x12\x16\n\x12\x43onfigureKeygroups\x10\x04\x32\xc6\r\n\nMiddleware\x12X\n\x0e\x43reateKeygroup\x12*.mcc.fred.middleware.CreateKeygroupRequest\x1a\x1a.mcc.fred.middleware.Empty\x12X\n\x0e\x44\x65leteKeygroup\x12*.mcc.fred.middleware.DeleteKeygroupRequest\x1a\x1a.mcc.fred.middleware.Empty\x12K\n\x04Read\x12 .mcc.fred.middleware.ReadRequest\x1a!.mcc.fred.middleware.ReadResponse\x12K\n\x04Scan\x12 .mcc.fred.middleware.ScanRequest\x1a!.mcc.fred.middleware.ScanResponse\x12K\n\x04Keys\x12 .mcc.fred.middleware.KeysRequest\x1a!.mcc.fred.middleware.KeysResponse\x12H\n\x06Update\x12\".mcc.fred.middleware.UpdateRequest\x1a\x1a.mcc.fred.middleware.Empty\x12H\n\x06\x44\x65lete\x12\".mcc.fred.middleware.DeleteRequest\x1a\x1a.mcc.fred.middleware.Empty\x12Q\n\x06\x41ppend\x12\".mcc.fred.middleware.AppendRequest\x1a#.mcc.fred.middleware.AppendResponse\x12H\n\x06Notify\x12\".mcc.fred.middleware.NotifyRequest\x1a\x1a.mcc.fred.middleware.Empty\x12V\n\rChooseReplica\x12).mcc.fred.middleware.ChooseReplicaRequest\x1a\x1a.mcc.fred.middleware.Empty\x12P\n\nAddReplica\x12&.mcc.fred.middleware.AddReplicaRequest\x1a\x1a.mcc.fred.middleware.Empty\x12l\n\x0fGetKeygroupInfo\x12+.mcc.fred.middleware.GetKeygroupInfoRequest\x1a,.mcc.fred.middleware.GetKeygroupInfoResponse\x12V\n\rRemoveReplica\x12).mcc.fred.middleware.RemoveReplicaRequest\x1a\x1a.mcc.fred.middleware.Empty\x12]\n\nGetReplica\x12&.mcc.fred.middleware.GetReplicaRequest\x1a\'.mcc.fred.middleware.GetReplicaResponse\x12\x66\n\rGetAllReplica\x12).mcc.fred.middleware.GetAllReplicaRequest\x1a*.mcc.fred.middleware.GetAllReplicaResponse\x12v\n\x13GetKeygroupTriggers\x12..mcc.fred.middleware.GetKeygroupTriggerRequest\x1a/.mcc.fred.middleware.GetKeygroupTriggerResponse\x12P\n\nAddTrigger\x12&.mcc.fred.middleware.AddTriggerRequest\x1a\x1a.mcc.fred.middleware.Empty\x12V\n\rRemoveTrigger\x12).mcc.fred.middleware.RemoveTriggerRequest\x1a\x1a.mcc.fred.middleware.Empty\x12G\n\x07\x41\x64\x64User\x12 .mcc.fred.middleware.UserRequest\x1a\x1a.mcc.fred.middleware.Empty\x12J\n\nRemoveUser\x12 .mcc.fred.middleware.UserRequest\x1a\x1a.mcc.fred.middleware.EmptyB\x0eZ\x0c.;middlewareb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x10middleware.proto\x12\x13mcc.fred.middleware\"\x07\n\x05\x45mpty\"_\n\x15\x43reateKeygroupRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x0f\n\x07mutable\x18\x02 \x01(\x08\x12\x0e\n\x06\x65xpiry\x18\x03 \x01(\x03\x12\x13\n\x0b\x66irstNodeId\x18\x04 \x01(\t\")\n\x15\x44\x65leteKeygroupRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\"\x88\x01\n\x04Item\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0b\n\x03val\x18\x02 \x01(\x0c\x12\x37\n\x07version\x18\x03 \x03(\x0b\x32&.mcc.fred.middleware.Item.VersionEntry\x1a.\n\x0cVersionEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x04:\x02\x38\x01\">\n\x0bReadRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12\x11\n\tminExpiry\x18\x03 \x01(\x03\"8\n\x0cReadResponse\x12(\n\x05items\x18\x01 \x03(\x0b\x32\x19.mcc.fred.middleware.Item\":\n\x0bScanRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12\r\n\x05\x63ount\x18\x03 \x01(\x04\"7\n\x0cScanResponse\x12\'\n\x04\x64\x61ta\x18\x01 \x03(\x0b\x32\x19.mcc.fred.middleware.Data\":\n\x0bKeysRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12\r\n\x05\x63ount\x18\x03 \x01(\x04\"6\n\x0cKeysResponse\x12&\n\x04keys\x18\x01 \x03(\x0b\x32\x18.mcc.fred.middleware.Key\"\x11\n\x03Key\x12\n\n\x02id\x18\x01 \x01(\t\" \n\x04\x44\x61ta\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04\x64\x61ta\x18\x02 \x01(\x0c\";\n\rUpdateRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12\x0c\n\x04\x64\x61ta\x18\x03 \x01(\x0c\"/\n\rAppendRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x0c\n\x04\x64\x61ta\x18\x02 \x01(\x0c\"\x1c\n\x0e\x41ppendResponse\x12\n\n\x02id\x18\x01 \x01(\t\"\x9f\x01\n\rNotifyRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12@\n\x07version\x18\x03 \x03(\x0b\x32/.mcc.fred.middleware.NotifyRequest.VersionEntry\x1a.\n\x0cVersionEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x04:\x02\x38\x01\"8\n\x14\x43hooseReplicaRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x0e\n\x06nodeId\x18\x02 \x01(\t\"-\n\rDeleteRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\"E\n\x11\x41\x64\x64ReplicaRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x0e\n\x06nodeId\x18\x02 \x01(\t\x12\x0e\n\x06\x65xpiry\x18\x03 \x01(\x03\"*\n\x16GetKeygroupInfoRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\"a\n\x17GetKeygroupInfoResponse\x12\x0f\n\x07mutable\x18\x01 \x01(\x08\x12\x35\n\x07replica\x18\x02 \x03(\x0b\x32$.mcc.fred.middleware.KeygroupReplica\"?\n\x0fKeygroupReplica\x12\x0e\n\x06nodeId\x18\x01 \x01(\t\x12\x0e\n\x06\x65xpiry\x18\x02 \x01(\x03\x12\x0c\n\x04host\x18\x03 \x01(\t\"8\n\x14RemoveReplicaRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x0e\n\x06nodeId\x18\x02 \x01(\t\"#\n\x11GetReplicaRequest\x12\x0e\n\x06nodeId\x18\x01 \x01(\t\"2\n\x12GetReplicaResponse\x12\x0e\n\x06nodeId\x18\x01 \x01(\t\x12\x0c\n\x04host\x18\x02 \x01(\t\"\x16\n\x14GetAllReplicaRequest\"R\n\x15GetAllReplicaResponse\x12\x39\n\x08replicas\x18\x01 \x03(\x0b\x32\'.mcc.fred.middleware.GetReplicaResponse\"-\n\x19GetKeygroupTriggerRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\"L\n\x1aGetKeygroupTriggerResponse\x12.\n\x08triggers\x18\x01 \x03(\x0b\x32\x1c.mcc.fred.middleware.Trigger\"#\n\x07Trigger\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04host\x18\x02 \x01(\t\"M\n\x11\x41\x64\x64TriggerRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x11\n\ttriggerId\x18\x02 \x01(\t\x12\x13\n\x0btriggerHost\x18\x03 \x01(\t\";\n\x14RemoveTriggerRequest\x12\x10\n\x08keygroup\x18\x01 \x01(\t\x12\x11\n\ttriggerId\x18\x02 \x01(\t\"Z\n\x0bUserRequest\x12\x0c\n\x04user\x18\x01 \x01(\t\x12\x10\n\x08keygroup\x18\x02 \x01(\t\x12+\n\x04role\x18\x03 \x01(\x0e\x32\x1d.mcc.fred.middleware.UserRole*s\n\x08UserRole\x12\x10\n\x0cReadKeygroup\x10\x00\x12\x11\n\rWriteKeygroup\x10\x01\x12\x14\n\x10\x43onfigureReplica\x10\x02\x12\x14\n\x10\x43onfigureTrigger\x10\x03\x12\x16\n\x12\x43onfigureKeygroups\x10\x04\x32\xc6\r\n\nMiddleware\x12X\n\x0e\x43reateKeygroup\x12*.mcc.fred.middleware.CreateKeygroupRequest\x1a\x1a.mcc.fred.middleware.Empty\x12X\n\x0e\x44\x65leteKeygroup\x12*.mcc.fred.middleware.DeleteKeygroupRequest\x1a\x1a.mcc.fred.middleware.Empty\x12K\n\x04Read\x12 .mcc.fred.middleware.ReadRequest\x1a!.mcc.fred.middleware.ReadResponse\x12K\n\x04Scan\x12 .mcc.fred.middleware.ScanRequest\x1a!.mcc.fred.middleware.ScanResponse\x12K\n\x04Keys\x12 .mcc.fred.middleware.KeysRequest\x1a!.mcc.fred.middleware.KeysResponse\x12H\n\x06Update\x12\".mcc.fred.middleware.UpdateRequest\x1a\x1a.mcc.fred.middleware.Empty\x12H\n\x06\x44\x65lete\x12\".mcc.fred.middleware.DeleteRequest\x1a\x1a.mcc.fred.middleware.Empty\x12Q\n\x06\x41ppend\x12\".mcc.fred.middleware.AppendRequest\x1a#.mcc.fred.middleware.AppendResponse\x12H\n\x06Notify\x12\".mcc.fred.middleware.NotifyRequest\x1a\x1a.mcc.fred.middleware.Empty\x12V\n\rChooseReplica\x12).mcc.fred.middleware.ChooseReplicaRequest\x1a\x1a.mcc.fred.middleware.Empty\x12P\n\nAddReplica\x12&.mcc.fred.middleware.AddReplicaRequest\x1a\x1a.mcc.fred.middleware.Empty\x12l\n\x0fGetKeygroupInfo\x12+.mcc.fred.middleware.GetKeygroupInfoRequest\x1a,.mcc.fred.middleware.GetKeygroupInfoResponse\x12V\n\rRemoveReplica\x12).mcc.fred.middleware.RemoveReplicaRequest\x1a\x1a.mcc.fred.middleware.Empty\x12]\n\nGetReplica\x12&.mcc.fred.middleware.GetReplicaRequest\x1a\'.mcc.fred.middleware.GetReplicaResponse\x12\x66\n\rGetAllReplica\x12).mcc.fred.middleware.GetAllReplicaRequest\x1a*.mcc.fred.middleware.GetAllReplicaResponse\x12v\n\x13GetKeygroupTriggers\x12..mcc.fred.middleware.GetKeygroupTriggerRequest\x1a/.mcc.fred.middleware.GetKeygroupTriggerResponse\x12P\n\nAddTrigger\x12&.mcc.fred.middleware.AddTriggerRequest\x1a\x1a.mcc.fred.middleware.Empty\x12V\n\rRemoveTrigger\x12).mcc.fred.middleware.RemoveTriggerRequest\x1a\x1a.mcc.fred.middleware.Empty\x12G\n\x07\x41\x64\x64User\x12 .mcc.fred.middleware.UserRequest\x1a\x1a.mcc.fred.middleware.Empty\x12J\n\nRemoveUser\x12 .mcc.fred.middleware.UserRequest\x1a\x1a.mcc.fred.middleware.EmptyB\x0eZ\x0c.;middlewareb\x06proto3')
 
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, globals())
 _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'middleware_pb2', globals())
@@ -112,14 +112,14 @@ class Item(google.protobuf.message.Message):
     VAL_FIELD_NUMBER: builtins.int
     VERSION_FIELD_NUMBER: builtins.int
     id: builtins.str
-    val: builtins.str
+    val: builtins.bytes
     @property
     def version(self) -> google.protobuf.internal.containers.ScalarMap[builtins.str, builtins.int]: ...
     def __init__(
         self,
         *,
         id: builtins.str = ...,
-        val: builtins.str = ...,
+        val: builtins.bytes = ...,
         version: collections.abc.Mapping[builtins.str, builtins.int] | None = ...,
     ) -> None: ...
     def ClearField(self, field_name: typing_extensions.Literal["id", b"id", "val", b"val", "version", b"version"]) -> None: ...
@@ -259,12 +259,12 @@ class Data(google.protobuf.message.Message):
     ID_FIELD_NUMBER: builtins.int
     DATA_FIELD_NUMBER: builtins.int
     id: builtins.str
-    data: builtins.str
+    data: builtins.bytes
     def __init__(
         self,
         *,
         id: builtins.str = ...,
-        data: builtins.str = ...,
+        data: builtins.bytes = ...,
     ) -> None: ...
     def ClearField(self, field_name: typing_extensions.Literal["data", b"data", "id", b"id"]) -> None: ...
 
@@ -279,13 +279,13 @@ class UpdateRequest(google.protobuf.message.Message):
     DATA_FIELD_NUMBER: builtins.int
     keygroup: builtins.str
     id: builtins.str
-    data: builtins.str
+    data: builtins.bytes
     def __init__(
         self,
         *,
         keygroup: builtins.str = ...,
         id: builtins.str = ...,
-        data: builtins.str = ...,
+        data: builtins.bytes = ...,
     ) -> None: ...
     def ClearField(self, field_name: typing_extensions.Literal["data", b"data", "id", b"id", "keygroup", b"keygroup"]) -> None: ...
 
@@ -298,12 +298,12 @@ class AppendRequest(google.protobuf.message.Message):
     KEYGROUP_FIELD_NUMBER: builtins.int
     DATA_FIELD_NUMBER: builtins.int
     keygroup: builtins.str
-    data: builtins.str
+    data: builtins.bytes
     def __init__(
         self,
         *,
         keygroup: builtins.str = ...,
-        data: builtins.str = ...,
+        data: builtins.bytes = ...,
     ) -> None: ...
     def ClearField(self, field_name: typing_extensions.Literal["data", b"data", "keygroup", b"keygroup"]) -> None: ...
 
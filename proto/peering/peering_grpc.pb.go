@@ -28,6 +28,16 @@ type NodeClient interface {
 	AppendItem(ctx context.Context, in *AppendItemRequest, opts ...grpc.CallOption) (*Empty, error)
 	GetItem(ctx context.Context, in *GetItemRequest, opts ...grpc.CallOption) (*GetItemResponse, error)
 	GetAllItems(ctx context.Context, in *GetAllItemsRequest, opts ...grpc.CallOption) (*GetAllItemsResponse, error)
+	TransferKeygroup(ctx context.Context, in *TransferKeygroupRequest, opts ...grpc.CallOption) (Node_TransferKeygroupClient, error)
+	PushKeygroup(ctx context.Context, opts ...grpc.CallOption) (Node_PushKeygroupClient, error)
+	GetMerkleTree(ctx context.Context, in *GetMerkleTreeRequest, opts ...grpc.CallOption) (*GetMerkleTreeResponse, error)
+	GetBucketItems(ctx context.Context, in *GetBucketItemsRequest, opts ...grpc.CallOption) (Node_GetBucketItemsClient, error)
+	ReplicateBatch(ctx context.Context, in *ReplicateBatchRequest, opts ...grpc.CallOption) (*ReplicateBatchResponse, error)
+	PutBatch(ctx context.Context, in *PutBatchRequest, opts ...grpc.CallOption) (*Empty, error)
+	AcknowledgeTombstones(ctx context.Context, in *AcknowledgeTombstonesRequest, opts ...grpc.CallOption) (*AcknowledgeTombstonesResponse, error)
+	CloneKeygroup(ctx context.Context, in *CloneKeygroupRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetCapabilities(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Capabilities, error)
+	AddReplica(ctx context.Context, in *AddReplicaRequest, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type nodeClient struct {
@@ -92,6 +102,167 @@ func (c *nodeClient) GetAllItems(ctx context.Context, in *GetAllItemsRequest, op
 	return out, nil
 }
 
+func (c *nodeClient) TransferKeygroup(ctx context.Context, in *TransferKeygroupRequest, opts ...grpc.CallOption) (Node_TransferKeygroupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Node_ServiceDesc.Streams[0], "/mcc.fred.peering.Node/TransferKeygroup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nodeTransferKeygroupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Node_TransferKeygroupClient interface {
+	Recv() (*KeygroupChunk, error)
+	grpc.ClientStream
+}
+
+type nodeTransferKeygroupClient struct {
+	grpc.ClientStream
+}
+
+func (x *nodeTransferKeygroupClient) Recv() (*KeygroupChunk, error) {
+	m := new(KeygroupChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nodeClient) PushKeygroup(ctx context.Context, opts ...grpc.CallOption) (Node_PushKeygroupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Node_ServiceDesc.Streams[1], "/mcc.fred.peering.Node/PushKeygroup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nodePushKeygroupClient{stream}
+	return x, nil
+}
+
+type Node_PushKeygroupClient interface {
+	Send(*KeygroupChunk) error
+	CloseAndRecv() (*Empty, error)
+	grpc.ClientStream
+}
+
+type nodePushKeygroupClient struct {
+	grpc.ClientStream
+}
+
+func (x *nodePushKeygroupClient) Send(m *KeygroupChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *nodePushKeygroupClient) CloseAndRecv() (*Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nodeClient) GetMerkleTree(ctx context.Context, in *GetMerkleTreeRequest, opts ...grpc.CallOption) (*GetMerkleTreeResponse, error) {
+	out := new(GetMerkleTreeResponse)
+	err := c.cc.Invoke(ctx, "/mcc.fred.peering.Node/GetMerkleTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) GetBucketItems(ctx context.Context, in *GetBucketItemsRequest, opts ...grpc.CallOption) (Node_GetBucketItemsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Node_ServiceDesc.Streams[2], "/mcc.fred.peering.Node/GetBucketItems", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nodeGetBucketItemsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Node_GetBucketItemsClient interface {
+	Recv() (*KeygroupChunk, error)
+	grpc.ClientStream
+}
+
+type nodeGetBucketItemsClient struct {
+	grpc.ClientStream
+}
+
+func (x *nodeGetBucketItemsClient) Recv() (*KeygroupChunk, error) {
+	m := new(KeygroupChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nodeClient) ReplicateBatch(ctx context.Context, in *ReplicateBatchRequest, opts ...grpc.CallOption) (*ReplicateBatchResponse, error) {
+	out := new(ReplicateBatchResponse)
+	err := c.cc.Invoke(ctx, "/mcc.fred.peering.Node/ReplicateBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) PutBatch(ctx context.Context, in *PutBatchRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mcc.fred.peering.Node/PutBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) AcknowledgeTombstones(ctx context.Context, in *AcknowledgeTombstonesRequest, opts ...grpc.CallOption) (*AcknowledgeTombstonesResponse, error) {
+	out := new(AcknowledgeTombstonesResponse)
+	err := c.cc.Invoke(ctx, "/mcc.fred.peering.Node/AcknowledgeTombstones", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) CloneKeygroup(ctx context.Context, in *CloneKeygroupRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mcc.fred.peering.Node/CloneKeygroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) GetCapabilities(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Capabilities, error) {
+	out := new(Capabilities)
+	err := c.cc.Invoke(ctx, "/mcc.fred.peering.Node/GetCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) AddReplica(ctx context.Context, in *AddReplicaRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mcc.fred.peering.Node/AddReplica", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NodeServer is the server API for Node service.
 // All implementations should embed UnimplementedNodeServer
 // for forward compatibility
@@ -102,6 +273,16 @@ type NodeServer interface {
 	AppendItem(context.Context, *AppendItemRequest) (*Empty, error)
 	GetItem(context.Context, *GetItemRequest) (*GetItemResponse, error)
 	GetAllItems(context.Context, *GetAllItemsRequest) (*GetAllItemsResponse, error)
+	TransferKeygroup(*TransferKeygroupRequest, Node_TransferKeygroupServer) error
+	PushKeygroup(Node_PushKeygroupServer) error
+	GetMerkleTree(context.Context, *GetMerkleTreeRequest) (*GetMerkleTreeResponse, error)
+	GetBucketItems(*GetBucketItemsRequest, Node_GetBucketItemsServer) error
+	ReplicateBatch(context.Context, *ReplicateBatchRequest) (*ReplicateBatchResponse, error)
+	PutBatch(context.Context, *PutBatchRequest) (*Empty, error)
+	AcknowledgeTombstones(context.Context, *AcknowledgeTombstonesRequest) (*AcknowledgeTombstonesResponse, error)
+	CloneKeygroup(context.Context, *CloneKeygroupRequest) (*Empty, error)
+	GetCapabilities(context.Context, *Empty) (*Capabilities, error)
+	AddReplica(context.Context, *AddReplicaRequest) (*Empty, error)
 }
 
 // UnimplementedNodeServer should be embedded to have forward compatible implementations.
@@ -126,6 +307,36 @@ func (UnimplementedNodeServer) GetItem(context.Context, *GetItemRequest) (*GetIt
 func (UnimplementedNodeServer) GetAllItems(context.Context, *GetAllItemsRequest) (*GetAllItemsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllItems not implemented")
 }
+func (UnimplementedNodeServer) TransferKeygroup(*TransferKeygroupRequest, Node_TransferKeygroupServer) error {
+	return status.Errorf(codes.Unimplemented, "method TransferKeygroup not implemented")
+}
+func (UnimplementedNodeServer) PushKeygroup(Node_PushKeygroupServer) error {
+	return status.Errorf(codes.Unimplemented, "method PushKeygroup not implemented")
+}
+func (UnimplementedNodeServer) GetMerkleTree(context.Context, *GetMerkleTreeRequest) (*GetMerkleTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMerkleTree not implemented")
+}
+func (UnimplementedNodeServer) GetBucketItems(*GetBucketItemsRequest, Node_GetBucketItemsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetBucketItems not implemented")
+}
+func (UnimplementedNodeServer) ReplicateBatch(context.Context, *ReplicateBatchRequest) (*ReplicateBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicateBatch not implemented")
+}
+func (UnimplementedNodeServer) PutBatch(context.Context, *PutBatchRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutBatch not implemented")
+}
+func (UnimplementedNodeServer) AcknowledgeTombstones(context.Context, *AcknowledgeTombstonesRequest) (*AcknowledgeTombstonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeTombstones not implemented")
+}
+func (UnimplementedNodeServer) CloneKeygroup(context.Context, *CloneKeygroupRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneKeygroup not implemented")
+}
+func (UnimplementedNodeServer) GetCapabilities(context.Context, *Empty) (*Capabilities, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapabilities not implemented")
+}
+func (UnimplementedNodeServer) AddReplica(context.Context, *AddReplicaRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReplica not implemented")
+}
 
 // UnsafeNodeServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to NodeServer will
@@ -246,6 +457,200 @@ func _Node_GetAllItems_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Node_TransferKeygroup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TransferKeygroupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NodeServer).TransferKeygroup(m, &nodeTransferKeygroupServer{stream})
+}
+
+type Node_TransferKeygroupServer interface {
+	Send(*KeygroupChunk) error
+	grpc.ServerStream
+}
+
+type nodeTransferKeygroupServer struct {
+	grpc.ServerStream
+}
+
+func (x *nodeTransferKeygroupServer) Send(m *KeygroupChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Node_PushKeygroup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NodeServer).PushKeygroup(&nodePushKeygroupServer{stream})
+}
+
+type Node_PushKeygroupServer interface {
+	SendAndClose(*Empty) error
+	Recv() (*KeygroupChunk, error)
+	grpc.ServerStream
+}
+
+type nodePushKeygroupServer struct {
+	grpc.ServerStream
+}
+
+func (x *nodePushKeygroupServer) SendAndClose(m *Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *nodePushKeygroupServer) Recv() (*KeygroupChunk, error) {
+	m := new(KeygroupChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Node_GetMerkleTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMerkleTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GetMerkleTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.peering.Node/GetMerkleTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetMerkleTree(ctx, req.(*GetMerkleTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_GetBucketItems_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetBucketItemsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NodeServer).GetBucketItems(m, &nodeGetBucketItemsServer{stream})
+}
+
+type Node_GetBucketItemsServer interface {
+	Send(*KeygroupChunk) error
+	grpc.ServerStream
+}
+
+type nodeGetBucketItemsServer struct {
+	grpc.ServerStream
+}
+
+func (x *nodeGetBucketItemsServer) Send(m *KeygroupChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Node_ReplicateBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplicateBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).ReplicateBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.peering.Node/ReplicateBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).ReplicateBatch(ctx, req.(*ReplicateBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_PutBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).PutBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.peering.Node/PutBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).PutBatch(ctx, req.(*PutBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_AcknowledgeTombstones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeTombstonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).AcknowledgeTombstones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.peering.Node/AcknowledgeTombstones",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).AcknowledgeTombstones(ctx, req.(*AcknowledgeTombstonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_CloneKeygroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneKeygroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).CloneKeygroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.peering.Node/CloneKeygroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).CloneKeygroup(ctx, req.(*CloneKeygroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.peering.Node/GetCapabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetCapabilities(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_AddReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReplicaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).AddReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.peering.Node/AddReplica",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).AddReplica(ctx, req.(*AddReplicaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Node_ServiceDesc is the grpc.ServiceDesc for Node service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -277,7 +682,51 @@ var Node_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllItems",
 			Handler:    _Node_GetAllItems_Handler,
 		},
+		{
+			MethodName: "GetMerkleTree",
+			Handler:    _Node_GetMerkleTree_Handler,
+		},
+		{
+			MethodName: "ReplicateBatch",
+			Handler:    _Node_ReplicateBatch_Handler,
+		},
+		{
+			MethodName: "PutBatch",
+			Handler:    _Node_PutBatch_Handler,
+		},
+		{
+			MethodName: "AcknowledgeTombstones",
+			Handler:    _Node_AcknowledgeTombstones_Handler,
+		},
+		{
+			MethodName: "CloneKeygroup",
+			Handler:    _Node_CloneKeygroup_Handler,
+		},
+		{
+			MethodName: "GetCapabilities",
+			Handler:    _Node_GetCapabilities_Handler,
+		},
+		{
+			MethodName: "AddReplica",
+			Handler:    _Node_AddReplica_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TransferKeygroup",
+			Handler:       _Node_TransferKeygroup_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PushKeygroup",
+			Handler:       _Node_PushKeygroup_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GetBucketItems",
+			Handler:       _Node_GetBucketItems_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "peering.proto",
 }
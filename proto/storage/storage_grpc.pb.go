@@ -24,9 +24,12 @@ const _ = grpc.SupportPackageIsVersion7
 type DatabaseClient interface {
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	UpdateBatch(ctx context.Context, in *UpdateBatchRequest, opts ...grpc.CallOption) (*UpdateBatchResponse, error)
 	Append(ctx context.Context, in *AppendRequest, opts ...grpc.CallOption) (*AppendResponse, error)
 	Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error)
 	Scan(ctx context.Context, in *ScanRequest, opts ...grpc.CallOption) (*ScanResponse, error)
+	ReadPage(ctx context.Context, in *ReadPageRequest, opts ...grpc.CallOption) (*ReadPageResponse, error)
+	ReadRange(ctx context.Context, in *ReadRangeRequest, opts ...grpc.CallOption) (*ReadRangeResponse, error)
 	ReadAll(ctx context.Context, in *ReadAllRequest, opts ...grpc.CallOption) (*ReadAllResponse, error)
 	IDs(ctx context.Context, in *IDsRequest, opts ...grpc.CallOption) (*IDsResponse, error)
 	Exists(ctx context.Context, in *ExistsRequest, opts ...grpc.CallOption) (*ExistsResponse, error)
@@ -36,6 +39,8 @@ type DatabaseClient interface {
 	AddKeygroupTrigger(ctx context.Context, in *AddKeygroupTriggerRequest, opts ...grpc.CallOption) (*AddKeygroupTriggerResponse, error)
 	DeleteKeygroupTrigger(ctx context.Context, in *DeleteKeygroupTriggerRequest, opts ...grpc.CallOption) (*DeleteKeygroupTriggerResponse, error)
 	GetKeygroupTrigger(ctx context.Context, in *GetKeygroupTriggerRequest, opts ...grpc.CallOption) (*GetKeygroupTriggerResponse, error)
+	SetKeygroupHistory(ctx context.Context, in *SetKeygroupHistoryRequest, opts ...grpc.CallOption) (*SetKeygroupHistoryResponse, error)
+	ReadHistory(ctx context.Context, in *ReadHistoryRequest, opts ...grpc.CallOption) (*ReadHistoryResponse, error)
 }
 
 type databaseClient struct {
@@ -64,6 +69,15 @@ func (c *databaseClient) Delete(ctx context.Context, in *DeleteRequest, opts ...
 	return out, nil
 }
 
+func (c *databaseClient) UpdateBatch(ctx context.Context, in *UpdateBatchRequest, opts ...grpc.CallOption) (*UpdateBatchResponse, error) {
+	out := new(UpdateBatchResponse)
+	err := c.cc.Invoke(ctx, "/mcc.fred.storage.Database/UpdateBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *databaseClient) Append(ctx context.Context, in *AppendRequest, opts ...grpc.CallOption) (*AppendResponse, error) {
 	out := new(AppendResponse)
 	err := c.cc.Invoke(ctx, "/mcc.fred.storage.Database/Append", in, out, opts...)
@@ -91,6 +105,24 @@ func (c *databaseClient) Scan(ctx context.Context, in *ScanRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *databaseClient) ReadPage(ctx context.Context, in *ReadPageRequest, opts ...grpc.CallOption) (*ReadPageResponse, error) {
+	out := new(ReadPageResponse)
+	err := c.cc.Invoke(ctx, "/mcc.fred.storage.Database/ReadPage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) ReadRange(ctx context.Context, in *ReadRangeRequest, opts ...grpc.CallOption) (*ReadRangeResponse, error) {
+	out := new(ReadRangeResponse)
+	err := c.cc.Invoke(ctx, "/mcc.fred.storage.Database/ReadRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *databaseClient) ReadAll(ctx context.Context, in *ReadAllRequest, opts ...grpc.CallOption) (*ReadAllResponse, error) {
 	out := new(ReadAllResponse)
 	err := c.cc.Invoke(ctx, "/mcc.fred.storage.Database/ReadAll", in, out, opts...)
@@ -172,15 +204,36 @@ func (c *databaseClient) GetKeygroupTrigger(ctx context.Context, in *GetKeygroup
 	return out, nil
 }
 
+func (c *databaseClient) SetKeygroupHistory(ctx context.Context, in *SetKeygroupHistoryRequest, opts ...grpc.CallOption) (*SetKeygroupHistoryResponse, error) {
+	out := new(SetKeygroupHistoryResponse)
+	err := c.cc.Invoke(ctx, "/mcc.fred.storage.Database/SetKeygroupHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) ReadHistory(ctx context.Context, in *ReadHistoryRequest, opts ...grpc.CallOption) (*ReadHistoryResponse, error) {
+	out := new(ReadHistoryResponse)
+	err := c.cc.Invoke(ctx, "/mcc.fred.storage.Database/ReadHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DatabaseServer is the server API for Database service.
 // All implementations should embed UnimplementedDatabaseServer
 // for forward compatibility
 type DatabaseServer interface {
 	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
 	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	UpdateBatch(context.Context, *UpdateBatchRequest) (*UpdateBatchResponse, error)
 	Append(context.Context, *AppendRequest) (*AppendResponse, error)
 	Read(context.Context, *ReadRequest) (*ReadResponse, error)
 	Scan(context.Context, *ScanRequest) (*ScanResponse, error)
+	ReadPage(context.Context, *ReadPageRequest) (*ReadPageResponse, error)
+	ReadRange(context.Context, *ReadRangeRequest) (*ReadRangeResponse, error)
 	ReadAll(context.Context, *ReadAllRequest) (*ReadAllResponse, error)
 	IDs(context.Context, *IDsRequest) (*IDsResponse, error)
 	Exists(context.Context, *ExistsRequest) (*ExistsResponse, error)
@@ -190,6 +243,8 @@ type DatabaseServer interface {
 	AddKeygroupTrigger(context.Context, *AddKeygroupTriggerRequest) (*AddKeygroupTriggerResponse, error)
 	DeleteKeygroupTrigger(context.Context, *DeleteKeygroupTriggerRequest) (*DeleteKeygroupTriggerResponse, error)
 	GetKeygroupTrigger(context.Context, *GetKeygroupTriggerRequest) (*GetKeygroupTriggerResponse, error)
+	SetKeygroupHistory(context.Context, *SetKeygroupHistoryRequest) (*SetKeygroupHistoryResponse, error)
+	ReadHistory(context.Context, *ReadHistoryRequest) (*ReadHistoryResponse, error)
 }
 
 // UnimplementedDatabaseServer should be embedded to have forward compatible implementations.
@@ -202,6 +257,9 @@ func (UnimplementedDatabaseServer) Update(context.Context, *UpdateRequest) (*Upd
 func (UnimplementedDatabaseServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedDatabaseServer) UpdateBatch(context.Context, *UpdateBatchRequest) (*UpdateBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBatch not implemented")
+}
 func (UnimplementedDatabaseServer) Append(context.Context, *AppendRequest) (*AppendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Append not implemented")
 }
@@ -211,6 +269,12 @@ func (UnimplementedDatabaseServer) Read(context.Context, *ReadRequest) (*ReadRes
 func (UnimplementedDatabaseServer) Scan(context.Context, *ScanRequest) (*ScanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Scan not implemented")
 }
+func (UnimplementedDatabaseServer) ReadPage(context.Context, *ReadPageRequest) (*ReadPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadPage not implemented")
+}
+func (UnimplementedDatabaseServer) ReadRange(context.Context, *ReadRangeRequest) (*ReadRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadRange not implemented")
+}
 func (UnimplementedDatabaseServer) ReadAll(context.Context, *ReadAllRequest) (*ReadAllResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadAll not implemented")
 }
@@ -238,6 +302,12 @@ func (UnimplementedDatabaseServer) DeleteKeygroupTrigger(context.Context, *Delet
 func (UnimplementedDatabaseServer) GetKeygroupTrigger(context.Context, *GetKeygroupTriggerRequest) (*GetKeygroupTriggerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetKeygroupTrigger not implemented")
 }
+func (UnimplementedDatabaseServer) SetKeygroupHistory(context.Context, *SetKeygroupHistoryRequest) (*SetKeygroupHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKeygroupHistory not implemented")
+}
+func (UnimplementedDatabaseServer) ReadHistory(context.Context, *ReadHistoryRequest) (*ReadHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadHistory not implemented")
+}
 
 // UnsafeDatabaseServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to DatabaseServer will
@@ -286,6 +356,24 @@ func _Database_Delete_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Database_UpdateBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).UpdateBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.storage.Database/UpdateBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).UpdateBatch(ctx, req.(*UpdateBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Database_Append_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AppendRequest)
 	if err := dec(in); err != nil {
@@ -340,6 +428,42 @@ func _Database_Scan_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Database_ReadPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).ReadPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.storage.Database/ReadPage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).ReadPage(ctx, req.(*ReadPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_ReadRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).ReadRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.storage.Database/ReadRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).ReadRange(ctx, req.(*ReadRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Database_ReadAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReadAllRequest)
 	if err := dec(in); err != nil {
@@ -502,6 +626,42 @@ func _Database_GetKeygroupTrigger_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Database_SetKeygroupHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetKeygroupHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).SetKeygroupHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.storage.Database/SetKeygroupHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).SetKeygroupHistory(ctx, req.(*SetKeygroupHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_ReadHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).ReadHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mcc.fred.storage.Database/ReadHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).ReadHistory(ctx, req.(*ReadHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Database_ServiceDesc is the grpc.ServiceDesc for Database service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -517,6 +677,10 @@ var Database_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _Database_Delete_Handler,
 		},
+		{
+			MethodName: "UpdateBatch",
+			Handler:    _Database_UpdateBatch_Handler,
+		},
 		{
 			MethodName: "Append",
 			Handler:    _Database_Append_Handler,
@@ -529,6 +693,14 @@ var Database_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Scan",
 			Handler:    _Database_Scan_Handler,
 		},
+		{
+			MethodName: "ReadPage",
+			Handler:    _Database_ReadPage_Handler,
+		},
+		{
+			MethodName: "ReadRange",
+			Handler:    _Database_ReadRange_Handler,
+		},
 		{
 			MethodName: "ReadAll",
 			Handler:    _Database_ReadAll_Handler,
@@ -565,6 +737,14 @@ var Database_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetKeygroupTrigger",
 			Handler:    _Database_GetKeygroupTrigger_Handler,
 		},
+		{
+			MethodName: "SetKeygroupHistory",
+			Handler:    _Database_SetKeygroupHistory_Handler,
+		},
+		{
+			MethodName: "ReadHistory",
+			Handler:    _Database_ReadHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "storage.proto",
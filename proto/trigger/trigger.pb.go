@@ -65,7 +65,7 @@ type PutItemTriggerRequest struct {
 
 	Keygroup string `protobuf:"bytes,1,opt,name=keygroup,proto3" json:"keygroup,omitempty"`
 	Id       string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Val      string `protobuf:"bytes,3,opt,name=val,proto3" json:"val,omitempty"`
+	Val      []byte `protobuf:"bytes,3,opt,name=val,proto3" json:"val,omitempty"`
 }
 
 func (x *PutItemTriggerRequest) Reset() {
@@ -114,11 +114,11 @@ func (x *PutItemTriggerRequest) GetId() string {
 	return ""
 }
 
-func (x *PutItemTriggerRequest) GetVal() string {
+func (x *PutItemTriggerRequest) GetVal() []byte {
 	if x != nil {
 		return x.Val
 	}
-	return ""
+	return nil
 }
 
 type DeleteItemTriggerRequest struct {
@@ -186,7 +186,7 @@ var file_trigger_proto_rawDesc = []byte{
 	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12,
 	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x10, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x61,
+	0x10, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x76, 0x61,
 	0x6c, 0x22, 0x46, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x54,
 	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
 	0x08, 0x6b, 0x65, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
@@ -313,7 +313,7 @@ func (n *Node) PutItem(kgname, item string, data string, expectError bool) vcloc
 	res, err := n.Client.Update(context.Background(), &client.UpdateRequest{
 		Keygroup: kgname,
 		Id:       item,
-		Data:     data,
+		Data:     []byte(data),
 	})
 
 	if err != nil && !expectError {
@@ -340,7 +340,7 @@ func (n *Node) PutItemVersion(kgname, item string, data string, version vclock.V
 	res, err := n.Client.Update(context.Background(), &client.UpdateRequest{
 		Keygroup: kgname,
 		Id:       item,
-		Data:     data,
+		Data:     []byte(data),
 		Versions: []*client.Version{{
 			Version: version.GetMap(),
 		}}})
@@ -369,7 +369,7 @@ func (n *Node) AppendItem(kgname string, id uint64, data string, expectError boo
 	res, err := n.Client.Append(context.Background(), &client.AppendRequest{
 		Keygroup: kgname,
 		Id:       id,
-		Data:     data,
+		Data:     []byte(data),
 	})
 
 	if err != nil != expectError {
@@ -414,7 +414,7 @@ func (n *Node) GetItem(kgname, item string, expectError bool) ([]string, []vcloc
 	vvectors := make([]vclock.VClock, len(res.Data))
 
 	for i, it := range res.Data {
-		vals[i] = it.Val
+		vals[i] = string(it.Val)
 		vvectors[i] = it.Version.Version
 	}
 
@@ -446,7 +446,7 @@ func (n *Node) ScanItems(kgname, item string, count uint64, expectError bool) ma
 	items := make(map[string]string)
 
 	for _, d := range res.Data {
-		items[d.Id] = d.Val
+		items[d.Id] = string(d.Val)
 	}
 
 	return items
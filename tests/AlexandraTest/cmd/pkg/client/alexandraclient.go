@@ -84,7 +84,7 @@ func (c *AlexandraClient) Update(kgname, id, data string, expectError bool) {
 	_, err := c.client.Update(context.Background(), &middleware.UpdateRequest{
 		Keygroup: kgname,
 		Id:       id,
-		Data:     data,
+		Data:     []byte(data),
 	})
 	c.dealWithResponse("Update", err, expectError)
 }
@@ -107,7 +107,7 @@ func (c *AlexandraClient) Read(keygroup, id string, minExpiry int64, expectError
 	vals := make([]string, len(res.Items))
 
 	for i := range res.Items {
-		vals[i] = res.Items[i].Val
+		vals[i] = string(res.Items[i].Val)
 	}
 
 	return vals
@@ -47,7 +47,7 @@ class Client():
 
             r.keygroup = keygroup
             r.id = self.forumkey
-            r.data = json.dumps(list(set()))
+            r.data = json.dumps(list(set())).encode()
 
             print("\033[93mtrying to add first item...\033[0m")
             self.client.Update(r)
@@ -73,7 +73,7 @@ class Client():
 
         r.keygroup = keygroup
         r.id = id
-        r.data = json.dumps(sorted(value))
+        r.data = json.dumps(sorted(value)).encode()
 
         self.client.Update(r)
 